@@ -37,6 +37,11 @@ func (r *mutationResolver) UpdateAdminUser(ctx context.Context, uid string, emai
 	return getAdminUserService().UpdateAdminUser(ctx, uid, email, password)
 }
 
+// SetAdminUserRole is the resolver for the setAdminUserRole field.
+func (r *mutationResolver) SetAdminUserRole(ctx context.Context, uid string, role string) (*model.SimpleResult, error) {
+	return getAdminUserService().SetAdminUserRole(ctx, uid, role)
+}
+
 // CreateSajuProfile is the resolver for the createSajuProfile field.
 func (r *mutationResolver) CreateSajuProfile(ctx context.Context, input model.SajuProfileCreateInput) (*model.SimpleResult, error) {
 	return getAdminSajuProfileService().CreateSajuProfileGql(ctx, input)
@@ -47,6 +52,16 @@ func (r *mutationResolver) DeleteSajuProfile(ctx context.Context, uid string) (*
 	return getAdminSajuProfileService().DeleteSajuProfileGql(ctx, uid)
 }
 
+// RetryJob is the resolver for the retryJob field.
+func (r *mutationResolver) RetryJob(ctx context.Context, uid string) (*model.SimpleResult, error) {
+	return getJobQueueService().RetryJob(ctx, uid)
+}
+
+// PurgeJobPayload is the resolver for the purgeJobPayload field.
+func (r *mutationResolver) PurgeJobPayload(ctx context.Context, uid string) (*model.SimpleResult, error) {
+	return getJobQueueService().PurgeJobPayload(ctx, uid)
+}
+
 // CreatePhyIdealPartner is the resolver for the createPhyIdealPartner field.
 func (r *mutationResolver) CreatePhyIdealPartner(ctx context.Context, input model.PhyIdealPartnerCreateInput) (*model.SimpleResult, error) {
 	return getAdminPhyPartnerService().CreatePhyPartnerGql(ctx, input)
@@ -122,6 +137,11 @@ func (r *queryResolver) AdminUsers(ctx context.Context) (*model.SimpleResult, er
 	return getAdminUserService().GetAdminUsers(ctx)
 }
 
+// AdminAuditLogs is the resolver for the adminAuditLogs field.
+func (r *queryResolver) AdminAuditLogs(ctx context.Context, input model.AdminAuditLogSearchInput) (*model.SimpleResult, error) {
+	return getAdminAuditService().GetAdminAuditLogs(ctx, input)
+}
+
 // SajuProfiles is the resolver for the sajuProfiles field.
 func (r *queryResolver) SajuProfiles(ctx context.Context, input model.SajuProfileSearchInput) (*model.SimpleResult, error) {
 	return getAdminSajuProfileService().GetSajuProfiles(ctx, input)
@@ -142,6 +162,16 @@ func (r *queryResolver) SajuProfileLogs(ctx context.Context, input model.SajuPro
 	return getAdminSajuProfileService().GetSajuProfileLogs(ctx, input)
 }
 
+// Jobs is the resolver for the jobs field.
+func (r *queryResolver) Jobs(ctx context.Context, input model.JobSearchInput) (*model.SimpleResult, error) {
+	return getJobQueueService().GetJobs(ctx, input)
+}
+
+// Job is the resolver for the job field.
+func (r *queryResolver) Job(ctx context.Context, uid string) (*model.SimpleResult, error) {
+	return getJobQueueService().GetJob(ctx, uid)
+}
+
 // PhyIdealPartners is the resolver for the phyIdealPartners field.
 func (r *queryResolver) PhyIdealPartners(ctx context.Context, input model.PhyIdealPartnerSearchInput) (*model.SimpleResult, error) {
 	return getAdminPhyPartnerService().GetPhyPartners(ctx, input)
@@ -182,9 +212,24 @@ func (r *queryResolver) AiExecution(ctx context.Context, uid string) (*model.Sim
 	return getAdminAiExecutionService().GetAiExecution(ctx, uid)
 }
 
+// AiCostSummary is the resolver for the aiCostSummary field.
+func (r *queryResolver) AiCostSummary(ctx context.Context, input model.AiCostSummaryInput) (*model.SimpleResult, error) {
+	return getAdminAiExecutionService().GetAiCostSummary(ctx, input)
+}
+
 // Palja is the resolver for the palja field.
-func (r *queryResolver) Palja(ctx context.Context, birthdate string, timezone string) (*model.SimpleResult, error) {
-	return getAdminToolService().GetPaljaGql(ctx, birthdate, timezone)
+func (r *queryResolver) Palja(ctx context.Context, birthdate string, timezone string, calendar *string, leapMonth *bool) (*model.SimpleResult, error) {
+	return getAdminToolService().GetPaljaGql(ctx, birthdate, timezone, calendar, leapMonth)
+}
+
+// SajuSearch is the resolver for the sajuSearch field.
+func (r *queryResolver) SajuSearch(ctx context.Context, input model.SajuSearchInput) (*model.SimpleResult, error) {
+	return getAdminToolService().FindSajuDatetimesGql(ctx, input)
+}
+
+// Almanac is the resolver for the almanac field.
+func (r *queryResolver) Almanac(ctx context.Context, input model.AlmanacInput) (*model.SimpleResult, error) {
+	return getAdminToolService().GetAlmanacGql(ctx, input)
 }
 
 // ItemnCards is the resolver for the itemnCards field.
@@ -23,8 +23,11 @@ type MutationResolver interface {
 	CreateAdminUser(ctx context.Context, email string, password string) (*model.SimpleResult, error)
 	SetAdminUserActive(ctx context.Context, uid string, active bool) (*model.SimpleResult, error)
 	UpdateAdminUser(ctx context.Context, uid string, email string, password string) (*model.SimpleResult, error)
+	SetAdminUserRole(ctx context.Context, uid string, role string) (*model.SimpleResult, error)
 	CreateSajuProfile(ctx context.Context, input model.SajuProfileCreateInput) (*model.SimpleResult, error)
 	DeleteSajuProfile(ctx context.Context, uid string) (*model.SimpleResult, error)
+	RetryJob(ctx context.Context, uid string) (*model.SimpleResult, error)
+	PurgeJobPayload(ctx context.Context, uid string) (*model.SimpleResult, error)
 	CreatePhyIdealPartner(ctx context.Context, input model.PhyIdealPartnerCreateInput) (*model.SimpleResult, error)
 	DeletePhyIdealPartner(ctx context.Context, uid string) (*model.SimpleResult, error)
 	PutAiMeta(ctx context.Context, input model.AiMetaInput) (*model.SimpleResult, error)
@@ -44,10 +47,13 @@ type PhyIdealPartnerResolver interface {
 }
 type QueryResolver interface {
 	AdminUsers(ctx context.Context) (*model.SimpleResult, error)
+	AdminAuditLogs(ctx context.Context, input model.AdminAuditLogSearchInput) (*model.SimpleResult, error)
 	SajuProfiles(ctx context.Context, input model.SajuProfileSearchInput) (*model.SimpleResult, error)
 	SajuProfile(ctx context.Context, uid string) (*model.SimpleResult, error)
 	SajuProfileSimilarPartners(ctx context.Context, uid string, limit int, offset int) (*model.SimpleResult, error)
 	SajuProfileLogs(ctx context.Context, input model.SajuProfileLogSearchInput) (*model.SimpleResult, error)
+	Jobs(ctx context.Context, input model.JobSearchInput) (*model.SimpleResult, error)
+	Job(ctx context.Context, uid string) (*model.SimpleResult, error)
 	PhyIdealPartners(ctx context.Context, input model.PhyIdealPartnerSearchInput) (*model.SimpleResult, error)
 	PhyIdealPartner(ctx context.Context, uid string) (*model.SimpleResult, error)
 	AiMetas(ctx context.Context, input model.AiMetaSearchInput) (*model.SimpleResult, error)
@@ -56,7 +62,10 @@ type QueryResolver interface {
 	AiMetaKVs(ctx context.Context, input model.AiMetaKVsInput) (*model.SimpleResult, error)
 	AiExecutions(ctx context.Context, input model.AiExecutionSearchInput) (*model.SimpleResult, error)
 	AiExecution(ctx context.Context, uid string) (*model.SimpleResult, error)
-	Palja(ctx context.Context, birthdate string, timezone string) (*model.SimpleResult, error)
+	AiCostSummary(ctx context.Context, input model.AiCostSummaryInput) (*model.SimpleResult, error)
+	Palja(ctx context.Context, birthdate string, timezone string, calendar *string, leapMonth *bool) (*model.SimpleResult, error)
+	SajuSearch(ctx context.Context, input model.SajuSearchInput) (*model.SimpleResult, error)
+	Almanac(ctx context.Context, input model.AlmanacInput) (*model.SimpleResult, error)
 	ItemnCards(ctx context.Context, input model.ItemNCardSearchInput) (*model.SimpleResult, error)
 	ItemnCard(ctx context.Context, uid *string) (*model.SimpleResult, error)
 	ItemnCardByCardID(ctx context.Context, cardID string, scope *string) (*model.SimpleResult, error)
@@ -77,6 +86,17 @@ type SajuProfileResolver interface {
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) dir_hasPerm_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "perm", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["perm"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createAdminUser_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -191,6 +211,17 @@ func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawAr
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_purgeJobPayload_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "uid", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["uid"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_putAiMeta_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -202,6 +233,17 @@ func (ec *executionContext) field_Mutation_putAiMeta_args(ctx context.Context, r
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_retryJob_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "uid", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["uid"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_runAiExecution_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -262,6 +304,22 @@ func (ec *executionContext) field_Mutation_setAdminUserActive_args(ctx context.C
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_setAdminUserRole_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "uid", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["uid"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "role", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["role"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_setAiMetaDefault_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -332,6 +390,28 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_adminAuditLogs_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAdminAuditLogSearchInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAdminAuditLogSearchInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_aiCostSummary_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAiCostSummaryInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAiCostSummaryInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_aiExecution_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -387,6 +467,17 @@ func (ec *executionContext) field_Query_aiMetas_args(ctx context.Context, rawArg
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_almanac_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAlmanacInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAlmanacInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_extract_pair_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -458,6 +549,28 @@ func (ec *executionContext) field_Query_itemnCards_args(ctx context.Context, raw
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_job_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "uid", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["uid"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_jobs_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNJobSearchInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐJobSearchInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_localLogs_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -493,6 +606,16 @@ func (ec *executionContext) field_Query_palja_args(ctx context.Context, rawArgs
 		return nil, err
 	}
 	args["timezone"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "calendar", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["calendar"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "leapMonth", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["leapMonth"] = arg3
 	return args, nil
 }
 
@@ -594,6 +717,17 @@ func (ec *executionContext) field_Query_sajuProfiles_args(ctx context.Context, r
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_sajuSearch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSajuSearchInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐSajuSearchInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 // endregion ***************************** args.gotpl *****************************
 
 // region    ************************** directives.gotpl **************************
@@ -602,43 +736,43 @@ func (ec *executionContext) field_Query_sajuProfiles_args(ctx context.Context, r
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _AdminUser_id(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditDiff_field(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditDiff) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AdminUser_id,
+		ec.fieldContext_AdminAuditDiff_field,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Field, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AdminUser_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditDiff_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AdminUser",
+		Object:     "AdminAuditDiff",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AdminUser_uid(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditDiff_before(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditDiff) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AdminUser_uid,
+		ec.fieldContext_AdminAuditDiff_before,
 		func(ctx context.Context) (any, error) {
-			return obj.UID, nil
+			return obj.Before, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -647,9 +781,9 @@ func (ec *executionContext) _AdminUser_uid(ctx context.Context, field graphql.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_AdminUser_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditDiff_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AdminUser",
+		Object:     "AdminAuditDiff",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -660,72 +794,72 @@ func (ec *executionContext) fieldContext_AdminUser_uid(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _AdminUser_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditDiff_after(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditDiff) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AdminUser_createdAt,
+		ec.fieldContext_AdminAuditDiff_after,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.After, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AdminUser_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditDiff_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AdminUser",
+		Object:     "AdminAuditDiff",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AdminUser_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_id(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AdminUser_updatedAt,
+		ec.fieldContext_AdminAuditLog_id,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AdminUser_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AdminUser",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AdminUser_username(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_uid(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AdminUser_username,
+		ec.fieldContext_AdminAuditLog_uid,
 		func(ctx context.Context) (any, error) {
-			return obj.Username, nil
+			return obj.UID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -734,9 +868,9 @@ func (ec *executionContext) _AdminUser_username(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext_AdminUser_username(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AdminUser",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -747,101 +881,101 @@ func (ec *executionContext) fieldContext_AdminUser_username(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _AdminUser_email(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AdminUser_email,
+		ec.fieldContext_AdminAuditLog_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Email, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBigInt2int64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AdminUser_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AdminUser",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AdminUser_isActive(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_requestId(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AdminUser_isActive,
+		ec.fieldContext_AdminAuditLog_requestId,
 		func(ctx context.Context) (any, error) {
-			return obj.IsActive, nil
+			return obj.RequestID, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AdminUser_isActive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_requestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AdminUser",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_id(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_actorUid(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_id,
+		ec.fieldContext_AdminAuditLog_actorUid,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.ActorUID, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_actorUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_uid(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_actorRole(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_uid,
+		ec.fieldContext_AdminAuditLog_actorRole,
 		func(ctx context.Context) (any, error) {
-			return obj.UID, nil
+			return obj.ActorRole, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -850,9 +984,9 @@ func (ec *executionContext) _AiExecution_uid(ctx context.Context, field graphql.
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_actorRole(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -863,72 +997,72 @@ func (ec *executionContext) fieldContext_AiExecution_uid(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_action(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_createdAt,
+		ec.fieldContext_AdminAuditLog_action,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Action, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_targetType(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_updatedAt,
+		ec.fieldContext_AdminAuditLog_targetType,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.TargetType, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_targetType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_metaUid(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_targetUid(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_metaUid,
+		ec.fieldContext_AdminAuditLog_targetUid,
 		func(ctx context.Context) (any, error) {
-			return obj.MetaUID, nil
+			return obj.TargetUID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -937,9 +1071,9 @@ func (ec *executionContext) _AiExecution_metaUid(ctx context.Context, field grap
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_metaUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_targetUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -950,14 +1084,14 @@ func (ec *executionContext) fieldContext_AiExecution_metaUid(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_metaType(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_before(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_metaType,
+		ec.fieldContext_AdminAuditLog_before,
 		func(ctx context.Context) (any, error) {
-			return obj.MetaType, nil
+			return obj.Before, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -966,9 +1100,9 @@ func (ec *executionContext) _AiExecution_metaType(ctx context.Context, field gra
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_metaType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -979,14 +1113,14 @@ func (ec *executionContext) fieldContext_AiExecution_metaType(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_status(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_after(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_status,
+		ec.fieldContext_AdminAuditLog_after,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.After, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -995,9 +1129,9 @@ func (ec *executionContext) _AiExecution_status(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1008,72 +1142,80 @@ func (ec *executionContext) fieldContext_AiExecution_status(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_errorMessage(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminAuditLog_diff(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_errorMessage,
+		ec.fieldContext_AdminAuditLog_diff,
 		func(ctx context.Context) (any, error) {
-			return obj.ErrorMessage, nil
+			return obj.Diff, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNAdminAuditDiff2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐAdminAuditDiffᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_errorMessage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminAuditLog_diff(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminAuditLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_AdminAuditDiff_field(ctx, field)
+			case "before":
+				return ec.fieldContext_AdminAuditDiff_before(ctx, field)
+			case "after":
+				return ec.fieldContext_AdminAuditDiff_after(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AdminAuditDiff", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_prompt(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminUser_id(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_prompt,
+		ec.fieldContext_AdminUser_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Prompt, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_prompt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminUser_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_valued_prompt(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminUser_uid(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_valued_prompt,
+		ec.fieldContext_AdminUser_uid,
 		func(ctx context.Context) (any, error) {
-			return obj.ValuedPrompt, nil
+			return obj.UID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1082,9 +1224,9 @@ func (ec *executionContext) _AiExecution_valued_prompt(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_valued_prompt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminUser_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1095,84 +1237,72 @@ func (ec *executionContext) fieldContext_AiExecution_valued_prompt(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_inputkvs(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminUser_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_inputkvs,
+		ec.fieldContext_AdminUser_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Inputkvs, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNKV2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐKvᚄ,
+		ec.marshalNBigInt2int64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_inputkvs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminUser_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "k":
-				return ec.fieldContext_KV_k(ctx, field)
-			case "v":
-				return ec.fieldContext_KV_v(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type KV", field.Name)
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_outputkvs(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminUser_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_outputkvs,
+		ec.fieldContext_AdminUser_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Outputkvs, nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalNKV2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐKvᚄ,
+		ec.marshalNBigInt2int64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_outputkvs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminUser_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "k":
-				return ec.fieldContext_KV_k(ctx, field)
-			case "v":
-				return ec.fieldContext_KV_v(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type KV", field.Name)
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_model(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminUser_username(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_model,
+		ec.fieldContext_AdminUser_username,
 		func(ctx context.Context) (any, error) {
-			return obj.Model, nil
+			return obj.Username, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1181,9 +1311,9 @@ func (ec *executionContext) _AiExecution_model(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_model(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminUser_username(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1194,72 +1324,72 @@ func (ec *executionContext) fieldContext_AiExecution_model(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_temperature(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminUser_email(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_temperature,
+		ec.fieldContext_AdminUser_email,
 		func(ctx context.Context) (any, error) {
-			return obj.Temperature, nil
+			return obj.Email, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_temperature(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminUser_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_maxTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminUser_isActive(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_maxTokens,
+		ec.fieldContext_AdminUser_isActive,
 		func(ctx context.Context) (any, error) {
-			return obj.MaxTokens, nil
+			return obj.IsActive, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_maxTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminUser_isActive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_size(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AdminUser_role(ctx context.Context, field graphql.CollectedField, obj *model.AdminUser) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_size,
+		ec.fieldContext_AdminUser_role,
 		func(ctx context.Context) (any, error) {
-			return obj.Size, nil
+			return obj.Role, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1268,9 +1398,9 @@ func (ec *executionContext) _AiExecution_size(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AdminUser_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AdminUser",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1281,83 +1411,54 @@ func (ec *executionContext) fieldContext_AiExecution_size(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_inputImageBase64(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiCostBucket_id(ctx context.Context, field graphql.CollectedField, obj *model.AiCostBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_inputImageBase64,
+		ec.fieldContext_AiCostBucket_id,
 		func(ctx context.Context) (any, error) {
-			return obj.InputImageBase64, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOID2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_inputImageBase64(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiCostBucket_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AiCostBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_elapsedTime(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiCostBucket_key(ctx context.Context, field graphql.CollectedField, obj *model.AiCostBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_elapsedTime,
+		ec.fieldContext_AiCostBucket_key,
 		func(ctx context.Context) (any, error) {
-			return obj.ElapsedTime, nil
+			return obj.Key, nil
 		},
 		nil,
-		ec.marshalNInt2int,
-		true,
+		ec.marshalNString2string,
 		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_AiExecution_elapsedTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _AiExecution_outputText(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AiExecution_outputText,
-		func(ctx context.Context) (any, error) {
-			return obj.OutputText, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_outputText(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiCostBucket_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AiCostBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1368,41 +1469,41 @@ func (ec *executionContext) fieldContext_AiExecution_outputText(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_outputImageBase64(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiCostBucket_count(ctx context.Context, field graphql.CollectedField, obj *model.AiCostBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_outputImageBase64,
+		ec.fieldContext_AiCostBucket_count,
 		func(ctx context.Context) (any, error) {
-			return obj.OutputImageBase64, nil
+			return obj.Count, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_outputImageBase64(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiCostBucket_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AiCostBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_inputTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiCostBucket_inputTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiCostBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_inputTokens,
+		ec.fieldContext_AiCostBucket_inputTokens,
 		func(ctx context.Context) (any, error) {
 			return obj.InputTokens, nil
 		},
@@ -1413,9 +1514,9 @@ func (ec *executionContext) _AiExecution_inputTokens(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_inputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiCostBucket_inputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AiCostBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1426,12 +1527,12 @@ func (ec *executionContext) fieldContext_AiExecution_inputTokens(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_outputTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiCostBucket_outputTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiCostBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_outputTokens,
+		ec.fieldContext_AiCostBucket_outputTokens,
 		func(ctx context.Context) (any, error) {
 			return obj.OutputTokens, nil
 		},
@@ -1442,9 +1543,9 @@ func (ec *executionContext) _AiExecution_outputTokens(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_outputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiCostBucket_outputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AiCostBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1455,12 +1556,12 @@ func (ec *executionContext) fieldContext_AiExecution_outputTokens(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_totalTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiCostBucket_totalTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiCostBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_totalTokens,
+		ec.fieldContext_AiCostBucket_totalTokens,
 		func(ctx context.Context) (any, error) {
 			return obj.TotalTokens, nil
 		},
@@ -1471,9 +1572,9 @@ func (ec *executionContext) _AiExecution_totalTokens(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_totalTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiCostBucket_totalTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AiCostBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1484,70 +1585,70 @@ func (ec *executionContext) fieldContext_AiExecution_totalTokens(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_runBy(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiCostBucket_imageCount(ctx context.Context, field graphql.CollectedField, obj *model.AiCostBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_runBy,
+		ec.fieldContext_AiCostBucket_imageCount,
 		func(ctx context.Context) (any, error) {
-			return obj.RunBy, nil
+			return obj.ImageCount, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_runBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiCostBucket_imageCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AiCostBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiExecution_runSajuProfileUid(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiCostBucket_cost(ctx context.Context, field graphql.CollectedField, obj *model.AiCostBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiExecution_runSajuProfileUid,
+		ec.fieldContext_AiCostBucket_cost,
 		func(ctx context.Context) (any, error) {
-			return obj.RunSajuProfileUID, nil
+			return obj.Cost, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNFloat2float64,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiExecution_runSajuProfileUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiCostBucket_cost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiExecution",
+		Object:     "AiCostBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_id(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_id(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_id,
+		ec.fieldContext_AiExecution_id,
 		func(ctx context.Context) (any, error) {
 			return obj.ID, nil
 		},
@@ -1558,9 +1659,9 @@ func (ec *executionContext) _AiMeta_id(ctx context.Context, field graphql.Collec
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1571,12 +1672,12 @@ func (ec *executionContext) fieldContext_AiMeta_id(_ context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_uid(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_uid(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_uid,
+		ec.fieldContext_AiExecution_uid,
 		func(ctx context.Context) (any, error) {
 			return obj.UID, nil
 		},
@@ -1587,9 +1688,9 @@ func (ec *executionContext) _AiMeta_uid(ctx context.Context, field graphql.Colle
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1600,12 +1701,12 @@ func (ec *executionContext) fieldContext_AiMeta_uid(_ context.Context, field gra
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_createdAt,
+		ec.fieldContext_AiExecution_createdAt,
 		func(ctx context.Context) (any, error) {
 			return obj.CreatedAt, nil
 		},
@@ -1616,9 +1717,9 @@ func (ec *executionContext) _AiMeta_createdAt(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1629,12 +1730,12 @@ func (ec *executionContext) fieldContext_AiMeta_createdAt(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_updatedAt,
+		ec.fieldContext_AiExecution_updatedAt,
 		func(ctx context.Context) (any, error) {
 			return obj.UpdatedAt, nil
 		},
@@ -1645,9 +1746,9 @@ func (ec *executionContext) _AiMeta_updatedAt(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1658,14 +1759,14 @@ func (ec *executionContext) fieldContext_AiMeta_updatedAt(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_metaType(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_metaUid(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_metaType,
+		ec.fieldContext_AiExecution_metaUid,
 		func(ctx context.Context) (any, error) {
-			return obj.MetaType, nil
+			return obj.MetaUID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1674,9 +1775,9 @@ func (ec *executionContext) _AiMeta_metaType(ctx context.Context, field graphql.
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_metaType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_metaUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1687,14 +1788,14 @@ func (ec *executionContext) fieldContext_AiMeta_metaType(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_name(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_metaType(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_name,
+		ec.fieldContext_AiExecution_metaType,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.MetaType, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1703,9 +1804,9 @@ func (ec *executionContext) _AiMeta_name(ctx context.Context, field graphql.Coll
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_metaType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1716,14 +1817,14 @@ func (ec *executionContext) fieldContext_AiMeta_name(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_desc(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_status(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_desc,
+		ec.fieldContext_AiExecution_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Desc, nil
+			return obj.Status, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1732,9 +1833,9 @@ func (ec *executionContext) _AiMeta_desc(ctx context.Context, field graphql.Coll
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_desc(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1745,14 +1846,14 @@ func (ec *executionContext) fieldContext_AiMeta_desc(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_prompt(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_errorMessage(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_prompt,
+		ec.fieldContext_AiExecution_errorMessage,
 		func(ctx context.Context) (any, error) {
-			return obj.Prompt, nil
+			return obj.ErrorMessage, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1761,9 +1862,9 @@ func (ec *executionContext) _AiMeta_prompt(ctx context.Context, field graphql.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_prompt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_errorMessage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1774,14 +1875,14 @@ func (ec *executionContext) fieldContext_AiMeta_prompt(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_model(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_prompt(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_model,
+		ec.fieldContext_AiExecution_prompt,
 		func(ctx context.Context) (any, error) {
-			return obj.Model, nil
+			return obj.Prompt, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1790,9 +1891,9 @@ func (ec *executionContext) _AiMeta_model(ctx context.Context, field graphql.Col
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_model(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_prompt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1803,199 +1904,211 @@ func (ec *executionContext) fieldContext_AiMeta_model(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_temperature(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_valued_prompt(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_temperature,
+		ec.fieldContext_AiExecution_valued_prompt,
 		func(ctx context.Context) (any, error) {
-			return obj.Temperature, nil
+			return obj.ValuedPrompt, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_temperature(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_valued_prompt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_maxTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_inputkvs(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_maxTokens,
+		ec.fieldContext_AiExecution_inputkvs,
 		func(ctx context.Context) (any, error) {
-			return obj.MaxTokens, nil
+			return obj.Inputkvs, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNKV2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐKvᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_maxTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_inputkvs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "k":
+				return ec.fieldContext_KV_k(ctx, field)
+			case "v":
+				return ec.fieldContext_KV_v(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type KV", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_size(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_outputkvs(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_size,
+		ec.fieldContext_AiExecution_outputkvs,
 		func(ctx context.Context) (any, error) {
-			return obj.Size, nil
+			return obj.Outputkvs, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNKV2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐKvᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_outputkvs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "k":
+				return ec.fieldContext_KV_k(ctx, field)
+			case "v":
+				return ec.fieldContext_KV_v(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type KV", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMeta_inUse(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_model(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMeta_inUse,
+		ec.fieldContext_AiExecution_model,
 		func(ctx context.Context) (any, error) {
-			return obj.InUse, nil
+			return obj.Model, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMeta_inUse(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_model(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMeta",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMetaType_id(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_temperature(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMetaType_id,
+		ec.fieldContext_AiExecution_temperature,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Temperature, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMetaType_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_temperature(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMetaType",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMetaType_type(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_maxTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMetaType_type,
+		ec.fieldContext_AiExecution_maxTokens,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.MaxTokens, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMetaType_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_maxTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMetaType",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMetaType_inputFields(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_size(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMetaType_inputFields,
+		ec.fieldContext_AiExecution_size,
 		func(ctx context.Context) (any, error) {
-			return obj.InputFields, nil
+			return obj.Size, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMetaType_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMetaType",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2006,25 +2119,25 @@ func (ec *executionContext) fieldContext_AiMetaType_inputFields(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMetaType_outputFields(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_inputImageBase64(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMetaType_outputFields,
+		ec.fieldContext_AiExecution_inputImageBase64,
 		func(ctx context.Context) (any, error) {
-			return obj.OutputFields, nil
+			return obj.InputImageBase64, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMetaType_outputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_inputImageBase64(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMetaType",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2035,138 +2148,130 @@ func (ec *executionContext) fieldContext_AiMetaType_outputFields(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMetaType_hasInputImage(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_elapsedTime(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMetaType_hasInputImage,
+		ec.fieldContext_AiExecution_elapsedTime,
 		func(ctx context.Context) (any, error) {
-			return obj.HasInputImage, nil
+			return obj.ElapsedTime, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMetaType_hasInputImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_elapsedTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMetaType",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AiMetaType_hasOutputImage(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_outputText(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AiMetaType_hasOutputImage,
+		ec.fieldContext_AiExecution_outputText,
 		func(ctx context.Context) (any, error) {
-			return obj.HasOutputImage, nil
+			return obj.OutputText, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AiMetaType_hasOutputImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_outputText(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AiMetaType",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ChemiGenerationResponse_targets(ctx context.Context, field graphql.CollectedField, obj *model.ChemiGenerationResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_outputImageBase64(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ChemiGenerationResponse_targets,
+		ec.fieldContext_AiExecution_outputImageBase64,
 		func(ctx context.Context) (any, error) {
-			return obj.Targets, nil
+			return obj.OutputImageBase64, nil
 		},
 		nil,
-		ec.marshalNChemiGenerationTargetOutput2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐChemiGenerationTargetOutputᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ChemiGenerationResponse_targets(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_outputImageBase64(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ChemiGenerationResponse",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "perspective":
-				return ec.fieldContext_ChemiGenerationTargetOutput_perspective(ctx, field)
-			case "max_chars":
-				return ec.fieldContext_ChemiGenerationTargetOutput_max_chars(ctx, field)
-			case "result":
-				return ec.fieldContext_ChemiGenerationTargetOutput_result(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ChemiGenerationTargetOutput", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ChemiGenerationTargetOutput_perspective(ctx context.Context, field graphql.CollectedField, obj *model.ChemiGenerationTargetOutput) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_inputTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ChemiGenerationTargetOutput_perspective,
+		ec.fieldContext_AiExecution_inputTokens,
 		func(ctx context.Context) (any, error) {
-			return obj.Perspective, nil
+			return obj.InputTokens, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ChemiGenerationTargetOutput_perspective(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_inputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ChemiGenerationTargetOutput",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ChemiGenerationTargetOutput_max_chars(ctx context.Context, field graphql.CollectedField, obj *model.ChemiGenerationTargetOutput) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_outputTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ChemiGenerationTargetOutput_max_chars,
+		ec.fieldContext_AiExecution_outputTokens,
 		func(ctx context.Context) (any, error) {
-			return obj.MaxChars, nil
+			return obj.OutputTokens, nil
 		},
 		nil,
 		ec.marshalNInt2int,
@@ -2175,9 +2280,9 @@ func (ec *executionContext) _ChemiGenerationTargetOutput_max_chars(ctx context.C
 	)
 }
 
-func (ec *executionContext) fieldContext_ChemiGenerationTargetOutput_max_chars(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_outputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ChemiGenerationTargetOutput",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2188,112 +2293,112 @@ func (ec *executionContext) fieldContext_ChemiGenerationTargetOutput_max_chars(_
 	return fc, nil
 }
 
-func (ec *executionContext) _ChemiGenerationTargetOutput_result(ctx context.Context, field graphql.CollectedField, obj *model.ChemiGenerationTargetOutput) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_totalTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ChemiGenerationTargetOutput_result,
+		ec.fieldContext_AiExecution_totalTokens,
 		func(ctx context.Context) (any, error) {
-			return obj.Result, nil
+			return obj.TotalTokens, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ChemiGenerationTargetOutput_result(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_totalTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ChemiGenerationTargetOutput",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_id(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_imageCount(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_id,
+		ec.fieldContext_AiExecution_imageCount,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.ImageCount, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_imageCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_uid(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_cost(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_uid,
+		ec.fieldContext_AiExecution_cost,
 		func(ctx context.Context) (any, error) {
-			return obj.UID, nil
+			return obj.Cost, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_cost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_cardId(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_runBy(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_cardId,
+		ec.fieldContext_AiExecution_runBy,
 		func(ctx context.Context) (any, error) {
-			return obj.CardID, nil
+			return obj.RunBy, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_cardId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_runBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2304,72 +2409,72 @@ func (ec *executionContext) fieldContext_ItemNCard_cardId(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_version(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiExecution_runSajuProfileUid(ctx context.Context, field graphql.CollectedField, obj *model.AiExecution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_version,
+		ec.fieldContext_AiExecution_runSajuProfileUid,
 		func(ctx context.Context) (any, error) {
-			return obj.Version, nil
+			return obj.RunSajuProfileUID, nil
 		},
 		nil,
-		ec.marshalNInt2int,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiExecution_runSajuProfileUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiExecution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_status(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_id(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_status,
+		ec.fieldContext_AiMeta_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_ruleSet(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_uid(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_ruleSet,
+		ec.fieldContext_AiMeta_uid,
 		func(ctx context.Context) (any, error) {
-			return obj.RuleSet, nil
+			return obj.UID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2378,9 +2483,9 @@ func (ec *executionContext) _ItemNCard_ruleSet(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_ruleSet(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2391,72 +2496,72 @@ func (ec *executionContext) fieldContext_ItemNCard_ruleSet(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_scope(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_scope,
+		ec.fieldContext_AiMeta_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Scope, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBigInt2int64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_scope(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_title(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_title,
+		ec.fieldContext_AiMeta_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Title, nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBigInt2int64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_category(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_metaType(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_category,
+		ec.fieldContext_AiMeta_metaType,
 		func(ctx context.Context) (any, error) {
-			return obj.Category, nil
+			return obj.MetaType, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2465,9 +2570,9 @@ func (ec *executionContext) _ItemNCard_category(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_metaType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2478,25 +2583,25 @@ func (ec *executionContext) fieldContext_ItemNCard_category(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_tags(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_name(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_tags,
+		ec.fieldContext_AiMeta_name,
 		func(ctx context.Context) (any, error) {
-			return obj.Tags, nil
+			return obj.Name, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2507,25 +2612,25 @@ func (ec *executionContext) fieldContext_ItemNCard_tags(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_domains(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_desc(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_domains,
+		ec.fieldContext_AiMeta_desc,
 		func(ctx context.Context) (any, error) {
-			return obj.Domains, nil
+			return obj.Desc, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_domains(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_desc(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2536,43 +2641,43 @@ func (ec *executionContext) fieldContext_ItemNCard_domains(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_priority(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_prompt(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_priority,
+		ec.fieldContext_AiMeta_prompt,
 		func(ctx context.Context) (any, error) {
-			return obj.Priority, nil
+			return obj.Prompt, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_prompt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_triggerJson(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_model(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_triggerJson,
+		ec.fieldContext_AiMeta_model,
 		func(ctx context.Context) (any, error) {
-			return obj.TriggerJSON, nil
+			return obj.Model, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2581,9 +2686,9 @@ func (ec *executionContext) _ItemNCard_triggerJson(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_triggerJson(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_model(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2594,72 +2699,72 @@ func (ec *executionContext) fieldContext_ItemNCard_triggerJson(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_scoreJson(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_temperature(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_scoreJson,
+		ec.fieldContext_AiMeta_temperature,
 		func(ctx context.Context) (any, error) {
-			return obj.ScoreJSON, nil
+			return obj.Temperature, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_scoreJson(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_temperature(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_contentJson(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_maxTokens(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_contentJson,
+		ec.fieldContext_AiMeta_maxTokens,
 		func(ctx context.Context) (any, error) {
-			return obj.ContentJSON, nil
+			return obj.MaxTokens, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_contentJson(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_maxTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_cooldownGroup(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_size(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_cooldownGroup,
+		ec.fieldContext_AiMeta_size,
 		func(ctx context.Context) (any, error) {
-			return obj.CooldownGroup, nil
+			return obj.Size, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2668,9 +2773,9 @@ func (ec *executionContext) _ItemNCard_cooldownGroup(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_cooldownGroup(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2681,257 +2786,265 @@ func (ec *executionContext) fieldContext_ItemNCard_cooldownGroup(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_maxPerUser(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMeta_inUse(ctx context.Context, field graphql.CollectedField, obj *model.AiMeta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_maxPerUser,
+		ec.fieldContext_AiMeta_inUse,
 		func(ctx context.Context) (any, error) {
-			return obj.MaxPerUser, nil
+			return obj.InUse, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_maxPerUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMeta_inUse(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMeta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_debugJson(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMetaType_id(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_debugJson,
+		ec.fieldContext_AiMetaType_id,
 		func(ctx context.Context) (any, error) {
-			return obj.DebugJSON, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_debugJson(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMetaType_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMetaType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMetaType_type(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_deletedAt,
+		ec.fieldContext_AiMetaType_type,
 		func(ctx context.Context) (any, error) {
-			return obj.DeletedAt, nil
+			return obj.Type, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMetaType_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMetaType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMetaType_inputFields(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_createdAt,
+		ec.fieldContext_AiMetaType_inputFields,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.InputFields, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2ᚕstringᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMetaType_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMetaType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ItemNCard_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMetaType_outputFields(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ItemNCard_updatedAt,
+		ec.fieldContext_AiMetaType_outputFields,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.OutputFields, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2ᚕstringᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ItemNCard_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMetaType_outputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ItemNCard",
+		Object:     "AiMetaType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _KV_k(ctx context.Context, field graphql.CollectedField, obj *model.Kv) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMetaType_hasInputImage(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_KV_k,
+		ec.fieldContext_AiMetaType_hasInputImage,
 		func(ctx context.Context) (any, error) {
-			return obj.K, nil
+			return obj.HasInputImage, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_KV_k(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMetaType_hasInputImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "KV",
+		Object:     "AiMetaType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _KV_v(ctx context.Context, field graphql.CollectedField, obj *model.Kv) (ret graphql.Marshaler) {
+func (ec *executionContext) _AiMetaType_hasOutputImage(ctx context.Context, field graphql.CollectedField, obj *model.AiMetaType) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_KV_v,
+		ec.fieldContext_AiMetaType_hasOutputImage,
 		func(ctx context.Context) (any, error) {
-			return obj.V, nil
+			return obj.HasOutputImage, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_KV_v(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AiMetaType_hasOutputImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "KV",
+		Object:     "AiMetaType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LLMRequestResult_id(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChemiGenerationResponse_targets(ctx context.Context, field graphql.CollectedField, obj *model.ChemiGenerationResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LLMRequestResult_id,
+		ec.fieldContext_ChemiGenerationResponse_targets,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Targets, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNChemiGenerationTargetOutput2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐChemiGenerationTargetOutputᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LLMRequestResult_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChemiGenerationResponse_targets(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LLMRequestResult",
+		Object:     "ChemiGenerationResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "perspective":
+				return ec.fieldContext_ChemiGenerationTargetOutput_perspective(ctx, field)
+			case "max_chars":
+				return ec.fieldContext_ChemiGenerationTargetOutput_max_chars(ctx, field)
+			case "result":
+				return ec.fieldContext_ChemiGenerationTargetOutput_result(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChemiGenerationTargetOutput", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LLMRequestResult_responseText(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChemiGenerationTargetOutput_perspective(ctx context.Context, field graphql.CollectedField, obj *model.ChemiGenerationTargetOutput) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LLMRequestResult_responseText,
+		ec.fieldContext_ChemiGenerationTargetOutput_perspective,
 		func(ctx context.Context) (any, error) {
-			return obj.ResponseText, nil
+			return obj.Perspective, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LLMRequestResult_responseText(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChemiGenerationTargetOutput_perspective(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LLMRequestResult",
+		Object:     "ChemiGenerationTargetOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2942,25 +3055,25 @@ func (ec *executionContext) fieldContext_LLMRequestResult_responseText(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _LLMRequestResult_inputTokens(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChemiGenerationTargetOutput_max_chars(ctx context.Context, field graphql.CollectedField, obj *model.ChemiGenerationTargetOutput) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LLMRequestResult_inputTokens,
+		ec.fieldContext_ChemiGenerationTargetOutput_max_chars,
 		func(ctx context.Context) (any, error) {
-			return obj.InputTokens, nil
+			return obj.MaxChars, nil
 		},
 		nil,
-		ec.marshalOInt2ᚖint,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LLMRequestResult_inputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChemiGenerationTargetOutput_max_chars(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LLMRequestResult",
+		Object:     "ChemiGenerationTargetOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2971,83 +3084,83 @@ func (ec *executionContext) fieldContext_LLMRequestResult_inputTokens(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _LLMRequestResult_outputTokens(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChemiGenerationTargetOutput_result(ctx context.Context, field graphql.CollectedField, obj *model.ChemiGenerationTargetOutput) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LLMRequestResult_outputTokens,
+		ec.fieldContext_ChemiGenerationTargetOutput_result,
 		func(ctx context.Context) (any, error) {
-			return obj.OutputTokens, nil
+			return obj.Result, nil
 		},
 		nil,
-		ec.marshalOInt2ᚖint,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LLMRequestResult_outputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChemiGenerationTargetOutput_result(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LLMRequestResult",
+		Object:     "ChemiGenerationTargetOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LLMRequestResult_totalTokens(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_id(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LLMRequestResult_totalTokens,
+		ec.fieldContext_ItemNCard_id,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalTokens, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalOInt2ᚖint,
+		ec.marshalOID2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LLMRequestResult_totalTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LLMRequestResult",
+		Object:     "ItemNCard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LLMRequestResult_errorMessage(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_uid(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LLMRequestResult_errorMessage,
+		ec.fieldContext_ItemNCard_uid,
 		func(ctx context.Context) (any, error) {
-			return obj.ErrorMessage, nil
+			return obj.UID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LLMRequestResult_errorMessage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LLMRequestResult",
+		Object:     "ItemNCard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3058,130 +3171,130 @@ func (ec *executionContext) fieldContext_LLMRequestResult_errorMessage(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _LocalLog_id(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_cardId(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LocalLog_id,
+		ec.fieldContext_ItemNCard_cardId,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.CardID, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LocalLog_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_cardId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LocalLog",
+		Object:     "ItemNCard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LocalLog_uid(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_version(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LocalLog_uid,
+		ec.fieldContext_ItemNCard_version,
 		func(ctx context.Context) (any, error) {
-			return obj.UID, nil
+			return obj.Version, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_LocalLog_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LocalLog",
+		Object:     "ItemNCard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LocalLog_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_status(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LocalLog_createdAt,
+		ec.fieldContext_ItemNCard_status,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_LocalLog_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LocalLog",
+		Object:     "ItemNCard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LocalLog_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_ruleSet(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LocalLog_expiresAt,
+		ec.fieldContext_ItemNCard_ruleSet,
 		func(ctx context.Context) (any, error) {
-			return obj.ExpiresAt, nil
+			return obj.RuleSet, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_LocalLog_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_ruleSet(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LocalLog",
+		Object:     "ItemNCard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LocalLog_status(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_scope(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LocalLog_status,
+		ec.fieldContext_ItemNCard_scope,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.Scope, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -3190,9 +3303,9 @@ func (ec *executionContext) _LocalLog_status(ctx context.Context, field graphql.
 	)
 }
 
-func (ec *executionContext) fieldContext_LocalLog_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_scope(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LocalLog",
+		Object:     "ItemNCard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3203,14 +3316,14 @@ func (ec *executionContext) fieldContext_LocalLog_status(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _LocalLog_text(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_title(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LocalLog_text,
+		ec.fieldContext_ItemNCard_title,
 		func(ctx context.Context) (any, error) {
-			return obj.Text, nil
+			return obj.Title, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -3219,9 +3332,9 @@ func (ec *executionContext) _LocalLog_text(ctx context.Context, field graphql.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_LocalLog_text(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LocalLog",
+		Object:     "ItemNCard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3232,419 +3345,2051 @@ func (ec *executionContext) fieldContext_LocalLog_text(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_category(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_login,
+		ec.fieldContext_ItemNCard_category,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().Login(ctx, fc.Args["email"].(string), fc.Args["password"].(string), fc.Args["otp"].(string))
+			return obj.Category, nil
 		},
 		nil,
-		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ItemNCard",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ok":
-				return ec.fieldContext_SimpleResult_ok(ctx, field)
-			case "uid":
-				return ec.fieldContext_SimpleResult_uid(ctx, field)
-			case "err":
-				return ec.fieldContext_SimpleResult_err(ctx, field)
-			case "msg":
-				return ec.fieldContext_SimpleResult_msg(ctx, field)
-			case "value":
-				return ec.fieldContext_SimpleResult_value(ctx, field)
-			case "base64Value":
-				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
-			case "node":
-				return ec.fieldContext_SimpleResult_node(ctx, field)
-			case "nodes":
-				return ec.fieldContext_SimpleResult_nodes(ctx, field)
-			case "kvs":
-				return ec.fieldContext_SimpleResult_kvs(ctx, field)
-			case "total":
-				return ec.fieldContext_SimpleResult_total(ctx, field)
-			case "limit":
-				return ec.fieldContext_SimpleResult_limit(ctx, field)
-			case "offset":
-				return ec.fieldContext_SimpleResult_offset(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_tags(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_logout,
+		ec.fieldContext_ItemNCard_tags,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Mutation().Logout(ctx)
+			return obj.Tags, nil
 		},
 		nil,
-		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		ec.marshalNString2ᚕstringᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_logout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ItemNCard",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ok":
-				return ec.fieldContext_SimpleResult_ok(ctx, field)
-			case "uid":
-				return ec.fieldContext_SimpleResult_uid(ctx, field)
-			case "err":
-				return ec.fieldContext_SimpleResult_err(ctx, field)
-			case "msg":
-				return ec.fieldContext_SimpleResult_msg(ctx, field)
-			case "value":
-				return ec.fieldContext_SimpleResult_value(ctx, field)
-			case "base64Value":
-				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
-			case "node":
-				return ec.fieldContext_SimpleResult_node(ctx, field)
-			case "nodes":
-				return ec.fieldContext_SimpleResult_nodes(ctx, field)
-			case "kvs":
-				return ec.fieldContext_SimpleResult_kvs(ctx, field)
-			case "total":
-				return ec.fieldContext_SimpleResult_total(ctx, field)
-			case "limit":
-				return ec.fieldContext_SimpleResult_limit(ctx, field)
-			case "offset":
-				return ec.fieldContext_SimpleResult_offset(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createAdminUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_domains(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createAdminUser,
+		ec.fieldContext_ItemNCard_domains,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().CreateAdminUser(ctx, fc.Args["email"].(string), fc.Args["password"].(string))
+			return obj.Domains, nil
 		},
 		nil,
-		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		ec.marshalNString2ᚕstringᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createAdminUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_domains(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ItemNCard",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ok":
-				return ec.fieldContext_SimpleResult_ok(ctx, field)
-			case "uid":
-				return ec.fieldContext_SimpleResult_uid(ctx, field)
-			case "err":
-				return ec.fieldContext_SimpleResult_err(ctx, field)
-			case "msg":
-				return ec.fieldContext_SimpleResult_msg(ctx, field)
-			case "value":
-				return ec.fieldContext_SimpleResult_value(ctx, field)
-			case "base64Value":
-				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
-			case "node":
-				return ec.fieldContext_SimpleResult_node(ctx, field)
-			case "nodes":
-				return ec.fieldContext_SimpleResult_nodes(ctx, field)
-			case "kvs":
-				return ec.fieldContext_SimpleResult_kvs(ctx, field)
-			case "total":
-				return ec.fieldContext_SimpleResult_total(ctx, field)
-			case "limit":
-				return ec.fieldContext_SimpleResult_limit(ctx, field)
-			case "offset":
-				return ec.fieldContext_SimpleResult_offset(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createAdminUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setAdminUserActive(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_priority(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_setAdminUserActive,
+		ec.fieldContext_ItemNCard_priority,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().SetAdminUserActive(ctx, fc.Args["uid"].(string), fc.Args["active"].(bool))
+			return obj.Priority, nil
 		},
 		nil,
-		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setAdminUserActive(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ItemNCard",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ok":
-				return ec.fieldContext_SimpleResult_ok(ctx, field)
-			case "uid":
-				return ec.fieldContext_SimpleResult_uid(ctx, field)
-			case "err":
-				return ec.fieldContext_SimpleResult_err(ctx, field)
-			case "msg":
-				return ec.fieldContext_SimpleResult_msg(ctx, field)
-			case "value":
-				return ec.fieldContext_SimpleResult_value(ctx, field)
-			case "base64Value":
-				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
-			case "node":
-				return ec.fieldContext_SimpleResult_node(ctx, field)
-			case "nodes":
-				return ec.fieldContext_SimpleResult_nodes(ctx, field)
-			case "kvs":
-				return ec.fieldContext_SimpleResult_kvs(ctx, field)
-			case "total":
-				return ec.fieldContext_SimpleResult_total(ctx, field)
-			case "limit":
-				return ec.fieldContext_SimpleResult_limit(ctx, field)
-			case "offset":
-				return ec.fieldContext_SimpleResult_offset(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setAdminUserActive_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateAdminUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_triggerJson(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateAdminUser,
+		ec.fieldContext_ItemNCard_triggerJson,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().UpdateAdminUser(ctx, fc.Args["uid"].(string), fc.Args["email"].(string), fc.Args["password"].(string))
+			return obj.TriggerJSON, nil
 		},
 		nil,
-		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateAdminUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_triggerJson(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ItemNCard",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ok":
-				return ec.fieldContext_SimpleResult_ok(ctx, field)
-			case "uid":
-				return ec.fieldContext_SimpleResult_uid(ctx, field)
-			case "err":
-				return ec.fieldContext_SimpleResult_err(ctx, field)
-			case "msg":
-				return ec.fieldContext_SimpleResult_msg(ctx, field)
-			case "value":
-				return ec.fieldContext_SimpleResult_value(ctx, field)
-			case "base64Value":
-				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
-			case "node":
-				return ec.fieldContext_SimpleResult_node(ctx, field)
-			case "nodes":
-				return ec.fieldContext_SimpleResult_nodes(ctx, field)
-			case "kvs":
-				return ec.fieldContext_SimpleResult_kvs(ctx, field)
-			case "total":
-				return ec.fieldContext_SimpleResult_total(ctx, field)
-			case "limit":
-				return ec.fieldContext_SimpleResult_limit(ctx, field)
-			case "offset":
-				return ec.fieldContext_SimpleResult_offset(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateAdminUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createSajuProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_scoreJson(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createSajuProfile,
+		ec.fieldContext_ItemNCard_scoreJson,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().CreateSajuProfile(ctx, fc.Args["input"].(model.SajuProfileCreateInput))
+			return obj.ScoreJSON, nil
 		},
 		nil,
-		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createSajuProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_scoreJson(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ItemNCard",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "ok":
-				return ec.fieldContext_SimpleResult_ok(ctx, field)
-			case "uid":
-				return ec.fieldContext_SimpleResult_uid(ctx, field)
-			case "err":
-				return ec.fieldContext_SimpleResult_err(ctx, field)
-			case "msg":
-				return ec.fieldContext_SimpleResult_msg(ctx, field)
-			case "value":
-				return ec.fieldContext_SimpleResult_value(ctx, field)
-			case "base64Value":
-				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
-			case "node":
-				return ec.fieldContext_SimpleResult_node(ctx, field)
-			case "nodes":
-				return ec.fieldContext_SimpleResult_nodes(ctx, field)
-			case "kvs":
-				return ec.fieldContext_SimpleResult_kvs(ctx, field)
-			case "total":
-				return ec.fieldContext_SimpleResult_total(ctx, field)
-			case "limit":
-				return ec.fieldContext_SimpleResult_limit(ctx, field)
-			case "offset":
-				return ec.fieldContext_SimpleResult_offset(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createSajuProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteSajuProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ItemNCard_contentJson(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteSajuProfile,
+		ec.fieldContext_ItemNCard_contentJson,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Mutation().DeleteSajuProfile(ctx, fc.Args["uid"].(string))
+			return obj.ContentJSON, nil
 		},
 		nil,
-		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteSajuProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ItemNCard_contentJson(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ItemNCard",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ItemNCard_cooldownGroup(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ItemNCard_cooldownGroup,
+		func(ctx context.Context) (any, error) {
+			return obj.CooldownGroup, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ItemNCard_cooldownGroup(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ItemNCard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ItemNCard_maxPerUser(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ItemNCard_maxPerUser,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxPerUser, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ItemNCard_maxPerUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ItemNCard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ItemNCard_debugJson(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ItemNCard_debugJson,
+		func(ctx context.Context) (any, error) {
+			return obj.DebugJSON, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ItemNCard_debugJson(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ItemNCard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ItemNCard_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ItemNCard_deletedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ItemNCard_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ItemNCard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ItemNCard_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ItemNCard_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ItemNCard_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ItemNCard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ItemNCard_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.ItemNCard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ItemNCard_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ItemNCard_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ItemNCard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_id(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_uid(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_uid,
+		func(ctx context.Context) (any, error) {
+			return obj.UID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_type(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_refUid(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_refUid,
+		func(ctx context.Context) (any, error) {
+			return obj.RefUID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_refUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_status(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_steps(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_steps,
+		func(ctx context.Context) (any, error) {
+			return obj.Steps, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_steps(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_attempts(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_attempts,
+		func(ctx context.Context) (any, error) {
+			return obj.Attempts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_attempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_maxAttempts(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_maxAttempts,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxAttempts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_maxAttempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_runAfter(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_runAfter,
+		func(ctx context.Context) (any, error) {
+			return obj.RunAfter, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_runAfter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_leaseOwner(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_leaseOwner,
+		func(ctx context.Context) (any, error) {
+			return obj.LeaseOwner, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_leaseOwner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_leaseUntil(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_leaseUntil,
+		func(ctx context.Context) (any, error) {
+			return obj.LeaseUntil, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_leaseUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_lastError(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_lastError,
+		func(ctx context.Context) (any, error) {
+			return obj.LastError, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_lastError(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Job_finishedAt(ctx context.Context, field graphql.CollectedField, obj *model.Job) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Job_finishedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.FinishedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Job_finishedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Job",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KV_k(ctx context.Context, field graphql.CollectedField, obj *model.Kv) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_KV_k,
+		func(ctx context.Context) (any, error) {
+			return obj.K, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_KV_k(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KV",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KV_v(ctx context.Context, field graphql.CollectedField, obj *model.Kv) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_KV_v,
+		func(ctx context.Context) (any, error) {
+			return obj.V, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_KV_v(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KV",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LLMRequestResult_id(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LLMRequestResult_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LLMRequestResult_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LLMRequestResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LLMRequestResult_responseText(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LLMRequestResult_responseText,
+		func(ctx context.Context) (any, error) {
+			return obj.ResponseText, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LLMRequestResult_responseText(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LLMRequestResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LLMRequestResult_inputTokens(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LLMRequestResult_inputTokens,
+		func(ctx context.Context) (any, error) {
+			return obj.InputTokens, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LLMRequestResult_inputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LLMRequestResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LLMRequestResult_outputTokens(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LLMRequestResult_outputTokens,
+		func(ctx context.Context) (any, error) {
+			return obj.OutputTokens, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LLMRequestResult_outputTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LLMRequestResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LLMRequestResult_totalTokens(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LLMRequestResult_totalTokens,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalTokens, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LLMRequestResult_totalTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LLMRequestResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LLMRequestResult_errorMessage(ctx context.Context, field graphql.CollectedField, obj *model.LLMRequestResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LLMRequestResult_errorMessage,
+		func(ctx context.Context) (any, error) {
+			return obj.ErrorMessage, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LLMRequestResult_errorMessage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LLMRequestResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LocalLog_id(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LocalLog_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LocalLog_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LocalLog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LocalLog_uid(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LocalLog_uid,
+		func(ctx context.Context) (any, error) {
+			return obj.UID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LocalLog_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LocalLog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LocalLog_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LocalLog_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LocalLog_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LocalLog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LocalLog_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LocalLog_expiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiresAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LocalLog_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LocalLog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LocalLog_status(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LocalLog_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LocalLog_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LocalLog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LocalLog_text(ctx context.Context, field graphql.CollectedField, obj *model.LocalLog) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LocalLog_text,
+		func(ctx context.Context) (any, error) {
+			return obj.Text, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LocalLog_text(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LocalLog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_login,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().Login(ctx, fc.Args["email"].(string), fc.Args["password"].(string), fc.Args["otp"].(string))
+		},
+		nil,
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_logout,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Mutation().Logout(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_logout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createAdminUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createAdminUser,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().CreateAdminUser(ctx, fc.Args["email"].(string), fc.Args["password"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "admin_user:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createAdminUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createAdminUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setAdminUserActive(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_setAdminUserActive,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().SetAdminUserActive(ctx, fc.Args["uid"].(string), fc.Args["active"].(bool))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "admin_user:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setAdminUserActive(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setAdminUserActive_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateAdminUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateAdminUser,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().UpdateAdminUser(ctx, fc.Args["uid"].(string), fc.Args["email"].(string), fc.Args["password"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "admin_user:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateAdminUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateAdminUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setAdminUserRole(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_setAdminUserRole,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().SetAdminUserRole(ctx, fc.Args["uid"].(string), fc.Args["role"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "admin_user:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setAdminUserRole(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setAdminUserRole_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createSajuProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createSajuProfile,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().CreateSajuProfile(ctx, fc.Args["input"].(model.SajuProfileCreateInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "saju_profile:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createSajuProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createSajuProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteSajuProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_deleteSajuProfile,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().DeleteSajuProfile(ctx, fc.Args["uid"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "saju_profile:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteSajuProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteSajuProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_retryJob(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_retryJob,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().RetryJob(ctx, fc.Args["uid"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "saju_profile:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_retryJob(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_retryJob_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_purgeJobPayload(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_purgeJobPayload,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().PurgeJobPayload(ctx, fc.Args["uid"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "saju_profile:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_purgeJobPayload(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "ok":
@@ -3682,7 +5427,7 @@ func (ec *executionContext) fieldContext_Mutation_deleteSajuProfile(ctx context.
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteSajuProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_purgeJobPayload_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -3699,7 +5444,32 @@ func (ec *executionContext) _Mutation_createPhyIdealPartner(ctx context.Context,
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().CreatePhyIdealPartner(ctx, fc.Args["input"].(model.PhyIdealPartnerCreateInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "phy_partner:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -3766,7 +5536,32 @@ func (ec *executionContext) _Mutation_deletePhyIdealPartner(ctx context.Context,
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().DeletePhyIdealPartner(ctx, fc.Args["uid"].(string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "phy_partner:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -3833,7 +5628,32 @@ func (ec *executionContext) _Mutation_putAiMeta(ctx context.Context, field graph
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().PutAiMeta(ctx, fc.Args["input"].(model.AiMetaInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "ai_meta:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -3900,7 +5720,32 @@ func (ec *executionContext) _Mutation_setAiMetaInUse(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().SetAiMetaInUse(ctx, fc.Args["uid"].(string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "ai_meta:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -3967,7 +5812,32 @@ func (ec *executionContext) _Mutation_delAiMeta(ctx context.Context, field graph
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().DelAiMeta(ctx, fc.Args["uid"].(string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "ai_meta:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -4034,7 +5904,32 @@ func (ec *executionContext) _Mutation_setAiMetaDefault(ctx context.Context, fiel
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().SetAiMetaDefault(ctx, fc.Args["uid"].(string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "ai_meta:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -4101,7 +5996,32 @@ func (ec *executionContext) _Mutation_runAiExecution(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().RunAiExecution(ctx, fc.Args["input"].(model.AiExcutionInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "ai_execution:run")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -4168,7 +6088,32 @@ func (ec *executionContext) _Mutation_createItemnCard(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().CreateItemnCard(ctx, fc.Args["input"].(model.ItemNCardInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "itemn_card:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -4235,7 +6180,32 @@ func (ec *executionContext) _Mutation_updateItemnCard(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().UpdateItemnCard(ctx, fc.Args["uid"].(string), fc.Args["input"].(model.ItemNCardInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "itemn_card:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -4302,7 +6272,32 @@ func (ec *executionContext) _Mutation_deleteItemnCard(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().DeleteItemnCard(ctx, fc.Args["uid"].(string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "itemn_card:write")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -4369,7 +6364,32 @@ func (ec *executionContext) _Mutation_runSajuGeneration(ctx context.Context, fie
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().RunSajuGeneration(ctx, fc.Args["input"].(model.SajuGenerationRequest))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SajuGenerationResponse
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "llm:generate")
+				if err != nil {
+					var zeroVal *model.SajuGenerationResponse
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SajuGenerationResponse
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalNSajuGenerationResponse2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSajuGenerationResponse,
 		true,
 		true,
@@ -4414,7 +6434,32 @@ func (ec *executionContext) _Mutation_runChemiGeneration(ctx context.Context, fi
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().RunChemiGeneration(ctx, fc.Args["input"].(model.ChemiGenerationRequest))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.ChemiGenerationResponse
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "llm:generate")
+				if err != nil {
+					var zeroVal *model.ChemiGenerationResponse
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.ChemiGenerationResponse
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalNChemiGenerationResponse2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐChemiGenerationResponse,
 		true,
 		true,
@@ -4459,7 +6504,32 @@ func (ec *executionContext) _Mutation_sendLLMRequest(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Mutation().SendLLMRequest(ctx, fc.Args["input"].(model.SendLLMRequestInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "llm:generate")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -4516,43 +6586,188 @@ func (ec *executionContext) fieldContext_Mutation_sendLLMRequest(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_id(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_id(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PhyIdealPartner_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PhyIdealPartner_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PhyIdealPartner",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PhyIdealPartner_uid(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PhyIdealPartner_uid,
+		func(ctx context.Context) (any, error) {
+			return obj.UID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PhyIdealPartner_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PhyIdealPartner",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PhyIdealPartner_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PhyIdealPartner_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PhyIdealPartner_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PhyIdealPartner",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PhyIdealPartner_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PhyIdealPartner_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PhyIdealPartner_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PhyIdealPartner",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PhyIdealPartner_summary(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PhyIdealPartner_summary,
+		func(ctx context.Context) (any, error) {
+			return obj.Summary, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PhyIdealPartner_summary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PhyIdealPartner",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PhyIdealPartner_featureEyes(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_id,
+		ec.fieldContext_PhyIdealPartner_featureEyes,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.FeatureEyes, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_featureEyes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_uid(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_featureNose(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_uid,
+		ec.fieldContext_PhyIdealPartner_featureNose,
 		func(ctx context.Context) (any, error) {
-			return obj.UID, nil
+			return obj.FeatureNose, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -4561,7 +6776,7 @@ func (ec *executionContext) _PhyIdealPartner_uid(ctx context.Context, field grap
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_uid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_featureNose(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
@@ -4574,72 +6789,72 @@ func (ec *executionContext) fieldContext_PhyIdealPartner_uid(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_featureMouth(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_createdAt,
+		ec.fieldContext_PhyIdealPartner_featureMouth,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.FeatureMouth, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_featureMouth(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_featureFaceShape(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_updatedAt,
+		ec.fieldContext_PhyIdealPartner_featureFaceShape,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.FeatureFaceShape, nil
 		},
 		nil,
-		ec.marshalNBigInt2int64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_featureFaceShape(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_summary(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_personalityMatch(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_summary,
+		ec.fieldContext_PhyIdealPartner_personalityMatch,
 		func(ctx context.Context) (any, error) {
-			return obj.Summary, nil
+			return obj.PersonalityMatch, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -4648,7 +6863,7 @@ func (ec *executionContext) _PhyIdealPartner_summary(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_summary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_personalityMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
@@ -4661,14 +6876,14 @@ func (ec *executionContext) fieldContext_PhyIdealPartner_summary(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_featureEyes(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_sex(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_featureEyes,
+		ec.fieldContext_PhyIdealPartner_sex,
 		func(ctx context.Context) (any, error) {
-			return obj.FeatureEyes, nil
+			return obj.Sex, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -4677,7 +6892,7 @@ func (ec *executionContext) _PhyIdealPartner_featureEyes(ctx context.Context, fi
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_featureEyes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_sex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
@@ -4690,43 +6905,43 @@ func (ec *executionContext) fieldContext_PhyIdealPartner_featureEyes(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_featureNose(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_age(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_featureNose,
+		ec.fieldContext_PhyIdealPartner_age,
 		func(ctx context.Context) (any, error) {
-			return obj.FeatureNose, nil
+			return obj.Age, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_featureNose(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_age(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_featureMouth(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_image(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_featureMouth,
+		ec.fieldContext_PhyIdealPartner_image,
 		func(ctx context.Context) (any, error) {
-			return obj.FeatureMouth, nil
+			return ec.Resolvers.PhyIdealPartner().Image(ctx, obj)
 		},
 		nil,
 		ec.marshalNString2string,
@@ -4735,12 +6950,12 @@ func (ec *executionContext) _PhyIdealPartner_featureMouth(ctx context.Context, f
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_featureMouth(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_image(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -4748,14 +6963,14 @@ func (ec *executionContext) fieldContext_PhyIdealPartner_featureMouth(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_featureFaceShape(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_embeddingModel(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_featureFaceShape,
+		ec.fieldContext_PhyIdealPartner_embeddingModel,
 		func(ctx context.Context) (any, error) {
-			return obj.FeatureFaceShape, nil
+			return obj.EmbeddingModel, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -4764,7 +6979,7 @@ func (ec *executionContext) _PhyIdealPartner_featureFaceShape(ctx context.Contex
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_featureFaceShape(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_embeddingModel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
@@ -4777,14 +6992,14 @@ func (ec *executionContext) fieldContext_PhyIdealPartner_featureFaceShape(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_personalityMatch(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_embeddingText(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_personalityMatch,
+		ec.fieldContext_PhyIdealPartner_embeddingText,
 		func(ctx context.Context) (any, error) {
-			return obj.PersonalityMatch, nil
+			return obj.EmbeddingText, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -4793,7 +7008,7 @@ func (ec *executionContext) _PhyIdealPartner_personalityMatch(ctx context.Contex
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_personalityMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_embeddingText(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
@@ -4806,226 +7021,575 @@ func (ec *executionContext) fieldContext_PhyIdealPartner_personalityMatch(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_sex(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_similarityScore(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_sex,
+		ec.fieldContext_PhyIdealPartner_similarityScore,
 		func(ctx context.Context) (any, error) {
-			return obj.Sex, nil
+			return obj.SimilarityScore, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_sex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_similarityScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_age(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _PhyIdealPartner_hasImage(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_age,
+		ec.fieldContext_PhyIdealPartner_hasImage,
 		func(ctx context.Context) (any, error) {
-			return obj.Age, nil
+			return obj.HasImage, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_age(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PhyIdealPartner_hasImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PhyIdealPartner",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_adminUsers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_adminUsers,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().AdminUsers(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_adminUsers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_adminAuditLogs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_adminAuditLogs,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AdminAuditLogs(ctx, fc.Args["input"].(model.AdminAuditLogSearchInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				perm, err := ec.unmarshalNString2string(ctx, "audit_log:read")
+				if err != nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, err
+				}
+				if ec.Directives.HasPerm == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive hasPerm is not implemented")
+				}
+				return ec.Directives.HasPerm(ctx, nil, directive1, perm)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_adminAuditLogs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_adminAuditLogs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_image(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_sajuProfiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_image,
+		ec.fieldContext_Query_sajuProfiles,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.PhyIdealPartner().Image(ctx, obj)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().SajuProfiles(ctx, fc.Args["input"].(model.SajuProfileSearchInput))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_image(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_sajuProfiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PhyIdealPartner",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sajuProfiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_embeddingModel(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_sajuProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_embeddingModel,
+		ec.fieldContext_Query_sajuProfile,
 		func(ctx context.Context) (any, error) {
-			return obj.EmbeddingModel, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().SajuProfile(ctx, fc.Args["uid"].(string))
 		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_embeddingModel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PhyIdealPartner",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
 
-func (ec *executionContext) _PhyIdealPartner_embeddingText(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_PhyIdealPartner_embeddingText,
-		func(ctx context.Context) (any, error) {
-			return obj.EmbeddingText, nil
+			next = directive1
+			return next
 		},
-		nil,
-		ec.marshalNString2string,
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_embeddingText(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_sajuProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PhyIdealPartner",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sajuProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_similarityScore(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_sajuProfileSimilarPartners(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_similarityScore,
+		ec.fieldContext_Query_sajuProfileSimilarPartners,
 		func(ctx context.Context) (any, error) {
-			return obj.SimilarityScore, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().SajuProfileSimilarPartners(ctx, fc.Args["uid"].(string), fc.Args["limit"].(int), fc.Args["offset"].(int))
 		},
-		nil,
-		ec.marshalNFloat2float64,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_similarityScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_sajuProfileSimilarPartners(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PhyIdealPartner",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sajuProfileSimilarPartners_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PhyIdealPartner_hasImage(ctx context.Context, field graphql.CollectedField, obj *model.PhyIdealPartner) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_sajuProfileLogs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PhyIdealPartner_hasImage,
+		ec.fieldContext_Query_sajuProfileLogs,
 		func(ctx context.Context) (any, error) {
-			return obj.HasImage, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().SajuProfileLogs(ctx, fc.Args["input"].(model.SajuProfileLogSearchInput))
 		},
-		nil,
-		ec.marshalNBoolean2bool,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PhyIdealPartner_hasImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_sajuProfileLogs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PhyIdealPartner",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "ok":
+				return ec.fieldContext_SimpleResult_ok(ctx, field)
+			case "uid":
+				return ec.fieldContext_SimpleResult_uid(ctx, field)
+			case "err":
+				return ec.fieldContext_SimpleResult_err(ctx, field)
+			case "msg":
+				return ec.fieldContext_SimpleResult_msg(ctx, field)
+			case "value":
+				return ec.fieldContext_SimpleResult_value(ctx, field)
+			case "base64Value":
+				return ec.fieldContext_SimpleResult_base64Value(ctx, field)
+			case "node":
+				return ec.fieldContext_SimpleResult_node(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SimpleResult_nodes(ctx, field)
+			case "kvs":
+				return ec.fieldContext_SimpleResult_kvs(ctx, field)
+			case "total":
+				return ec.fieldContext_SimpleResult_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_SimpleResult_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_SimpleResult_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sajuProfileLogs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_adminUsers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_jobs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_adminUsers,
+		ec.fieldContext_Query_jobs,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().AdminUsers(ctx)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().Jobs(ctx, fc.Args["input"].(model.JobSearchInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_adminUsers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_jobs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5061,27 +7625,51 @@ func (ec *executionContext) fieldContext_Query_adminUsers(_ context.Context, fie
 			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_jobs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sajuProfiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_job(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_sajuProfiles,
+		ec.fieldContext_Query_job,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().SajuProfiles(ctx, fc.Args["input"].(model.SajuProfileSearchInput))
+			return ec.Resolvers.Query().Job(ctx, fc.Args["uid"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_sajuProfiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_job(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5124,31 +7712,44 @@ func (ec *executionContext) fieldContext_Query_sajuProfiles(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_sajuProfiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_job_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sajuProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_phyIdealPartners(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_sajuProfile,
+		ec.fieldContext_Query_phyIdealPartners,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().SajuProfile(ctx, fc.Args["uid"].(string))
+			return ec.Resolvers.Query().PhyIdealPartners(ctx, fc.Args["input"].(model.PhyIdealPartnerSearchInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_sajuProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_phyIdealPartners(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5191,31 +7792,44 @@ func (ec *executionContext) fieldContext_Query_sajuProfile(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_sajuProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_phyIdealPartners_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sajuProfileSimilarPartners(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_phyIdealPartner(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_sajuProfileSimilarPartners,
+		ec.fieldContext_Query_phyIdealPartner,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().SajuProfileSimilarPartners(ctx, fc.Args["uid"].(string), fc.Args["limit"].(int), fc.Args["offset"].(int))
+			return ec.Resolvers.Query().PhyIdealPartner(ctx, fc.Args["uid"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_sajuProfileSimilarPartners(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_phyIdealPartner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5258,31 +7872,44 @@ func (ec *executionContext) fieldContext_Query_sajuProfileSimilarPartners(ctx co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_sajuProfileSimilarPartners_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_phyIdealPartner_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sajuProfileLogs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_aiMetas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_sajuProfileLogs,
+		ec.fieldContext_Query_aiMetas,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().SajuProfileLogs(ctx, fc.Args["input"].(model.SajuProfileLogSearchInput))
+			return ec.Resolvers.Query().AiMetas(ctx, fc.Args["input"].(model.AiMetaSearchInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_sajuProfileLogs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_aiMetas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5325,31 +7952,44 @@ func (ec *executionContext) fieldContext_Query_sajuProfileLogs(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_sajuProfileLogs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_aiMetas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_phyIdealPartners(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_aiMeta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_phyIdealPartners,
+		ec.fieldContext_Query_aiMeta,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().PhyIdealPartners(ctx, fc.Args["input"].(model.PhyIdealPartnerSearchInput))
+			return ec.Resolvers.Query().AiMeta(ctx, fc.Args["uid"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_phyIdealPartners(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_aiMeta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5392,31 +8032,43 @@ func (ec *executionContext) fieldContext_Query_phyIdealPartners(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_phyIdealPartners_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_aiMeta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_phyIdealPartner(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_aiMetaTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_phyIdealPartner,
+		ec.fieldContext_Query_aiMetaTypes,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().PhyIdealPartner(ctx, fc.Args["uid"].(string))
+			return ec.Resolvers.Query().AiMetaTypes(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_phyIdealPartner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_aiMetaTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5452,38 +8104,40 @@ func (ec *executionContext) fieldContext_Query_phyIdealPartner(ctx context.Conte
 			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_phyIdealPartner_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aiMetas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_aiMetaKVs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aiMetas,
+		ec.fieldContext_Query_aiMetaKVs,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AiMetas(ctx, fc.Args["input"].(model.AiMetaSearchInput))
+			return ec.Resolvers.Query().AiMetaKVs(ctx, fc.Args["input"].(model.AiMetaKVsInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aiMetas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_aiMetaKVs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5526,31 +8180,44 @@ func (ec *executionContext) fieldContext_Query_aiMetas(ctx context.Context, fiel
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aiMetas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_aiMetaKVs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aiMeta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_aiExecutions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aiMeta,
+		ec.fieldContext_Query_aiExecutions,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AiMeta(ctx, fc.Args["uid"].(string))
+			return ec.Resolvers.Query().AiExecutions(ctx, fc.Args["input"].(model.AiExecutionSearchInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aiMeta(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_aiExecutions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5593,30 +8260,44 @@ func (ec *executionContext) fieldContext_Query_aiMeta(ctx context.Context, field
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aiMeta_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_aiExecutions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aiMetaTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_aiExecution(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aiMetaTypes,
+		ec.fieldContext_Query_aiExecution,
 		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().AiMetaTypes(ctx)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().AiExecution(ctx, fc.Args["uid"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aiMetaTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_aiExecution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5652,27 +8333,51 @@ func (ec *executionContext) fieldContext_Query_aiMetaTypes(_ context.Context, fi
 			return nil, fmt.Errorf("no field named %q was found under type SimpleResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_aiExecution_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aiMetaKVs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_aiCostSummary(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aiMetaKVs,
+		ec.fieldContext_Query_aiCostSummary,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AiMetaKVs(ctx, fc.Args["input"].(model.AiMetaKVsInput))
+			return ec.Resolvers.Query().AiCostSummary(ctx, fc.Args["input"].(model.AiCostSummaryInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aiMetaKVs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_aiCostSummary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5715,31 +8420,44 @@ func (ec *executionContext) fieldContext_Query_aiMetaKVs(ctx context.Context, fi
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aiMetaKVs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_aiCostSummary_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aiExecutions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_palja(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aiExecutions,
+		ec.fieldContext_Query_palja,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AiExecutions(ctx, fc.Args["input"].(model.AiExecutionSearchInput))
+			return ec.Resolvers.Query().Palja(ctx, fc.Args["birthdate"].(string), fc.Args["timezone"].(string), fc.Args["calendar"].(*string), fc.Args["leapMonth"].(*bool))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aiExecutions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_palja(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5782,31 +8500,44 @@ func (ec *executionContext) fieldContext_Query_aiExecutions(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aiExecutions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_palja_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_aiExecution(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_sajuSearch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_aiExecution,
+		ec.fieldContext_Query_sajuSearch,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AiExecution(ctx, fc.Args["uid"].(string))
+			return ec.Resolvers.Query().SajuSearch(ctx, fc.Args["input"].(model.SajuSearchInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_aiExecution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_sajuSearch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5849,31 +8580,44 @@ func (ec *executionContext) fieldContext_Query_aiExecution(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_aiExecution_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_sajuSearch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_palja(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_almanac(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_palja,
+		ec.fieldContext_Query_almanac,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().Palja(ctx, fc.Args["birthdate"].(string), fc.Args["timezone"].(string))
+			return ec.Resolvers.Query().Almanac(ctx, fc.Args["input"].(model.AlmanacInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_palja(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_almanac(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -5916,7 +8660,7 @@ func (ec *executionContext) fieldContext_Query_palja(ctx context.Context, field
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_palja_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_almanac_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -5933,7 +8677,20 @@ func (ec *executionContext) _Query_itemnCards(ctx context.Context, field graphql
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().ItemnCards(ctx, fc.Args["input"].(model.ItemNCardSearchInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -6000,7 +8757,20 @@ func (ec *executionContext) _Query_itemnCard(ctx context.Context, field graphql.
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().ItemnCard(ctx, fc.Args["uid"].(*string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -6067,7 +8837,20 @@ func (ec *executionContext) _Query_itemnCardByCardId(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().ItemnCardByCardID(ctx, fc.Args["cardId"].(string), fc.Args["scope"].(*string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalOSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		false,
@@ -6134,7 +8917,20 @@ func (ec *executionContext) _Query_sajuChart(ctx context.Context, field graphql.
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().SajuChart(ctx, fc.Args["input"].(model.SajuChartInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -6201,7 +8997,20 @@ func (ec *executionContext) _Query_itemnCardsByTokens(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().ItemnCardsByTokens(ctx, fc.Args["input"].(model.ItemnCardsByTokensInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -6268,7 +9077,20 @@ func (ec *executionContext) _Query_extract_saju(ctx context.Context, field graph
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().ExtractSaju(ctx, fc.Args["input"].(model.ExtractSajuInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -6335,7 +9157,20 @@ func (ec *executionContext) _Query_sajuPairChart(ctx context.Context, field grap
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().SajuPairChart(ctx, fc.Args["input"].(model.SajuPairChartInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -6402,7 +9237,20 @@ func (ec *executionContext) _Query_pairCardsByTokens(ctx context.Context, field
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().PairCardsByTokens(ctx, fc.Args["input"].(model.PairCardsByTokensInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -6469,7 +9317,20 @@ func (ec *executionContext) _Query_extract_pair(ctx context.Context, field graph
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().ExtractPair(ctx, fc.Args["input"].(model.ExtractPairInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -6536,7 +9397,20 @@ func (ec *executionContext) _Query_localLogs(ctx context.Context, field graphql.
 			fc := graphql.GetFieldContext(ctx)
 			return ec.Resolvers.Query().LocalLogs(ctx, fc.Args["input"].(model.LocalLogSearchInput))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -6602,7 +9476,20 @@ func (ec *executionContext) _Query_systemStats(ctx context.Context, field graphq
 		func(ctx context.Context) (any, error) {
 			return ec.Resolvers.Query().SystemStats(ctx)
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.Auth == nil {
+					var zeroVal *model.SimpleResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.Directives.Auth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSimpleResult,
 		true,
 		true,
@@ -8418,7 +11305,210 @@ func (ec *executionContext) _SajuProfile_partnerMatchTips(ctx context.Context, f
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerMatchTips(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_partnerMatchTips(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfile_partnerSummary(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfile_partnerSummary,
+		func(ctx context.Context) (any, error) {
+			return obj.PartnerSummary, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfile_partnerSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfile_partnerFeatureEyes(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfile_partnerFeatureEyes,
+		func(ctx context.Context) (any, error) {
+			return obj.PartnerFeatureEyes, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureEyes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfile_partnerFeatureNose(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfile_partnerFeatureNose,
+		func(ctx context.Context) (any, error) {
+			return obj.PartnerFeatureNose, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureNose(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfile_partnerFeatureMouth(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfile_partnerFeatureMouth,
+		func(ctx context.Context) (any, error) {
+			return obj.PartnerFeatureMouth, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureMouth(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfile_partnerFeatureFaceShape(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfile_partnerFeatureFaceShape,
+		func(ctx context.Context) (any, error) {
+			return obj.PartnerFeatureFaceShape, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureFaceShape(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfile_partnerPersonalityMatch(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfile_partnerPersonalityMatch,
+		func(ctx context.Context) (any, error) {
+			return obj.PartnerPersonalityMatch, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfile_partnerPersonalityMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfile_partnerSex(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfile_partnerSex,
+		func(ctx context.Context) (any, error) {
+			return obj.PartnerSex, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfile_partnerSex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
@@ -8431,43 +11521,43 @@ func (ec *executionContext) fieldContext_SajuProfile_partnerMatchTips(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_partnerSummary(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_partnerAge(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_partnerSummary,
+		ec.fieldContext_SajuProfile_partnerAge,
 		func(ctx context.Context) (any, error) {
-			return obj.PartnerSummary, nil
+			return obj.PartnerAge, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_partnerAge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_partnerFeatureEyes(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_phyPartnerUid(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_partnerFeatureEyes,
+		ec.fieldContext_SajuProfile_phyPartnerUid,
 		func(ctx context.Context) (any, error) {
-			return obj.PartnerFeatureEyes, nil
+			return obj.PhyPartnerUID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -8476,7 +11566,7 @@ func (ec *executionContext) _SajuProfile_partnerFeatureEyes(ctx context.Context,
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureEyes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_phyPartnerUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
@@ -8489,43 +11579,43 @@ func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureEyes(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_partnerFeatureNose(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_phyPartnerSimilarity(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_partnerFeatureNose,
+		ec.fieldContext_SajuProfile_phyPartnerSimilarity,
 		func(ctx context.Context) (any, error) {
-			return obj.PartnerFeatureNose, nil
+			return obj.PhyPartnerSimilarity, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureNose(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_phyPartnerSimilarity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_partnerFeatureMouth(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_status(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_partnerFeatureMouth,
+		ec.fieldContext_SajuProfile_status,
 		func(ctx context.Context) (any, error) {
-			return obj.PartnerFeatureMouth, nil
+			return obj.Status, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -8534,7 +11624,7 @@ func (ec *executionContext) _SajuProfile_partnerFeatureMouth(ctx context.Context
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureMouth(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
@@ -8547,14 +11637,14 @@ func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureMouth(_ conte
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_partnerFeatureFaceShape(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_sajuStatus(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_partnerFeatureFaceShape,
+		ec.fieldContext_SajuProfile_sajuStatus,
 		func(ctx context.Context) (any, error) {
-			return obj.PartnerFeatureFaceShape, nil
+			return obj.SajuStatus, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -8563,7 +11653,7 @@ func (ec *executionContext) _SajuProfile_partnerFeatureFaceShape(ctx context.Con
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureFaceShape(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_sajuStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
@@ -8576,14 +11666,14 @@ func (ec *executionContext) fieldContext_SajuProfile_partnerFeatureFaceShape(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_partnerPersonalityMatch(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_phyStatus(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_partnerPersonalityMatch,
+		ec.fieldContext_SajuProfile_phyStatus,
 		func(ctx context.Context) (any, error) {
-			return obj.PartnerPersonalityMatch, nil
+			return obj.PhyStatus, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -8592,7 +11682,7 @@ func (ec *executionContext) _SajuProfile_partnerPersonalityMatch(ctx context.Con
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerPersonalityMatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_phyStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
@@ -8605,14 +11695,14 @@ func (ec *executionContext) fieldContext_SajuProfile_partnerPersonalityMatch(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_partnerSex(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_partnerStatus(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_partnerSex,
+		ec.fieldContext_SajuProfile_partnerStatus,
 		func(ctx context.Context) (any, error) {
-			return obj.PartnerSex, nil
+			return obj.PartnerStatus, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -8621,7 +11711,7 @@ func (ec *executionContext) _SajuProfile_partnerSex(ctx context.Context, field g
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerSex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_partnerStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
@@ -8634,88 +11724,98 @@ func (ec *executionContext) fieldContext_SajuProfile_partnerSex(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_partnerAge(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_statusTransitions(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_partnerAge,
+		ec.fieldContext_SajuProfile_statusTransitions,
 		func(ctx context.Context) (any, error) {
-			return obj.PartnerAge, nil
+			return obj.StatusTransitions, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNSajuProfileStatusTransition2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSajuProfileStatusTransitionᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_partnerAge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_statusTransitions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "step":
+				return ec.fieldContext_SajuProfileStatusTransition_step(ctx, field)
+			case "from":
+				return ec.fieldContext_SajuProfileStatusTransition_from(ctx, field)
+			case "to":
+				return ec.fieldContext_SajuProfileStatusTransition_to(ctx, field)
+			case "at":
+				return ec.fieldContext_SajuProfileStatusTransition_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SajuProfileStatusTransition", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_phyPartnerUid(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_aiCost(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_phyPartnerUid,
+		ec.fieldContext_SajuProfile_aiCost,
 		func(ctx context.Context) (any, error) {
-			return obj.PhyPartnerUID, nil
+			return obj.AiCost, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_phyPartnerUid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_aiCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SajuProfile_phyPartnerSimilarity(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _SajuProfile_aiTotalTokens(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SajuProfile_phyPartnerSimilarity,
+		ec.fieldContext_SajuProfile_aiTotalTokens,
 		func(ctx context.Context) (any, error) {
-			return obj.PhyPartnerSimilarity, nil
+			return obj.AiTotalTokens, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SajuProfile_phyPartnerSimilarity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SajuProfile_aiTotalTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "SajuProfile",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
@@ -8924,6 +12024,122 @@ func (ec *executionContext) fieldContext_SajuProfileLog_text(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _SajuProfileStatusTransition_step(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfileStatusTransition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfileStatusTransition_step,
+		func(ctx context.Context) (any, error) {
+			return obj.Step, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfileStatusTransition_step(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfileStatusTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfileStatusTransition_from(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfileStatusTransition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfileStatusTransition_from,
+		func(ctx context.Context) (any, error) {
+			return obj.From, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfileStatusTransition_from(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfileStatusTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfileStatusTransition_to(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfileStatusTransition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfileStatusTransition_to,
+		func(ctx context.Context) (any, error) {
+			return obj.To, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfileStatusTransition_to(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfileStatusTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SajuProfileStatusTransition_at(ctx context.Context, field graphql.CollectedField, obj *model.SajuProfileStatusTransition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SajuProfileStatusTransition_at,
+		func(ctx context.Context) (any, error) {
+			return obj.At, nil
+		},
+		nil,
+		ec.marshalNBigInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SajuProfileStatusTransition_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SajuProfileStatusTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SelectedItemnCard_id(ctx context.Context, field graphql.CollectedField, obj *model.SelectedItemnCard) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -9565,42 +12781,199 @@ func (ec *executionContext) fieldContext_SystemStats_memoryUsage(_ context.Conte
 			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	return fc, nil
+	return fc, nil
+}
+
+func (ec *executionContext) _SystemStats_memoryTotal(ctx context.Context, field graphql.CollectedField, obj *model.SystemStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SystemStats_memoryTotal,
+		func(ctx context.Context) (any, error) {
+			return obj.MemoryTotal, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SystemStats_memoryTotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SystemStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAdminAuditLogSearchInput(ctx context.Context, obj any) (model.AdminAuditLogSearchInput, error) {
+	var it model.AdminAuditLogSearchInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"limit", "offset", "actorUid", "action", "targetType", "targetUid", "requestId", "fromCreatedAt", "toCreatedAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "offset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Offset = data
+		case "actorUid":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actorUid"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ActorUID = data
+		case "action":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Action = data
+		case "targetType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TargetType = data
+		case "targetUid":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetUid"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TargetUID = data
+		case "requestId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RequestID = data
+		case "fromCreatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromCreatedAt"))
+			data, err := ec.unmarshalOBigInt2ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FromCreatedAt = data
+		case "toCreatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toCreatedAt"))
+			data, err := ec.unmarshalOBigInt2ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ToCreatedAt = data
+		}
+	}
+	return it, nil
 }
 
-func (ec *executionContext) _SystemStats_memoryTotal(ctx context.Context, field graphql.CollectedField, obj *model.SystemStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SystemStats_memoryTotal,
-		func(ctx context.Context) (any, error) {
-			return obj.MemoryTotal, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) unmarshalInputAiCostSummaryInput(ctx context.Context, obj any) (model.AiCostSummaryInput, error) {
+	var it model.AiCostSummaryInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
 
-func (ec *executionContext) fieldContext_SystemStats_memoryTotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SystemStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+	fieldsInOrder := [...]string{"groupBy", "fromCreatedAt", "toCreatedAt", "metaType", "model", "runSajuProfileUid", "limit", "offset"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "groupBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupBy"))
+			data, err := ec.unmarshalNAiCostGroupBy2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAiCostGroupBy(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupBy = data
+		case "fromCreatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromCreatedAt"))
+			data, err := ec.unmarshalOBigInt2ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FromCreatedAt = data
+		case "toCreatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toCreatedAt"))
+			data, err := ec.unmarshalOBigInt2ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ToCreatedAt = data
+		case "metaType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("metaType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MetaType = data
+		case "model":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("model"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Model = data
+		case "runSajuProfileUid":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("runSajuProfileUid"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RunSajuProfileUID = data
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "offset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Offset = data
+		}
 	}
-	return fc, nil
+	return it, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
 func (ec *executionContext) unmarshalInputAiExcutionInput(ctx context.Context, obj any) (model.AiExcutionInput, error) {
 	var it model.AiExcutionInput
 	asMap := map[string]any{}
@@ -9927,6 +13300,46 @@ func (ec *executionContext) unmarshalInputAiMetaSearchInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputAlmanacInput(ctx context.Context, obj any) (model.AlmanacInput, error) {
+	var it model.AlmanacInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"from", "to", "tzOffsetMinutes"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "from":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.From = data
+		case "to":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.To = data
+		case "tzOffsetMinutes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tzOffsetMinutes"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TzOffsetMinutes = data
+		}
+	}
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputChemiGenerationPairInput(ctx context.Context, obj any) (model.ChemiGenerationPairInput, error) {
 	var it model.ChemiGenerationPairInput
 	asMap := map[string]any{}
@@ -10307,6 +13720,60 @@ func (ec *executionContext) unmarshalInputItemnCardsByTokensInput(ctx context.Co
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputJobSearchInput(ctx context.Context, obj any) (model.JobSearchInput, error) {
+	var it model.JobSearchInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"limit", "offset", "type", "status", "refUid"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "offset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Offset = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "refUid":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("refUid"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RefUID = data
+		}
+	}
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputKVInput(ctx context.Context, obj any) (model.KVInput, error) {
 	var it model.KVInput
 	asMap := map[string]any{}
@@ -10570,7 +14037,7 @@ func (ec *executionContext) unmarshalInputSajuBirthInput(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"date", "time", "time_precision"}
+	fieldsInOrder := [...]string{"date", "time", "time_precision", "calendar", "leap_month"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -10598,6 +14065,20 @@ func (ec *executionContext) unmarshalInputSajuBirthInput(ctx context.Context, ob
 				return it, err
 			}
 			it.TimePrecision = data
+		case "calendar":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("calendar"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Calendar = data
+		case "leap_month":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("leap_month"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LeapMonth = data
 		}
 	}
 	return it, nil
@@ -11000,6 +14481,67 @@ func (ec *executionContext) unmarshalInputSajuProfileSearchInput(ctx context.Con
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputSajuSearchInput(ctx context.Context, obj any) (model.SajuSearchInput, error) {
+	var it model.SajuSearchInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"pattern", "from", "to", "tzOffsetMinutes", "zishiConvention", "limit"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "pattern":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pattern"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pattern = data
+		case "from":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.From = data
+		case "to":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.To = data
+		case "tzOffsetMinutes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tzOffsetMinutes"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TzOffsetMinutes = data
+		case "zishiConvention":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("zishiConvention"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ZishiConvention = data
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		}
+	}
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSendLLMRequestInput(ctx context.Context, obj any) (model.SendLLMRequestInput, error) {
 	var it model.SendLLMRequestInput
 	asMap := map[string]any{}
@@ -11125,6 +14667,13 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 			return graphql.Null
 		}
 		return ec._LLMRequestResult(ctx, sel, obj)
+	case model.Job:
+		return ec._Job(ctx, sel, &obj)
+	case *model.Job:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Job(ctx, sel, obj)
 	case model.ItemNCard:
 		return ec._ItemNCard(ctx, sel, &obj)
 	case *model.ItemNCard:
@@ -11167,6 +14716,13 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 			return graphql.Null
 		}
 		return ec._AiExecution(ctx, sel, obj)
+	case model.AiCostBucket:
+		return ec._AiCostBucket(ctx, sel, &obj)
+	case *model.AiCostBucket:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AiCostBucket(ctx, sel, obj)
 	case model.AdminUser:
 		return ec._AdminUser(ctx, sel, &obj)
 	case *model.AdminUser:
@@ -11174,6 +14730,13 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 			return graphql.Null
 		}
 		return ec._AdminUser(ctx, sel, obj)
+	case model.AdminAuditLog:
+		return ec._AdminAuditLog(ctx, sel, &obj)
+	case *model.AdminAuditLog:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AdminAuditLog(ctx, sel, obj)
 	default:
 		if typedObj, ok := obj.(graphql.Marshaler); ok {
 			return typedObj
@@ -11183,9 +14746,149 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 	}
 }
 
-// endregion ************************** interface.gotpl ***************************
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var adminAuditDiffImplementors = []string{"AdminAuditDiff"}
+
+func (ec *executionContext) _AdminAuditDiff(ctx context.Context, sel ast.SelectionSet, obj *model.AdminAuditDiff) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, adminAuditDiffImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AdminAuditDiff")
+		case "field":
+			out.Values[i] = ec._AdminAuditDiff_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "before":
+			out.Values[i] = ec._AdminAuditDiff_before(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "after":
+			out.Values[i] = ec._AdminAuditDiff_after(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var adminAuditLogImplementors = []string{"AdminAuditLog", "Node"}
 
-// region    **************************** object.gotpl ****************************
+func (ec *executionContext) _AdminAuditLog(ctx context.Context, sel ast.SelectionSet, obj *model.AdminAuditLog) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, adminAuditLogImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AdminAuditLog")
+		case "id":
+			out.Values[i] = ec._AdminAuditLog_id(ctx, field, obj)
+		case "uid":
+			out.Values[i] = ec._AdminAuditLog_uid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._AdminAuditLog_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestId":
+			out.Values[i] = ec._AdminAuditLog_requestId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actorUid":
+			out.Values[i] = ec._AdminAuditLog_actorUid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actorRole":
+			out.Values[i] = ec._AdminAuditLog_actorRole(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "action":
+			out.Values[i] = ec._AdminAuditLog_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "targetType":
+			out.Values[i] = ec._AdminAuditLog_targetType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "targetUid":
+			out.Values[i] = ec._AdminAuditLog_targetUid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "before":
+			out.Values[i] = ec._AdminAuditLog_before(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "after":
+			out.Values[i] = ec._AdminAuditLog_after(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "diff":
+			out.Values[i] = ec._AdminAuditLog_diff(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
 
 var adminUserImplementors = []string{"AdminUser", "Node"}
 
@@ -11230,6 +14933,82 @@ func (ec *executionContext) _AdminUser(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "role":
+			out.Values[i] = ec._AdminUser_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aiCostBucketImplementors = []string{"AiCostBucket", "Node"}
+
+func (ec *executionContext) _AiCostBucket(ctx context.Context, sel ast.SelectionSet, obj *model.AiCostBucket) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aiCostBucketImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AiCostBucket")
+		case "id":
+			out.Values[i] = ec._AiCostBucket_id(ctx, field, obj)
+		case "key":
+			out.Values[i] = ec._AiCostBucket_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._AiCostBucket_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "inputTokens":
+			out.Values[i] = ec._AiCostBucket_inputTokens(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "outputTokens":
+			out.Values[i] = ec._AiCostBucket_outputTokens(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalTokens":
+			out.Values[i] = ec._AiCostBucket_totalTokens(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "imageCount":
+			out.Values[i] = ec._AiCostBucket_imageCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cost":
+			out.Values[i] = ec._AiCostBucket_cost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11367,6 +15146,16 @@ func (ec *executionContext) _AiExecution(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "imageCount":
+			out.Values[i] = ec._AiExecution_imageCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cost":
+			out.Values[i] = ec._AiExecution_cost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "runBy":
 			out.Values[i] = ec._AiExecution_runBy(ctx, field, obj)
 		case "runSajuProfileUid":
@@ -11778,6 +15567,112 @@ func (ec *executionContext) _ItemNCard(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
+var jobImplementors = []string{"Job", "Node"}
+
+func (ec *executionContext) _Job(ctx context.Context, sel ast.SelectionSet, obj *model.Job) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, jobImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Job")
+		case "id":
+			out.Values[i] = ec._Job_id(ctx, field, obj)
+		case "uid":
+			out.Values[i] = ec._Job_uid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Job_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Job_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._Job_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refUid":
+			out.Values[i] = ec._Job_refUid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._Job_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "steps":
+			out.Values[i] = ec._Job_steps(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "attempts":
+			out.Values[i] = ec._Job_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maxAttempts":
+			out.Values[i] = ec._Job_maxAttempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "runAfter":
+			out.Values[i] = ec._Job_runAfter(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "leaseOwner":
+			out.Values[i] = ec._Job_leaseOwner(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "leaseUntil":
+			out.Values[i] = ec._Job_leaseUntil(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastError":
+			out.Values[i] = ec._Job_lastError(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "finishedAt":
+			out.Values[i] = ec._Job_finishedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var kVImplementors = []string{"KV"}
 
 func (ec *executionContext) _KV(ctx context.Context, sel ast.SelectionSet, obj *model.Kv) graphql.Marshaler {
@@ -11983,6 +15878,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "setAdminUserRole":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setAdminUserRole(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "createSajuProfile":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_createSajuProfile(ctx, field)
@@ -11991,6 +15893,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_deleteSajuProfile(ctx, field)
 			})
+		case "retryJob":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_retryJob(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "purgeJobPayload":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_purgeJobPayload(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "createPhyIdealPartner":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_createPhyIdealPartner(ctx, field)
@@ -12241,10 +16157,32 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			Field:  field,
 		})
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "adminUsers":
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "adminUsers":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_adminUsers(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "adminAuditLogs":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -12253,7 +16191,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_adminUsers(ctx, field)
+				res = ec._Query_adminAuditLogs(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -12353,6 +16291,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "jobs":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_jobs(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "job":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_job(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "phyIdealPartners":
 			field := field
@@ -12529,6 +16511,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "aiCostSummary":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_aiCostSummary(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "palja":
 			field := field
@@ -12551,6 +16555,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "sajuSearch":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sajuSearch(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "almanac":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_almanac(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "itemnCards":
 			field := field
@@ -13342,6 +17390,41 @@ func (ec *executionContext) _SajuProfile(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "status":
+			out.Values[i] = ec._SajuProfile_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "sajuStatus":
+			out.Values[i] = ec._SajuProfile_sajuStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "phyStatus":
+			out.Values[i] = ec._SajuProfile_phyStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "partnerStatus":
+			out.Values[i] = ec._SajuProfile_partnerStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "statusTransitions":
+			out.Values[i] = ec._SajuProfile_statusTransitions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "aiCost":
+			out.Values[i] = ec._SajuProfile_aiCost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "aiTotalTokens":
+			out.Values[i] = ec._SajuProfile_aiTotalTokens(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -13431,6 +17514,60 @@ func (ec *executionContext) _SajuProfileLog(ctx context.Context, sel ast.Selecti
 	return out
 }
 
+var sajuProfileStatusTransitionImplementors = []string{"SajuProfileStatusTransition"}
+
+func (ec *executionContext) _SajuProfileStatusTransition(ctx context.Context, sel ast.SelectionSet, obj *model.SajuProfileStatusTransition) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sajuProfileStatusTransitionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SajuProfileStatusTransition")
+		case "step":
+			out.Values[i] = ec._SajuProfileStatusTransition_step(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "from":
+			out.Values[i] = ec._SajuProfileStatusTransition_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "to":
+			out.Values[i] = ec._SajuProfileStatusTransition_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "at":
+			out.Values[i] = ec._SajuProfileStatusTransition_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var selectedItemnCardImplementors = []string{"SelectedItemnCard", "Node"}
 
 func (ec *executionContext) _SelectedItemnCard(ctx context.Context, sel ast.SelectionSet, obj *model.SelectedItemnCard) graphql.Marshaler {
@@ -13610,6 +17747,52 @@ func (ec *executionContext) _SystemStats(ctx context.Context, sel ast.SelectionS
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAdminAuditDiff2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐAdminAuditDiffᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AdminAuditDiff) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAdminAuditDiff2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐAdminAuditDiff(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAdminAuditDiff2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐAdminAuditDiff(ctx context.Context, sel ast.SelectionSet, v *model.AdminAuditDiff) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AdminAuditDiff(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAdminAuditLogSearchInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAdminAuditLogSearchInput(ctx context.Context, v any) (model.AdminAuditLogSearchInput, error) {
+	res, err := ec.unmarshalInputAdminAuditLogSearchInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNAiCostGroupBy2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAiCostGroupBy(ctx context.Context, v any) (model.AiCostGroupBy, error) {
+	var res model.AiCostGroupBy
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAiCostGroupBy2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAiCostGroupBy(ctx context.Context, sel ast.SelectionSet, v model.AiCostGroupBy) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNAiCostSummaryInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAiCostSummaryInput(ctx context.Context, v any) (model.AiCostSummaryInput, error) {
+	res, err := ec.unmarshalInputAiCostSummaryInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNAiExcutionInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAiExcutionInput(ctx context.Context, v any) (model.AiExcutionInput, error) {
 	res, err := ec.unmarshalInputAiExcutionInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -13635,6 +17818,11 @@ func (ec *executionContext) unmarshalNAiMetaSearchInput2sajudating_apiᚋapiᚋa
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNAlmanacInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐAlmanacInput(ctx context.Context, v any) (model.AlmanacInput, error) {
+	res, err := ec.unmarshalInputAlmanacInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNBigInt2int64(ctx context.Context, v any) (int64, error) {
 	res, err := config.UnmarshalBigInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -13736,6 +17924,11 @@ func (ec *executionContext) unmarshalNItemnCardsByTokensInput2sajudating_apiᚋa
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNJobSearchInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐJobSearchInput(ctx context.Context, v any) (model.JobSearchInput, error) {
+	res, err := ec.unmarshalInputJobSearchInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNKV2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐKvᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Kv) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -13932,6 +18125,37 @@ func (ec *executionContext) unmarshalNSajuProfileSearchInput2sajudating_apiᚋap
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNSajuProfileStatusTransition2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSajuProfileStatusTransitionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SajuProfileStatusTransition) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNSajuProfileStatusTransition2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSajuProfileStatusTransition(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSajuProfileStatusTransition2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐSajuProfileStatusTransition(ctx context.Context, sel ast.SelectionSet, v *model.SajuProfileStatusTransition) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SajuProfileStatusTransition(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSajuSearchInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐSajuSearchInput(ctx context.Context, v any) (model.SajuSearchInput, error) {
+	res, err := ec.unmarshalInputSajuSearchInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNSendLLMRequestInput2sajudating_apiᚋapiᚋadmgqlᚋmodelᚐSendLLMRequestInput(ctx context.Context, v any) (model.SendLLMRequestInput, error) {
 	res, err := ec.unmarshalInputSendLLMRequestInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -13951,6 +18175,24 @@ func (ec *executionContext) marshalNSimpleResult2ᚖsajudating_apiᚋapiᚋadmgq
 	return ec._SimpleResult(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOBigInt2ᚖint64(ctx context.Context, v any) (*int64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := config.UnmarshalBigInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBigInt2ᚖint64(ctx context.Context, sel ast.SelectionSet, v *int64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := config.MarshalBigInt(*v)
+	return res
+}
+
 func (ec *executionContext) marshalOKV2ᚕᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐKvᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Kv) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -695,6 +695,122 @@ func (ec *executionContext) fieldContext_ExtractDaeunPeriod_day(_ context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _ExtractDaeunPeriod_startAgeYears(ctx context.Context, field graphql.CollectedField, obj *model.ExtractDaeunPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractDaeunPeriod_startAgeYears,
+		func(ctx context.Context) (any, error) {
+			return obj.StartAgeYears, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractDaeunPeriod_startAgeYears(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractDaeunPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExtractDaeunPeriod_startAgeMonths(ctx context.Context, field graphql.CollectedField, obj *model.ExtractDaeunPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractDaeunPeriod_startAgeMonths,
+		func(ctx context.Context) (any, error) {
+			return obj.StartAgeMonths, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractDaeunPeriod_startAgeMonths(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractDaeunPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExtractDaeunPeriod_startDt(ctx context.Context, field graphql.CollectedField, obj *model.ExtractDaeunPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractDaeunPeriod_startDt,
+		func(ctx context.Context) (any, error) {
+			return obj.StartDt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractDaeunPeriod_startDt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractDaeunPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExtractDaeunPeriod_convention(ctx context.Context, field graphql.CollectedField, obj *model.ExtractDaeunPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractDaeunPeriod_convention,
+		func(ctx context.Context) (any, error) {
+			return obj.Convention, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractDaeunPeriod_convention(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractDaeunPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ExtractElDistribution_wood(ctx context.Context, field graphql.CollectedField, obj *model.ExtractElDistribution) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -4555,6 +4671,8 @@ func (ec *executionContext) fieldContext_ExtractPairInputDisplay_a(_ context.Con
 				return ec.fieldContext_ExtractSajuInputDisplay_solarDt(ctx, field)
 			case "adjustedDt":
 				return ec.fieldContext_ExtractSajuInputDisplay_adjustedDt(ctx, field)
+			case "solarTime":
+				return ec.fieldContext_ExtractSajuInputDisplay_solarTime(ctx, field)
 			case "fortuneBaseDt":
 				return ec.fieldContext_ExtractSajuInputDisplay_fortuneBaseDt(ctx, field)
 			case "seunFromYear":
@@ -4567,6 +4685,8 @@ func (ec *executionContext) fieldContext_ExtractPairInputDisplay_a(_ context.Con
 				return ec.fieldContext_ExtractSajuInputDisplay_ilunYear(ctx, field)
 			case "ilunMonth":
 				return ec.fieldContext_ExtractSajuInputDisplay_ilunMonth(ctx, field)
+			case "daeunConvention":
+				return ec.fieldContext_ExtractSajuInputDisplay_daeunConvention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractSajuInputDisplay", field.Name)
 		},
@@ -4618,6 +4738,8 @@ func (ec *executionContext) fieldContext_ExtractPairInputDisplay_b(_ context.Con
 				return ec.fieldContext_ExtractSajuInputDisplay_solarDt(ctx, field)
 			case "adjustedDt":
 				return ec.fieldContext_ExtractSajuInputDisplay_adjustedDt(ctx, field)
+			case "solarTime":
+				return ec.fieldContext_ExtractSajuInputDisplay_solarTime(ctx, field)
 			case "fortuneBaseDt":
 				return ec.fieldContext_ExtractSajuInputDisplay_fortuneBaseDt(ctx, field)
 			case "seunFromYear":
@@ -4630,6 +4752,8 @@ func (ec *executionContext) fieldContext_ExtractPairInputDisplay_b(_ context.Con
 				return ec.fieldContext_ExtractSajuInputDisplay_ilunYear(ctx, field)
 			case "ilunMonth":
 				return ec.fieldContext_ExtractSajuInputDisplay_ilunMonth(ctx, field)
+			case "daeunConvention":
+				return ec.fieldContext_ExtractSajuInputDisplay_daeunConvention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractSajuInputDisplay", field.Name)
 		},
@@ -5633,6 +5757,8 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_input(_ context.Context,
 				return ec.fieldContext_ExtractSajuInputDisplay_solarDt(ctx, field)
 			case "adjustedDt":
 				return ec.fieldContext_ExtractSajuInputDisplay_adjustedDt(ctx, field)
+			case "solarTime":
+				return ec.fieldContext_ExtractSajuInputDisplay_solarTime(ctx, field)
 			case "fortuneBaseDt":
 				return ec.fieldContext_ExtractSajuInputDisplay_fortuneBaseDt(ctx, field)
 			case "seunFromYear":
@@ -5645,6 +5771,8 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_input(_ context.Context,
 				return ec.fieldContext_ExtractSajuInputDisplay_ilunYear(ctx, field)
 			case "ilunMonth":
 				return ec.fieldContext_ExtractSajuInputDisplay_ilunMonth(ctx, field)
+			case "daeunConvention":
+				return ec.fieldContext_ExtractSajuInputDisplay_daeunConvention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractSajuInputDisplay", field.Name)
 		},
@@ -5984,6 +6112,14 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_daeun(_ context.Context,
 				return ec.fieldContext_ExtractDaeunPeriod_month(ctx, field)
 			case "day":
 				return ec.fieldContext_ExtractDaeunPeriod_day(ctx, field)
+			case "startAgeYears":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeYears(ctx, field)
+			case "startAgeMonths":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeMonths(ctx, field)
+			case "startDt":
+				return ec.fieldContext_ExtractDaeunPeriod_startDt(ctx, field)
+			case "convention":
+				return ec.fieldContext_ExtractDaeunPeriod_convention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractDaeunPeriod", field.Name)
 		},
@@ -6061,6 +6197,14 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_seun(_ context.Context,
 				return ec.fieldContext_ExtractDaeunPeriod_month(ctx, field)
 			case "day":
 				return ec.fieldContext_ExtractDaeunPeriod_day(ctx, field)
+			case "startAgeYears":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeYears(ctx, field)
+			case "startAgeMonths":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeMonths(ctx, field)
+			case "startDt":
+				return ec.fieldContext_ExtractDaeunPeriod_startDt(ctx, field)
+			case "convention":
+				return ec.fieldContext_ExtractDaeunPeriod_convention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractDaeunPeriod", field.Name)
 		},
@@ -6138,6 +6282,14 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_wolun(_ context.Context,
 				return ec.fieldContext_ExtractDaeunPeriod_month(ctx, field)
 			case "day":
 				return ec.fieldContext_ExtractDaeunPeriod_day(ctx, field)
+			case "startAgeYears":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeYears(ctx, field)
+			case "startAgeMonths":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeMonths(ctx, field)
+			case "startDt":
+				return ec.fieldContext_ExtractDaeunPeriod_startDt(ctx, field)
+			case "convention":
+				return ec.fieldContext_ExtractDaeunPeriod_convention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractDaeunPeriod", field.Name)
 		},
@@ -6215,6 +6367,14 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_ilun(_ context.Context,
 				return ec.fieldContext_ExtractDaeunPeriod_month(ctx, field)
 			case "day":
 				return ec.fieldContext_ExtractDaeunPeriod_day(ctx, field)
+			case "startAgeYears":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeYears(ctx, field)
+			case "startAgeMonths":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeMonths(ctx, field)
+			case "startDt":
+				return ec.fieldContext_ExtractDaeunPeriod_startDt(ctx, field)
+			case "convention":
+				return ec.fieldContext_ExtractDaeunPeriod_convention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractDaeunPeriod", field.Name)
 		},
@@ -6292,6 +6452,14 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_daeunList(_ context.Cont
 				return ec.fieldContext_ExtractDaeunPeriod_month(ctx, field)
 			case "day":
 				return ec.fieldContext_ExtractDaeunPeriod_day(ctx, field)
+			case "startAgeYears":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeYears(ctx, field)
+			case "startAgeMonths":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeMonths(ctx, field)
+			case "startDt":
+				return ec.fieldContext_ExtractDaeunPeriod_startDt(ctx, field)
+			case "convention":
+				return ec.fieldContext_ExtractDaeunPeriod_convention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractDaeunPeriod", field.Name)
 		},
@@ -6369,6 +6537,14 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_seunList(_ context.Conte
 				return ec.fieldContext_ExtractDaeunPeriod_month(ctx, field)
 			case "day":
 				return ec.fieldContext_ExtractDaeunPeriod_day(ctx, field)
+			case "startAgeYears":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeYears(ctx, field)
+			case "startAgeMonths":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeMonths(ctx, field)
+			case "startDt":
+				return ec.fieldContext_ExtractDaeunPeriod_startDt(ctx, field)
+			case "convention":
+				return ec.fieldContext_ExtractDaeunPeriod_convention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractDaeunPeriod", field.Name)
 		},
@@ -6446,6 +6622,14 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_wolunList(_ context.Cont
 				return ec.fieldContext_ExtractDaeunPeriod_month(ctx, field)
 			case "day":
 				return ec.fieldContext_ExtractDaeunPeriod_day(ctx, field)
+			case "startAgeYears":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeYears(ctx, field)
+			case "startAgeMonths":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeMonths(ctx, field)
+			case "startDt":
+				return ec.fieldContext_ExtractDaeunPeriod_startDt(ctx, field)
+			case "convention":
+				return ec.fieldContext_ExtractDaeunPeriod_convention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractDaeunPeriod", field.Name)
 		},
@@ -6523,6 +6707,14 @@ func (ec *executionContext) fieldContext_ExtractSajuDoc_ilunList(_ context.Conte
 				return ec.fieldContext_ExtractDaeunPeriod_month(ctx, field)
 			case "day":
 				return ec.fieldContext_ExtractDaeunPeriod_day(ctx, field)
+			case "startAgeYears":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeYears(ctx, field)
+			case "startAgeMonths":
+				return ec.fieldContext_ExtractDaeunPeriod_startAgeMonths(ctx, field)
+			case "startDt":
+				return ec.fieldContext_ExtractDaeunPeriod_startDt(ctx, field)
+			case "convention":
+				return ec.fieldContext_ExtractDaeunPeriod_convention(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ExtractDaeunPeriod", field.Name)
 		},
@@ -7154,6 +7346,49 @@ func (ec *executionContext) fieldContext_ExtractSajuInputDisplay_adjustedDt(_ co
 	return fc, nil
 }
 
+func (ec *executionContext) _ExtractSajuInputDisplay_solarTime(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSajuInputDisplay) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractSajuInputDisplay_solarTime,
+		func(ctx context.Context) (any, error) {
+			return obj.SolarTime, nil
+		},
+		nil,
+		ec.marshalOExtractSolarTime2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐExtractSolarTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractSajuInputDisplay_solarTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractSajuInputDisplay",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "mode":
+				return ec.fieldContext_ExtractSolarTime_mode(ctx, field)
+			case "tzOffsetMinutes":
+				return ec.fieldContext_ExtractSolarTime_tzOffsetMinutes(ctx, field)
+			case "longitudeMinutes":
+				return ec.fieldContext_ExtractSolarTime_longitudeMinutes(ctx, field)
+			case "equationOfTime":
+				return ec.fieldContext_ExtractSolarTime_equationOfTime(ctx, field)
+			case "correctionMinutes":
+				return ec.fieldContext_ExtractSolarTime_correctionMinutes(ctx, field)
+			case "solarDt":
+				return ec.fieldContext_ExtractSolarTime_solarDt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExtractSolarTime", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ExtractSajuInputDisplay_fortuneBaseDt(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSajuInputDisplay) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -7328,6 +7563,35 @@ func (ec *executionContext) fieldContext_ExtractSajuInputDisplay_ilunMonth(_ con
 	return fc, nil
 }
 
+func (ec *executionContext) _ExtractSajuInputDisplay_daeunConvention(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSajuInputDisplay) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractSajuInputDisplay_daeunConvention,
+		func(ctx context.Context) (any, error) {
+			return obj.DaeunConvention, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractSajuInputDisplay_daeunConvention(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractSajuInputDisplay",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ExtractSajuNode_id(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSajuNode) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -7978,6 +8242,180 @@ func (ec *executionContext) fieldContext_ExtractScorePart_note(_ context.Context
 	return fc, nil
 }
 
+func (ec *executionContext) _ExtractSolarTime_mode(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSolarTime) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractSolarTime_mode,
+		func(ctx context.Context) (any, error) {
+			return obj.Mode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractSolarTime_mode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractSolarTime",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExtractSolarTime_tzOffsetMinutes(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSolarTime) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractSolarTime_tzOffsetMinutes,
+		func(ctx context.Context) (any, error) {
+			return obj.TzOffsetMinutes, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractSolarTime_tzOffsetMinutes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractSolarTime",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExtractSolarTime_longitudeMinutes(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSolarTime) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractSolarTime_longitudeMinutes,
+		func(ctx context.Context) (any, error) {
+			return obj.LongitudeMinutes, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractSolarTime_longitudeMinutes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractSolarTime",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExtractSolarTime_equationOfTime(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSolarTime) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractSolarTime_equationOfTime,
+		func(ctx context.Context) (any, error) {
+			return obj.EquationOfTime, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractSolarTime_equationOfTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractSolarTime",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExtractSolarTime_correctionMinutes(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSolarTime) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractSolarTime_correctionMinutes,
+		func(ctx context.Context) (any, error) {
+			return obj.CorrectionMinutes, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractSolarTime_correctionMinutes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractSolarTime",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExtractSolarTime_solarDt(ctx context.Context, field graphql.CollectedField, obj *model.ExtractSolarTime) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtractSolarTime_solarDt,
+		func(ctx context.Context) (any, error) {
+			return obj.SolarDt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ExtractSolarTime_solarDt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtractSolarTime",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 // endregion **************************** field.gotpl *****************************
 
 // region    **************************** input.gotpl *****************************
@@ -8116,7 +8554,7 @@ func (ec *executionContext) unmarshalInputExtractSajuInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"dtLocal", "tz", "loc", "calendar", "leapMonth", "sex", "timePrec", "engine", "solarDt", "adjustedDt", "fortuneBaseDt", "seunFromYear", "seunToYear", "wolunYear", "ilunYear", "ilunMonth"}
+	fieldsInOrder := [...]string{"dtLocal", "tz", "loc", "calendar", "leapMonth", "sex", "timePrec", "engine", "solarDt", "adjustedDt", "fortuneBaseDt", "seunFromYear", "seunToYear", "wolunYear", "ilunYear", "ilunMonth", "daeunConvention"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -8235,6 +8673,13 @@ func (ec *executionContext) unmarshalInputExtractSajuInput(ctx context.Context,
 				return it, err
 			}
 			it.IlunMonth = data
+		case "daeunConvention":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("daeunConvention"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DaeunConvention = data
 		}
 	}
 	return it, nil
@@ -8335,6 +8780,20 @@ func (ec *executionContext) _ExtractDaeunPeriod(ctx context.Context, sel ast.Sel
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "startAgeYears":
+			out.Values[i] = ec._ExtractDaeunPeriod_startAgeYears(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startAgeMonths":
+			out.Values[i] = ec._ExtractDaeunPeriod_startAgeMonths(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startDt":
+			out.Values[i] = ec._ExtractDaeunPeriod_startDt(ctx, field, obj)
+		case "convention":
+			out.Values[i] = ec._ExtractDaeunPeriod_convention(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9881,6 +10340,8 @@ func (ec *executionContext) _ExtractSajuInputDisplay(ctx context.Context, sel as
 			out.Values[i] = ec._ExtractSajuInputDisplay_solarDt(ctx, field, obj)
 		case "adjustedDt":
 			out.Values[i] = ec._ExtractSajuInputDisplay_adjustedDt(ctx, field, obj)
+		case "solarTime":
+			out.Values[i] = ec._ExtractSajuInputDisplay_solarTime(ctx, field, obj)
 		case "fortuneBaseDt":
 			out.Values[i] = ec._ExtractSajuInputDisplay_fortuneBaseDt(ctx, field, obj)
 		case "seunFromYear":
@@ -9893,6 +10354,8 @@ func (ec *executionContext) _ExtractSajuInputDisplay(ctx context.Context, sel as
 			out.Values[i] = ec._ExtractSajuInputDisplay_ilunYear(ctx, field, obj)
 		case "ilunMonth":
 			out.Values[i] = ec._ExtractSajuInputDisplay_ilunMonth(ctx, field, obj)
+		case "daeunConvention":
+			out.Values[i] = ec._ExtractSajuInputDisplay_daeunConvention(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10101,6 +10564,70 @@ func (ec *executionContext) _ExtractScorePart(ctx context.Context, sel ast.Selec
 	return out
 }
 
+var extractSolarTimeImplementors = []string{"ExtractSolarTime"}
+
+func (ec *executionContext) _ExtractSolarTime(ctx context.Context, sel ast.SelectionSet, obj *model.ExtractSolarTime) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, extractSolarTimeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExtractSolarTime")
+		case "mode":
+			out.Values[i] = ec._ExtractSolarTime_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tzOffsetMinutes":
+			out.Values[i] = ec._ExtractSolarTime_tzOffsetMinutes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "longitudeMinutes":
+			out.Values[i] = ec._ExtractSolarTime_longitudeMinutes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "equationOfTime":
+			out.Values[i] = ec._ExtractSolarTime_equationOfTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "correctionMinutes":
+			out.Values[i] = ec._ExtractSolarTime_correctionMinutes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "solarDt":
+			out.Values[i] = ec._ExtractSolarTime_solarDt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 // endregion **************************** object.gotpl ****************************
 
 // region    ***************************** type.gotpl *****************************
@@ -10773,6 +11300,13 @@ func (ec *executionContext) marshalOExtractScorePart2ᚕᚖsajudating_apiᚋapi
 	return ret
 }
 
+func (ec *executionContext) marshalOExtractSolarTime2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐExtractSolarTime(ctx context.Context, sel ast.SelectionSet, v *model.ExtractSolarTime) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ExtractSolarTime(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOExtractTenGod2ᚖsajudating_apiᚋapiᚋadmgqlᚋmodelᚐExtractTenGod(ctx context.Context, v any) (*model.ExtractTenGod, error) {
 	if v == nil {
 		return nil, nil
@@ -28,24 +28,61 @@ type ResolverRoot interface {
 }
 
 type DirectiveRoot struct {
+	Auth    func(ctx context.Context, obj any, next graphql.Resolver) (res any, err error)
+	HasPerm func(ctx context.Context, obj any, next graphql.Resolver, perm string) (res any, err error)
 }
 
 type ComplexityRoot struct {
+	AdminAuditDiff struct {
+		After  func(childComplexity int) int
+		Before func(childComplexity int) int
+		Field  func(childComplexity int) int
+	}
+
+	AdminAuditLog struct {
+		Action     func(childComplexity int) int
+		ActorRole  func(childComplexity int) int
+		ActorUID   func(childComplexity int) int
+		After      func(childComplexity int) int
+		Before     func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		Diff       func(childComplexity int) int
+		ID         func(childComplexity int) int
+		RequestID  func(childComplexity int) int
+		TargetType func(childComplexity int) int
+		TargetUID  func(childComplexity int) int
+		UID        func(childComplexity int) int
+	}
+
 	AdminUser struct {
 		CreatedAt func(childComplexity int) int
 		Email     func(childComplexity int) int
 		ID        func(childComplexity int) int
 		IsActive  func(childComplexity int) int
+		Role      func(childComplexity int) int
 		UID       func(childComplexity int) int
 		UpdatedAt func(childComplexity int) int
 		Username  func(childComplexity int) int
 	}
 
+	AiCostBucket struct {
+		Cost         func(childComplexity int) int
+		Count        func(childComplexity int) int
+		ID           func(childComplexity int) int
+		ImageCount   func(childComplexity int) int
+		InputTokens  func(childComplexity int) int
+		Key          func(childComplexity int) int
+		OutputTokens func(childComplexity int) int
+		TotalTokens  func(childComplexity int) int
+	}
+
 	AiExecution struct {
+		Cost              func(childComplexity int) int
 		CreatedAt         func(childComplexity int) int
 		ElapsedTime       func(childComplexity int) int
 		ErrorMessage      func(childComplexity int) int
 		ID                func(childComplexity int) int
+		ImageCount        func(childComplexity int) int
 		InputImageBase64  func(childComplexity int) int
 		InputTokens       func(childComplexity int) int
 		Inputkvs          func(childComplexity int) int
@@ -105,29 +142,33 @@ type ComplexityRoot struct {
 	}
 
 	ExtractDaeunPeriod struct {
-		AgeFrom      func(childComplexity int) int
-		AgeTo        func(childComplexity int) int
-		Branch       func(childComplexity int) int
-		BranchEl     func(childComplexity int) int
-		BranchHanja  func(childComplexity int) int
-		BranchKo     func(childComplexity int) int
-		BranchTenGod func(childComplexity int) int
-		BranchTwelve func(childComplexity int) int
-		BranchYy     func(childComplexity int) int
-		Day          func(childComplexity int) int
-		GanjiHanja   func(childComplexity int) int
-		GanjiKo      func(childComplexity int) int
-		Month        func(childComplexity int) int
-		Order        func(childComplexity int) int
-		StartYear    func(childComplexity int) int
-		Stem         func(childComplexity int) int
-		StemEl       func(childComplexity int) int
-		StemHanja    func(childComplexity int) int
-		StemKo       func(childComplexity int) int
-		StemTenGod   func(childComplexity int) int
-		StemYy       func(childComplexity int) int
-		Type         func(childComplexity int) int
-		Year         func(childComplexity int) int
+		AgeFrom        func(childComplexity int) int
+		AgeTo          func(childComplexity int) int
+		Branch         func(childComplexity int) int
+		BranchEl       func(childComplexity int) int
+		BranchHanja    func(childComplexity int) int
+		BranchKo       func(childComplexity int) int
+		BranchTenGod   func(childComplexity int) int
+		BranchTwelve   func(childComplexity int) int
+		BranchYy       func(childComplexity int) int
+		Convention     func(childComplexity int) int
+		Day            func(childComplexity int) int
+		GanjiHanja     func(childComplexity int) int
+		GanjiKo        func(childComplexity int) int
+		Month          func(childComplexity int) int
+		Order          func(childComplexity int) int
+		StartAgeMonths func(childComplexity int) int
+		StartAgeYears  func(childComplexity int) int
+		StartDt        func(childComplexity int) int
+		StartYear      func(childComplexity int) int
+		Stem           func(childComplexity int) int
+		StemEl         func(childComplexity int) int
+		StemHanja      func(childComplexity int) int
+		StemKo         func(childComplexity int) int
+		StemTenGod     func(childComplexity int) int
+		StemYy         func(childComplexity int) int
+		Type           func(childComplexity int) int
+		Year           func(childComplexity int) int
 	}
 
 	ExtractElDistribution struct {
@@ -384,22 +425,24 @@ type ComplexityRoot struct {
 	}
 
 	ExtractSajuInputDisplay struct {
-		AdjustedDt    func(childComplexity int) int
-		Calendar      func(childComplexity int) int
-		DtLocal       func(childComplexity int) int
-		Engine        func(childComplexity int) int
-		FortuneBaseDt func(childComplexity int) int
-		IlunMonth     func(childComplexity int) int
-		IlunYear      func(childComplexity int) int
-		LeapMonth     func(childComplexity int) int
-		Loc           func(childComplexity int) int
-		SeunFromYear  func(childComplexity int) int
-		SeunToYear    func(childComplexity int) int
-		Sex           func(childComplexity int) int
-		SolarDt       func(childComplexity int) int
-		TimePrec      func(childComplexity int) int
-		Tz            func(childComplexity int) int
-		WolunYear     func(childComplexity int) int
+		AdjustedDt      func(childComplexity int) int
+		Calendar        func(childComplexity int) int
+		DaeunConvention func(childComplexity int) int
+		DtLocal         func(childComplexity int) int
+		Engine          func(childComplexity int) int
+		FortuneBaseDt   func(childComplexity int) int
+		IlunMonth       func(childComplexity int) int
+		IlunYear        func(childComplexity int) int
+		LeapMonth       func(childComplexity int) int
+		Loc             func(childComplexity int) int
+		SeunFromYear    func(childComplexity int) int
+		SeunToYear      func(childComplexity int) int
+		Sex             func(childComplexity int) int
+		SolarDt         func(childComplexity int) int
+		SolarTime       func(childComplexity int) int
+		TimePrec        func(childComplexity int) int
+		Tz              func(childComplexity int) int
+		WolunYear       func(childComplexity int) int
 	}
 
 	ExtractSajuNode struct {
@@ -433,6 +476,15 @@ type ComplexityRoot struct {
 		W     func(childComplexity int) int
 	}
 
+	ExtractSolarTime struct {
+		CorrectionMinutes func(childComplexity int) int
+		EquationOfTime    func(childComplexity int) int
+		LongitudeMinutes  func(childComplexity int) int
+		Mode              func(childComplexity int) int
+		SolarDt           func(childComplexity int) int
+		TzOffsetMinutes   func(childComplexity int) int
+	}
+
 	ItemNCard struct {
 		CardID        func(childComplexity int) int
 		Category      func(childComplexity int) int
@@ -457,6 +509,24 @@ type ComplexityRoot struct {
 		Version       func(childComplexity int) int
 	}
 
+	Job struct {
+		Attempts    func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		FinishedAt  func(childComplexity int) int
+		ID          func(childComplexity int) int
+		LastError   func(childComplexity int) int
+		LeaseOwner  func(childComplexity int) int
+		LeaseUntil  func(childComplexity int) int
+		MaxAttempts func(childComplexity int) int
+		RefUID      func(childComplexity int) int
+		RunAfter    func(childComplexity int) int
+		Status      func(childComplexity int) int
+		Steps       func(childComplexity int) int
+		Type        func(childComplexity int) int
+		UID         func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+	}
+
 	KV struct {
 		K func(childComplexity int) int
 		V func(childComplexity int) int
@@ -491,12 +561,15 @@ type ComplexityRoot struct {
 		DeleteSajuProfile     func(childComplexity int, uid string) int
 		Login                 func(childComplexity int, email string, password string, otp string) int
 		Logout                func(childComplexity int) int
+		PurgeJobPayload       func(childComplexity int, uid string) int
 		PutAiMeta             func(childComplexity int, input model.AiMetaInput) int
+		RetryJob              func(childComplexity int, uid string) int
 		RunAiExecution        func(childComplexity int, input model.AiExcutionInput) int
 		RunChemiGeneration    func(childComplexity int, input model.ChemiGenerationRequest) int
 		RunSajuGeneration     func(childComplexity int, input model.SajuGenerationRequest) int
 		SendLLMRequest        func(childComplexity int, input model.SendLLMRequestInput) int
 		SetAdminUserActive    func(childComplexity int, uid string, active bool) int
+		SetAdminUserRole      func(childComplexity int, uid string, role string) int
 		SetAiMetaDefault      func(childComplexity int, uid string) int
 		SetAiMetaInUse        func(childComplexity int, uid string) int
 		UpdateAdminUser       func(childComplexity int, uid string, email string, password string) int
@@ -524,22 +597,27 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		AdminAuditLogs             func(childComplexity int, input model.AdminAuditLogSearchInput) int
 		AdminUsers                 func(childComplexity int) int
+		AiCostSummary              func(childComplexity int, input model.AiCostSummaryInput) int
 		AiExecution                func(childComplexity int, uid string) int
 		AiExecutions               func(childComplexity int, input model.AiExecutionSearchInput) int
 		AiMeta                     func(childComplexity int, uid string) int
 		AiMetaKVs                  func(childComplexity int, input model.AiMetaKVsInput) int
 		AiMetaTypes                func(childComplexity int) int
 		AiMetas                    func(childComplexity int, input model.AiMetaSearchInput) int
+		Almanac                    func(childComplexity int, input model.AlmanacInput) int
 		ExtractPair                func(childComplexity int, input model.ExtractPairInput) int
 		ExtractSaju                func(childComplexity int, input model.ExtractSajuInput) int
 		ItemnCard                  func(childComplexity int, uid *string) int
 		ItemnCardByCardID          func(childComplexity int, cardID string, scope *string) int
 		ItemnCards                 func(childComplexity int, input model.ItemNCardSearchInput) int
 		ItemnCardsByTokens         func(childComplexity int, input model.ItemnCardsByTokensInput) int
+		Job                        func(childComplexity int, uid string) int
+		Jobs                       func(childComplexity int, input model.JobSearchInput) int
 		LocalLogs                  func(childComplexity int, input model.LocalLogSearchInput) int
 		PairCardsByTokens          func(childComplexity int, input model.PairCardsByTokensInput) int
-		Palja                      func(childComplexity int, birthdate string, timezone string) int
+		Palja                      func(childComplexity int, birthdate string, timezone string, calendar *string, leapMonth *bool) int
 		PhyIdealPartner            func(childComplexity int, uid string) int
 		PhyIdealPartners           func(childComplexity int, input model.PhyIdealPartnerSearchInput) int
 		SajuChart                  func(childComplexity int, input model.SajuChartInput) int
@@ -548,6 +626,7 @@ type ComplexityRoot struct {
 		SajuProfileLogs            func(childComplexity int, input model.SajuProfileLogSearchInput) int
 		SajuProfileSimilarPartners func(childComplexity int, uid string, limit int, offset int) int
 		SajuProfiles               func(childComplexity int, input model.SajuProfileSearchInput) int
+		SajuSearch                 func(childComplexity int, input model.SajuSearchInput) int
 		SystemStats                func(childComplexity int) int
 	}
 
@@ -603,6 +682,8 @@ type ComplexityRoot struct {
 	}
 
 	SajuProfile struct {
+		AiCost                  func(childComplexity int) int
+		AiTotalTokens           func(childComplexity int) int
 		Birthdate               func(childComplexity int) int
 		CreatedAt               func(childComplexity int) int
 		Email                   func(childComplexity int) int
@@ -625,14 +706,19 @@ type ComplexityRoot struct {
 		PartnerMatchTips        func(childComplexity int) int
 		PartnerPersonalityMatch func(childComplexity int) int
 		PartnerSex              func(childComplexity int) int
+		PartnerStatus           func(childComplexity int) int
 		PartnerSummary          func(childComplexity int) int
 		PhyContent              func(childComplexity int) int
 		PhyPartnerSimilarity    func(childComplexity int) int
 		PhyPartnerUID           func(childComplexity int) int
+		PhyStatus               func(childComplexity int) int
 		PhySummary              func(childComplexity int) int
 		SajuContent             func(childComplexity int) int
+		SajuStatus              func(childComplexity int) int
 		SajuSummary             func(childComplexity int) int
 		Sex                     func(childComplexity int) int
+		Status                  func(childComplexity int) int
+		StatusTransitions       func(childComplexity int) int
 		UID                     func(childComplexity int) int
 		UpdatedAt               func(childComplexity int) int
 	}
@@ -647,6 +733,13 @@ type ComplexityRoot struct {
 		UpdatedAt func(childComplexity int) int
 	}
 
+	SajuProfileStatusTransition struct {
+		At   func(childComplexity int) int
+		From func(childComplexity int) int
+		Step func(childComplexity int) int
+		To   func(childComplexity int) int
+	}
+
 	SelectedItemnCard struct {
 		CardID         func(childComplexity int) int
 		ContentSummary func(childComplexity int) int
@@ -694,6 +787,111 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AdminAuditDiff.after":
+		if e.ComplexityRoot.AdminAuditDiff.After == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditDiff.After(childComplexity), true
+
+	case "AdminAuditDiff.before":
+		if e.ComplexityRoot.AdminAuditDiff.Before == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditDiff.Before(childComplexity), true
+
+	case "AdminAuditDiff.field":
+		if e.ComplexityRoot.AdminAuditDiff.Field == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditDiff.Field(childComplexity), true
+
+	case "AdminAuditLog.action":
+		if e.ComplexityRoot.AdminAuditLog.Action == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.Action(childComplexity), true
+
+	case "AdminAuditLog.actorRole":
+		if e.ComplexityRoot.AdminAuditLog.ActorRole == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.ActorRole(childComplexity), true
+
+	case "AdminAuditLog.actorUid":
+		if e.ComplexityRoot.AdminAuditLog.ActorUID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.ActorUID(childComplexity), true
+
+	case "AdminAuditLog.after":
+		if e.ComplexityRoot.AdminAuditLog.After == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.After(childComplexity), true
+
+	case "AdminAuditLog.before":
+		if e.ComplexityRoot.AdminAuditLog.Before == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.Before(childComplexity), true
+
+	case "AdminAuditLog.createdAt":
+		if e.ComplexityRoot.AdminAuditLog.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.CreatedAt(childComplexity), true
+
+	case "AdminAuditLog.diff":
+		if e.ComplexityRoot.AdminAuditLog.Diff == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.Diff(childComplexity), true
+
+	case "AdminAuditLog.id":
+		if e.ComplexityRoot.AdminAuditLog.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.ID(childComplexity), true
+
+	case "AdminAuditLog.requestId":
+		if e.ComplexityRoot.AdminAuditLog.RequestID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.RequestID(childComplexity), true
+
+	case "AdminAuditLog.targetType":
+		if e.ComplexityRoot.AdminAuditLog.TargetType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.TargetType(childComplexity), true
+
+	case "AdminAuditLog.targetUid":
+		if e.ComplexityRoot.AdminAuditLog.TargetUID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.TargetUID(childComplexity), true
+
+	case "AdminAuditLog.uid":
+		if e.ComplexityRoot.AdminAuditLog.UID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminAuditLog.UID(childComplexity), true
+
 	case "AdminUser.createdAt":
 		if e.ComplexityRoot.AdminUser.CreatedAt == nil {
 			break
@@ -722,6 +920,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AdminUser.IsActive(childComplexity), true
 
+	case "AdminUser.role":
+		if e.ComplexityRoot.AdminUser.Role == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AdminUser.Role(childComplexity), true
+
 	case "AdminUser.uid":
 		if e.ComplexityRoot.AdminUser.UID == nil {
 			break
@@ -743,6 +948,69 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AdminUser.Username(childComplexity), true
 
+	case "AiCostBucket.cost":
+		if e.ComplexityRoot.AiCostBucket.Cost == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiCostBucket.Cost(childComplexity), true
+
+	case "AiCostBucket.count":
+		if e.ComplexityRoot.AiCostBucket.Count == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiCostBucket.Count(childComplexity), true
+
+	case "AiCostBucket.id":
+		if e.ComplexityRoot.AiCostBucket.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiCostBucket.ID(childComplexity), true
+
+	case "AiCostBucket.imageCount":
+		if e.ComplexityRoot.AiCostBucket.ImageCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiCostBucket.ImageCount(childComplexity), true
+
+	case "AiCostBucket.inputTokens":
+		if e.ComplexityRoot.AiCostBucket.InputTokens == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiCostBucket.InputTokens(childComplexity), true
+
+	case "AiCostBucket.key":
+		if e.ComplexityRoot.AiCostBucket.Key == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiCostBucket.Key(childComplexity), true
+
+	case "AiCostBucket.outputTokens":
+		if e.ComplexityRoot.AiCostBucket.OutputTokens == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiCostBucket.OutputTokens(childComplexity), true
+
+	case "AiCostBucket.totalTokens":
+		if e.ComplexityRoot.AiCostBucket.TotalTokens == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiCostBucket.TotalTokens(childComplexity), true
+
+	case "AiExecution.cost":
+		if e.ComplexityRoot.AiExecution.Cost == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiExecution.Cost(childComplexity), true
+
 	case "AiExecution.createdAt":
 		if e.ComplexityRoot.AiExecution.CreatedAt == nil {
 			break
@@ -771,6 +1039,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.AiExecution.ID(childComplexity), true
 
+	case "AiExecution.imageCount":
+		if e.ComplexityRoot.AiExecution.ImageCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.AiExecution.ImageCount(childComplexity), true
+
 	case "AiExecution.inputImageBase64":
 		if e.ComplexityRoot.AiExecution.InputImageBase64 == nil {
 			break
@@ -1142,6 +1417,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ExtractDaeunPeriod.BranchYy(childComplexity), true
 
+	case "ExtractDaeunPeriod.convention":
+		if e.ComplexityRoot.ExtractDaeunPeriod.Convention == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractDaeunPeriod.Convention(childComplexity), true
+
 	case "ExtractDaeunPeriod.day":
 		if e.ComplexityRoot.ExtractDaeunPeriod.Day == nil {
 			break
@@ -1177,6 +1459,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ExtractDaeunPeriod.Order(childComplexity), true
 
+	case "ExtractDaeunPeriod.startAgeMonths":
+		if e.ComplexityRoot.ExtractDaeunPeriod.StartAgeMonths == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractDaeunPeriod.StartAgeMonths(childComplexity), true
+
+	case "ExtractDaeunPeriod.startAgeYears":
+		if e.ComplexityRoot.ExtractDaeunPeriod.StartAgeYears == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractDaeunPeriod.StartAgeYears(childComplexity), true
+
+	case "ExtractDaeunPeriod.startDt":
+		if e.ComplexityRoot.ExtractDaeunPeriod.StartDt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractDaeunPeriod.StartDt(childComplexity), true
+
 	case "ExtractDaeunPeriod.startYear":
 		if e.ComplexityRoot.ExtractDaeunPeriod.StartYear == nil {
 			break
@@ -2479,6 +2782,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ExtractSajuInputDisplay.Calendar(childComplexity), true
 
+	case "ExtractSajuInputDisplay.daeunConvention":
+		if e.ComplexityRoot.ExtractSajuInputDisplay.DaeunConvention == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractSajuInputDisplay.DaeunConvention(childComplexity), true
+
 	case "ExtractSajuInputDisplay.dtLocal":
 		if e.ComplexityRoot.ExtractSajuInputDisplay.DtLocal == nil {
 			break
@@ -2556,6 +2866,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ExtractSajuInputDisplay.SolarDt(childComplexity), true
 
+	case "ExtractSajuInputDisplay.solarTime":
+		if e.ComplexityRoot.ExtractSajuInputDisplay.SolarTime == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractSajuInputDisplay.SolarTime(childComplexity), true
+
 	case "ExtractSajuInputDisplay.timePrec":
 		if e.ComplexityRoot.ExtractSajuInputDisplay.TimePrec == nil {
 			break
@@ -2731,6 +3048,48 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ExtractScorePart.W(childComplexity), true
 
+	case "ExtractSolarTime.correctionMinutes":
+		if e.ComplexityRoot.ExtractSolarTime.CorrectionMinutes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractSolarTime.CorrectionMinutes(childComplexity), true
+
+	case "ExtractSolarTime.equationOfTime":
+		if e.ComplexityRoot.ExtractSolarTime.EquationOfTime == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractSolarTime.EquationOfTime(childComplexity), true
+
+	case "ExtractSolarTime.longitudeMinutes":
+		if e.ComplexityRoot.ExtractSolarTime.LongitudeMinutes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractSolarTime.LongitudeMinutes(childComplexity), true
+
+	case "ExtractSolarTime.mode":
+		if e.ComplexityRoot.ExtractSolarTime.Mode == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractSolarTime.Mode(childComplexity), true
+
+	case "ExtractSolarTime.solarDt":
+		if e.ComplexityRoot.ExtractSolarTime.SolarDt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractSolarTime.SolarDt(childComplexity), true
+
+	case "ExtractSolarTime.tzOffsetMinutes":
+		if e.ComplexityRoot.ExtractSolarTime.TzOffsetMinutes == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ExtractSolarTime.TzOffsetMinutes(childComplexity), true
+
 	case "ItemNCard.cardId":
 		if e.ComplexityRoot.ItemNCard.CardID == nil {
 			break
@@ -2878,6 +3237,111 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ItemNCard.Version(childComplexity), true
 
+	case "Job.attempts":
+		if e.ComplexityRoot.Job.Attempts == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.Attempts(childComplexity), true
+
+	case "Job.createdAt":
+		if e.ComplexityRoot.Job.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.CreatedAt(childComplexity), true
+
+	case "Job.finishedAt":
+		if e.ComplexityRoot.Job.FinishedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.FinishedAt(childComplexity), true
+
+	case "Job.id":
+		if e.ComplexityRoot.Job.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.ID(childComplexity), true
+
+	case "Job.lastError":
+		if e.ComplexityRoot.Job.LastError == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.LastError(childComplexity), true
+
+	case "Job.leaseOwner":
+		if e.ComplexityRoot.Job.LeaseOwner == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.LeaseOwner(childComplexity), true
+
+	case "Job.leaseUntil":
+		if e.ComplexityRoot.Job.LeaseUntil == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.LeaseUntil(childComplexity), true
+
+	case "Job.maxAttempts":
+		if e.ComplexityRoot.Job.MaxAttempts == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.MaxAttempts(childComplexity), true
+
+	case "Job.refUid":
+		if e.ComplexityRoot.Job.RefUID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.RefUID(childComplexity), true
+
+	case "Job.runAfter":
+		if e.ComplexityRoot.Job.RunAfter == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.RunAfter(childComplexity), true
+
+	case "Job.status":
+		if e.ComplexityRoot.Job.Status == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.Status(childComplexity), true
+
+	case "Job.steps":
+		if e.ComplexityRoot.Job.Steps == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.Steps(childComplexity), true
+
+	case "Job.type":
+		if e.ComplexityRoot.Job.Type == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.Type(childComplexity), true
+
+	case "Job.uid":
+		if e.ComplexityRoot.Job.UID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.UID(childComplexity), true
+
+	case "Job.updatedAt":
+		if e.ComplexityRoot.Job.UpdatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Job.UpdatedAt(childComplexity), true
+
 	case "KV.k":
 		if e.ComplexityRoot.KV.K == nil {
 			break
@@ -3091,6 +3555,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.Logout(childComplexity), true
 
+	case "Mutation.purgeJobPayload":
+		if e.ComplexityRoot.Mutation.PurgeJobPayload == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_purgeJobPayload_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.PurgeJobPayload(childComplexity, args["uid"].(string)), true
+
 	case "Mutation.putAiMeta":
 		if e.ComplexityRoot.Mutation.PutAiMeta == nil {
 			break
@@ -3103,6 +3579,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.PutAiMeta(childComplexity, args["input"].(model.AiMetaInput)), true
 
+	case "Mutation.retryJob":
+		if e.ComplexityRoot.Mutation.RetryJob == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_retryJob_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.RetryJob(childComplexity, args["uid"].(string)), true
+
 	case "Mutation.runAiExecution":
 		if e.ComplexityRoot.Mutation.RunAiExecution == nil {
 			break
@@ -3163,6 +3651,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Mutation.SetAdminUserActive(childComplexity, args["uid"].(string), args["active"].(bool)), true
 
+	case "Mutation.setAdminUserRole":
+		if e.ComplexityRoot.Mutation.SetAdminUserRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setAdminUserRole_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.SetAdminUserRole(childComplexity, args["uid"].(string), args["role"].(string)), true
+
 	case "Mutation.setAiMetaDefault":
 		if e.ComplexityRoot.Mutation.SetAiMetaDefault == nil {
 			break
@@ -3330,6 +3830,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.PhyIdealPartner.UpdatedAt(childComplexity), true
 
+	case "Query.adminAuditLogs":
+		if e.ComplexityRoot.Query.AdminAuditLogs == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminAuditLogs_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.AdminAuditLogs(childComplexity, args["input"].(model.AdminAuditLogSearchInput)), true
+
 	case "Query.adminUsers":
 		if e.ComplexityRoot.Query.AdminUsers == nil {
 			break
@@ -3337,6 +3849,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Query.AdminUsers(childComplexity), true
 
+	case "Query.aiCostSummary":
+		if e.ComplexityRoot.Query.AiCostSummary == nil {
+			break
+		}
+
+		args, err := ec.field_Query_aiCostSummary_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.AiCostSummary(childComplexity, args["input"].(model.AiCostSummaryInput)), true
+
 	case "Query.aiExecution":
 		if e.ComplexityRoot.Query.AiExecution == nil {
 			break
@@ -3404,6 +3928,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Query.AiMetas(childComplexity, args["input"].(model.AiMetaSearchInput)), true
 
+	case "Query.almanac":
+		if e.ComplexityRoot.Query.Almanac == nil {
+			break
+		}
+
+		args, err := ec.field_Query_almanac_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.Almanac(childComplexity, args["input"].(model.AlmanacInput)), true
+
 	case "Query.extract_pair":
 		if e.ComplexityRoot.Query.ExtractPair == nil {
 			break
@@ -3476,6 +4012,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Query.ItemnCardsByTokens(childComplexity, args["input"].(model.ItemnCardsByTokensInput)), true
 
+	case "Query.job":
+		if e.ComplexityRoot.Query.Job == nil {
+			break
+		}
+
+		args, err := ec.field_Query_job_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.Job(childComplexity, args["uid"].(string)), true
+
+	case "Query.jobs":
+		if e.ComplexityRoot.Query.Jobs == nil {
+			break
+		}
+
+		args, err := ec.field_Query_jobs_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.Jobs(childComplexity, args["input"].(model.JobSearchInput)), true
+
 	case "Query.localLogs":
 		if e.ComplexityRoot.Query.LocalLogs == nil {
 			break
@@ -3510,7 +4070,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.ComplexityRoot.Query.Palja(childComplexity, args["birthdate"].(string), args["timezone"].(string)), true
+		return e.ComplexityRoot.Query.Palja(childComplexity, args["birthdate"].(string), args["timezone"].(string), args["calendar"].(*string), args["leapMonth"].(*bool)), true
 
 	case "Query.phyIdealPartner":
 		if e.ComplexityRoot.Query.PhyIdealPartner == nil {
@@ -3608,6 +4168,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Query.SajuProfiles(childComplexity, args["input"].(model.SajuProfileSearchInput)), true
 
+	case "Query.sajuSearch":
+		if e.ComplexityRoot.Query.SajuSearch == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sajuSearch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.SajuSearch(childComplexity, args["input"].(model.SajuSearchInput)), true
+
 	case "Query.systemStats":
 		if e.ComplexityRoot.Query.SystemStats == nil {
 			break
@@ -3846,6 +4418,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SajuPillarSource.Period(childComplexity), true
 
+	case "SajuProfile.aiCost":
+		if e.ComplexityRoot.SajuProfile.AiCost == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfile.AiCost(childComplexity), true
+
+	case "SajuProfile.aiTotalTokens":
+		if e.ComplexityRoot.SajuProfile.AiTotalTokens == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfile.AiTotalTokens(childComplexity), true
+
 	case "SajuProfile.birthdate":
 		if e.ComplexityRoot.SajuProfile.Birthdate == nil {
 			break
@@ -4000,6 +4586,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SajuProfile.PartnerSex(childComplexity), true
 
+	case "SajuProfile.partnerStatus":
+		if e.ComplexityRoot.SajuProfile.PartnerStatus == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfile.PartnerStatus(childComplexity), true
+
 	case "SajuProfile.partnerSummary":
 		if e.ComplexityRoot.SajuProfile.PartnerSummary == nil {
 			break
@@ -4028,6 +4621,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SajuProfile.PhyPartnerUID(childComplexity), true
 
+	case "SajuProfile.phyStatus":
+		if e.ComplexityRoot.SajuProfile.PhyStatus == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfile.PhyStatus(childComplexity), true
+
 	case "SajuProfile.phySummary":
 		if e.ComplexityRoot.SajuProfile.PhySummary == nil {
 			break
@@ -4042,6 +4642,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SajuProfile.SajuContent(childComplexity), true
 
+	case "SajuProfile.sajuStatus":
+		if e.ComplexityRoot.SajuProfile.SajuStatus == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfile.SajuStatus(childComplexity), true
+
 	case "SajuProfile.sajuSummary":
 		if e.ComplexityRoot.SajuProfile.SajuSummary == nil {
 			break
@@ -4056,6 +4663,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SajuProfile.Sex(childComplexity), true
 
+	case "SajuProfile.status":
+		if e.ComplexityRoot.SajuProfile.Status == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfile.Status(childComplexity), true
+
+	case "SajuProfile.statusTransitions":
+		if e.ComplexityRoot.SajuProfile.StatusTransitions == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfile.StatusTransitions(childComplexity), true
+
 	case "SajuProfile.uid":
 		if e.ComplexityRoot.SajuProfile.UID == nil {
 			break
@@ -4119,6 +4740,34 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SajuProfileLog.UpdatedAt(childComplexity), true
 
+	case "SajuProfileStatusTransition.at":
+		if e.ComplexityRoot.SajuProfileStatusTransition.At == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfileStatusTransition.At(childComplexity), true
+
+	case "SajuProfileStatusTransition.from":
+		if e.ComplexityRoot.SajuProfileStatusTransition.From == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfileStatusTransition.From(childComplexity), true
+
+	case "SajuProfileStatusTransition.step":
+		if e.ComplexityRoot.SajuProfileStatusTransition.Step == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfileStatusTransition.Step(childComplexity), true
+
+	case "SajuProfileStatusTransition.to":
+		if e.ComplexityRoot.SajuProfileStatusTransition.To == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SajuProfileStatusTransition.To(childComplexity), true
+
 	case "SelectedItemnCard.cardId":
 		if e.ComplexityRoot.SelectedItemnCard.CardID == nil {
 			break
@@ -4288,11 +4937,14 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAdminAuditLogSearchInput,
+		ec.unmarshalInputAiCostSummaryInput,
 		ec.unmarshalInputAiExcutionInput,
 		ec.unmarshalInputAiExecutionSearchInput,
 		ec.unmarshalInputAiMetaInput,
 		ec.unmarshalInputAiMetaKVsInput,
 		ec.unmarshalInputAiMetaSearchInput,
+		ec.unmarshalInputAlmanacInput,
 		ec.unmarshalInputChemiGenerationPairInput,
 		ec.unmarshalInputChemiGenerationRequest,
 		ec.unmarshalInputChemiGenerationTargetInput,
@@ -4303,6 +4955,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputItemNCardInput,
 		ec.unmarshalInputItemNCardSearchInput,
 		ec.unmarshalInputItemnCardsByTokensInput,
+		ec.unmarshalInputJobSearchInput,
 		ec.unmarshalInputKVInput,
 		ec.unmarshalInputLocalLogSearchInput,
 		ec.unmarshalInputPairCardsByTokensInput,
@@ -4317,6 +4970,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputSajuProfileCreateInput,
 		ec.unmarshalInputSajuProfileLogSearchInput,
 		ec.unmarshalInputSajuProfileSearchInput,
+		ec.unmarshalInputSajuSearchInput,
 		ec.unmarshalInputSendLLMRequestInput,
 	)
 	first := true
@@ -4397,6 +5051,11 @@ var sources = []*ast.Source{
 
 scalar BigInt
 
+# 관리자 인증 필요 필드 (middleware.AdminAuthMiddleware 가 채운 관리자 uid 검사)
+directive @auth on FIELD_DEFINITION
+# 관리자 역할 권한 필요 필드 (service/AdminRolePermission.go 권한 매트릭스)
+directive @hasPerm(perm: String!) on FIELD_DEFINITION
+
 interface Node {
   id: ID
 }
@@ -4429,81 +5088,91 @@ type SimpleResult {
 
 type Query {
   # 관리자 계정
-  adminUsers: SimpleResult!
+  adminUsers: SimpleResult! @auth
+  adminAuditLogs(input: AdminAuditLogSearchInput!): SimpleResult! @auth @hasPerm(perm: "audit_log:read")
 
   # 사주 프로필
-  sajuProfiles(input: SajuProfileSearchInput!): SimpleResult!
-  sajuProfile(uid: String!): SimpleResult!
-  sajuProfileSimilarPartners(uid: String!, limit: Int!, offset: Int!): SimpleResult!
-  sajuProfileLogs(input: SajuProfileLogSearchInput!): SimpleResult!
+  sajuProfiles(input: SajuProfileSearchInput!): SimpleResult! @auth
+  sajuProfile(uid: String!): SimpleResult! @auth
+  sajuProfileSimilarPartners(uid: String!, limit: Int!, offset: Int!): SimpleResult! @auth
+  sajuProfileLogs(input: SajuProfileLogSearchInput!): SimpleResult! @auth
+  # 백그라운드 작업 (사주 프로필 분석 파이프라인)
+  jobs(input: JobSearchInput!): SimpleResult! @auth
+  job(uid: String!): SimpleResult! @auth
 
   # 이상형 파트너(물리)
-  phyIdealPartners(input: PhyIdealPartnerSearchInput!): SimpleResult!
-  phyIdealPartner(uid: String!): SimpleResult!
+  phyIdealPartners(input: PhyIdealPartnerSearchInput!): SimpleResult! @auth
+  phyIdealPartner(uid: String!): SimpleResult! @auth
 
   # AI 메타/실행
-  aiMetas(input: AiMetaSearchInput!): SimpleResult!
-  aiMeta(uid: String!): SimpleResult!
-  aiMetaTypes: SimpleResult!
-  aiMetaKVs(input: AiMetaKVsInput!): SimpleResult!
-  aiExecutions(input: AiExecutionSearchInput!): SimpleResult!
-  aiExecution(uid: String!): SimpleResult!
-  palja(birthdate: String!, timezone: String!): SimpleResult!
+  aiMetas(input: AiMetaSearchInput!): SimpleResult! @auth
+  aiMeta(uid: String!): SimpleResult! @auth
+  aiMetaTypes: SimpleResult! @auth
+  aiMetaKVs(input: AiMetaKVsInput!): SimpleResult! @auth
+  aiExecutions(input: AiExecutionSearchInput!): SimpleResult! @auth
+  aiExecution(uid: String!): SimpleResult! @auth
+  aiCostSummary(input: AiCostSummaryInput!): SimpleResult! @auth
+  palja(birthdate: String!, timezone: String!, calendar: String, leapMonth: Boolean): SimpleResult! @auth # calendar: SOLAR(기본) | LUNAR, leapMonth: 음력 윤달
+  sajuSearch(input: SajuSearchInput!): SimpleResult! @auth
+  almanac(input: AlmanacInput!): SimpleResult! @auth
 
   # 사주어셈블-ItemNCard (사주/궁합 카드)
-  itemnCards(input: ItemNCardSearchInput!): SimpleResult!
-  itemnCard(uid: String): SimpleResult
-  itemnCardByCardId(cardId: String!, scope: String): SimpleResult
+  itemnCards(input: ItemNCardSearchInput!): SimpleResult! @auth
+  itemnCard(uid: String): SimpleResult @auth
+  itemnCardByCardId(cardId: String!, scope: String): SimpleResult @auth
 
   # 사주어셈블-SajuAssemble: 명식/차트·토큰 추출 및 카드 조회 (설계: docs/SajuAssemble/GraphQL_Extract_Design.md)
   # 사주
-  sajuChart(input: SajuChartInput!): SimpleResult!
-  itemnCardsByTokens(input: ItemnCardsByTokensInput!): SimpleResult!
-  extract_saju(input: ExtractSajuInput!): SimpleResult!
+  sajuChart(input: SajuChartInput!): SimpleResult! @auth
+  itemnCardsByTokens(input: ItemnCardsByTokensInput!): SimpleResult! @auth
+  extract_saju(input: ExtractSajuInput!): SimpleResult! @auth
   # 궁합
-  sajuPairChart(input: SajuPairChartInput!): SimpleResult!
-  pairCardsByTokens(input: PairCardsByTokensInput!): SimpleResult!
-  extract_pair(input: ExtractPairInput!): SimpleResult!
+  sajuPairChart(input: SajuPairChartInput!): SimpleResult! @auth
+  pairCardsByTokens(input: PairCardsByTokensInput!): SimpleResult! @auth
+  extract_pair(input: ExtractPairInput!): SimpleResult! @auth
 
   # 로그/시스템
-  localLogs(input: LocalLogSearchInput!): SimpleResult!
-  systemStats: SimpleResult!
+  localLogs(input: LocalLogSearchInput!): SimpleResult! @auth
+  systemStats: SimpleResult! @auth
 }
 
 type Mutation {
   # 관리자 계정
   login(email: String!, password: String!, otp: String!): SimpleResult!
-  logout: SimpleResult!
-  createAdminUser(email: String!, password: String!): SimpleResult!
-  setAdminUserActive(uid: String!, active: Boolean!): SimpleResult!
-  updateAdminUser(uid: String!, email: String!, password: String!): SimpleResult!
+  logout: SimpleResult! @auth
+  createAdminUser(email: String!, password: String!): SimpleResult! @auth @hasPerm(perm: "admin_user:write")
+  setAdminUserActive(uid: String!, active: Boolean!): SimpleResult! @auth @hasPerm(perm: "admin_user:write")
+  updateAdminUser(uid: String!, email: String!, password: String!): SimpleResult! @auth @hasPerm(perm: "admin_user:write")
+  setAdminUserRole(uid: String!, role: String!): SimpleResult! @auth @hasPerm(perm: "admin_user:write")
 
   # 사주 프로필
-  createSajuProfile(input: SajuProfileCreateInput!): SimpleResult
-  deleteSajuProfile(uid: String!): SimpleResult
+  createSajuProfile(input: SajuProfileCreateInput!): SimpleResult @auth @hasPerm(perm: "saju_profile:write")
+  deleteSajuProfile(uid: String!): SimpleResult @auth @hasPerm(perm: "saju_profile:write")
+  retryJob(uid: String!): SimpleResult! @auth @hasPerm(perm: "saju_profile:write")
+  purgeJobPayload(uid: String!): SimpleResult! @auth @hasPerm(perm: "saju_profile:write") # dead job 의 이미지 등 payload 삭제
 
   # 이상형 파트너
-  createPhyIdealPartner(input: PhyIdealPartnerCreateInput!): SimpleResult
-  deletePhyIdealPartner(uid: String!): SimpleResult
+  createPhyIdealPartner(input: PhyIdealPartnerCreateInput!): SimpleResult @auth @hasPerm(perm: "phy_partner:write")
+  deletePhyIdealPartner(uid: String!): SimpleResult @auth @hasPerm(perm: "phy_partner:write")
 
   # AI 메타
-  putAiMeta(input: AiMetaInput!): SimpleResult
-  setAiMetaInUse(uid: String!): SimpleResult
-  delAiMeta(uid: String!): SimpleResult
-  setAiMetaDefault(uid: String!): SimpleResult
-  runAiExecution(input: AiExcutionInput!): SimpleResult!
+  putAiMeta(input: AiMetaInput!): SimpleResult @auth @hasPerm(perm: "ai_meta:write")
+  setAiMetaInUse(uid: String!): SimpleResult @auth @hasPerm(perm: "ai_meta:write")
+  delAiMeta(uid: String!): SimpleResult @auth @hasPerm(perm: "ai_meta:write")
+  setAiMetaDefault(uid: String!): SimpleResult @auth @hasPerm(perm: "ai_meta:write")
+  runAiExecution(input: AiExcutionInput!): SimpleResult! @auth @hasPerm(perm: "ai_execution:run")
 
   # 사주어셈블-ItemNCard
-  createItemnCard(input: ItemNCardInput!): SimpleResult
-  updateItemnCard(uid: String!, input: ItemNCardInput!): SimpleResult
-  deleteItemnCard(uid: String!): SimpleResult
+  createItemnCard(input: ItemNCardInput!): SimpleResult @auth @hasPerm(perm: "itemn_card:write")
+  updateItemnCard(uid: String!, input: ItemNCardInput!): SimpleResult @auth @hasPerm(perm: "itemn_card:write")
+  deleteItemnCard(uid: String!): SimpleResult @auth @hasPerm(perm: "itemn_card:write")
 
   # 사주어셈블-사주/궁합 생성 (실행) (추후 수정 혹은 삭제 예정)
-  runSajuGeneration(input: SajuGenerationRequest!): SajuGenerationResponse!
-  runChemiGeneration(input: ChemiGenerationRequest!): ChemiGenerationResponse!
+  runSajuGeneration(input: SajuGenerationRequest!): SajuGenerationResponse! @auth @hasPerm(perm: "llm:generate")
+  runChemiGeneration(input: ChemiGenerationRequest!): ChemiGenerationResponse! @auth @hasPerm(perm: "llm:generate")
 
   # 사주어셈블-공통 LLM 요청 (프롬프트만 전달, 카드 UID 없음)
-  sendLLMRequest(input: SendLLMRequestInput!): SimpleResult!
+  sendLLMRequest(input: SendLLMRequestInput!): SimpleResult! @auth @hasPerm(perm: "llm:generate")
 }
 
 # 관리자 계정 (목록 노드용)
@@ -4515,6 +5184,39 @@ type AdminUser implements Node {
   username: String!
   email: String!
   isActive: Boolean!
+  role: String! # owner / editor / viewer / card_author
+}
+
+# 관리자 뮤테이션 감사 로그
+type AdminAuditLog implements Node {
+  id: ID
+  uid: String!
+  createdAt: BigInt!
+  requestId: String!
+  actorUid: String!
+  actorRole: String!
+  action: String!
+  targetType: String!
+  targetUid: String!
+  before: String!
+  after: String!
+  diff: [AdminAuditDiff!]!
+}
+type AdminAuditDiff {
+  field: String!
+  before: String!
+  after: String!
+}
+input AdminAuditLogSearchInput {
+  limit: Int!
+  offset: Int!
+  actorUid: String
+  action: String
+  targetType: String
+  targetUid: String
+  requestId: String
+  fromCreatedAt: BigInt
+  toCreatedAt: BigInt
 }
 
 # 사주 프로필
@@ -4551,6 +5253,22 @@ type SajuProfile implements Node {
   partnerAge: Int!
   phyPartnerUid: String!
   phyPartnerSimilarity: Float!
+  # 진행상태 init / ing / done / error (status 는 단계 상태에서 도출)
+  status: String!
+  sajuStatus: String!
+  phyStatus: String!
+  partnerStatus: String!
+  statusTransitions: [SajuProfileStatusTransition!]!
+  # AI 사용량 누적 (이 프로필로 수행된 AiExecution 합계)
+  aiCost: Float! # USD
+  aiTotalTokens: Int!
+}
+# 상태 전이 기록 (step 이 빈 문자열이면 전체 상태)
+type SajuProfileStatusTransition {
+  step: String!
+  from: String!
+  to: String!
+  at: BigInt!
 }
 
 input SajuProfileSearchInput {
@@ -4583,6 +5301,32 @@ input SajuProfileLogSearchInput {
   status: String
 }
 
+# 백그라운드 작업 (jobs 컬렉션) - payload(이미지 등)는 노출하지 않음
+type Job implements Node {
+  id: ID
+  uid: String!
+  createdAt: BigInt!
+  updatedAt: BigInt!
+  type: String! # saju_profile.saju / saju_profile.phy
+  refUid: String!
+  status: String! # pending / running / done / dead
+  steps: [String!]!
+  attempts: Int!
+  maxAttempts: Int!
+  runAfter: BigInt!
+  leaseOwner: String!
+  leaseUntil: BigInt!
+  lastError: String!
+  finishedAt: BigInt!
+}
+input JobSearchInput {
+  limit: Int!
+  offset: Int!
+  type: String
+  status: String
+  refUid: String
+}
+
 # 이상형 파트너(물리)
 type PhyIdealPartner implements Node {
   id: ID
@@ -4698,6 +5442,8 @@ type AiExecution implements Node {
   inputTokens: Int!
   outputTokens: Int!
   totalTokens: Int!
+  imageCount: Int!
+  cost: Float! # USD
   runBy: String
   runSajuProfileUid: String
 }
@@ -4726,6 +5472,50 @@ input AiExecutionSearchInput {
   runSajuProfileUid: String
 }
 
+# AI 비용 집계 (AiExecution 사용량/비용 합계) - value: 조회된 버킷 비용 합계
+enum AiCostGroupBy {
+  DAY # KST 일자 (yyyy-mm-dd)
+  META_TYPE
+  SAJU_PROFILE
+  MODEL
+}
+# 사주 역방향 조회: 패턴(연/월/일[/시], * 와일드카드)에 맞는 현지 시간 구간
+input SajuSearchInput {
+  pattern: String!         # 예: "甲子/丙寅/戊辰/庚午", "*/*/壬戌"
+  from: String!            # 시작 현지일시(포함), yyyy-mm-dd[ HH:MM]
+  to: String!              # 끝 현지일시(제외)
+  tzOffsetMinutes: Int     # 기본 540(KST)
+  zishiConvention: String  # DAY_CHANGE_00(기본) | YAJASI | DAY_CHANGE_23
+  limit: Int               # 기본 1000, 최대 10000
+}
+
+input AlmanacInput {
+  from: String!            # 현지 날짜 yyyy-mm-dd
+  to: String               # 현지 날짜(포함), 비어있으면 from; 최대 400일
+  tzOffsetMinutes: Int     # 기본 540(KST)
+}
+
+input AiCostSummaryInput {
+  groupBy: AiCostGroupBy!
+  fromCreatedAt: BigInt
+  toCreatedAt: BigInt
+  metaType: String
+  model: String
+  runSajuProfileUid: String
+  limit: Int
+  offset: Int
+}
+type AiCostBucket implements Node {
+  id: ID
+  key: String!
+  count: Int!
+  inputTokens: Int!
+  outputTokens: Int!
+  totalTokens: Int!
+  imageCount: Int!
+  cost: Float! # USD
+}
+
 # ItemNCard (사주/궁합 카드)
 type ItemNCard implements Node {
   id: ID
@@ -4923,6 +5713,8 @@ input SajuBirthInput {
   date: String!
   time: String!
   time_precision: String
+  calendar: String # solar | lunar (음력은 1900~2100 양력 변환)
+  leap_month: Boolean # 음력 윤달 여부
 }
 input SajuGenerationUserInput {
   birth: SajuBirthInput!
@@ -5083,7 +5875,7 @@ type ExtractEngine {
 input ExtractEngineInput {
   name: String!   # 엔진 이름
   ver: String!    # 버전
-  sys: String     # 시스템 식별(옵션)
+  sys: String     # 시스템(유파) 식별(옵션) — 신살 룰팩 KR_STANDARD(기본)|KR_CLASSIC|SIMPLE
   params: Map     # 엔진 파라미터 맵
 }
 
@@ -5105,6 +5897,7 @@ input ExtractSajuInput {
   wolunYear: Int    # 월운 목록 대상 연도(옵션)
   ilunYear: Int     # 일운 목록 대상 연도(옵션)
   ilunMonth: Int    # 일운 목록 대상 월(옵션, 1..12)
+  daeunConvention: String # 대운수 산정 방식(옵션; EXACT 기본 | ROUNDED)
 }
 
 # 출생 입력 표시용 타입 (리턴 데이터)
@@ -5119,12 +5912,24 @@ type ExtractSajuInputDisplay {
   engine: ExtractEngine!   # 계산 엔진(표시용)
   solarDt: String   # 양력일시
   adjustedDt: String  # 보정일시
+  solarTime: ExtractSolarTime # 진태양시 보정 내역(loc 입력 시)
   fortuneBaseDt: String # 운세 계산 기준일시(옵션)
   seunFromYear: Int # 세운 목록 시작 연도(옵션)
   seunToYear: Int   # 세운 목록 종료 연도(옵션)
   wolunYear: Int    # 월운 목록 대상 연도(옵션)
   ilunYear: Int     # 일운 목록 대상 연도(옵션)
   ilunMonth: Int    # 일운 목록 대상 월(옵션)
+  daeunConvention: String # 대운수 산정 방식
+}
+
+# 진태양시 보정 내역: 일주·시주는 solarDt 기준
+type ExtractSolarTime {
+  mode: String!              # MEAN | APPARENT
+  tzOffsetMinutes: Int!      # 출생 시점 표준시 오프셋(분, 서머타임 등 이력 반영)
+  longitudeMinutes: Float!   # 경도 보정(분)
+  equationOfTime: Float!     # 균시차(분)
+  correctionMinutes: Int!    # 적용 보정량(분)
+  solarDt: String!           # 보정된 지방태양시
 }
 
 # 한 기둥: 천간·지지·숨은천간·나음·궁망
@@ -5245,6 +6050,10 @@ type ExtractDaeunPeriod {
   year: Int!       # 기준 연도(세운/월운/일운)
   month: Int!      # 기준 월(월운/일운)
   day: Int!        # 기준 일(일운)
+  startAgeYears: Int!  # 대운 시작 나이(만, 년)
+  startAgeMonths: Int! # 대운 시작 나이(개월)
+  startDt: String      # 대운 시작 일시(현지)
+  convention: String   # 대운수 산정 방식(EXACT/ROUNDED)
 }
 
 # 오행 분포 (목·화·토·금·수 비율)
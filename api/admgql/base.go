@@ -20,6 +20,8 @@ var (
 	adminToolServiceOnce        sync.Once
 	adminUserService            *service.AdminUserService
 	adminUserServiceOnce        sync.Once
+	adminAuditService           *service.AdminAuditService
+	adminAuditServiceOnce       sync.Once
 	localLogService             *service.LocalLogService
 	localLogServiceOnce         sync.Once
 	adminItemNCardService       *service.AdminItemNCardService
@@ -72,6 +74,13 @@ func getAdminUserService() *service.AdminUserService {
 	return adminUserService
 }
 
+func getAdminAuditService() *service.AdminAuditService {
+	adminAuditServiceOnce.Do(func() {
+		adminAuditService = service.NewAdminAuditService()
+	})
+	return adminAuditService
+}
+
 func getLocalLogService() *service.LocalLogService {
 	localLogServiceOnce.Do(func() {
 		localLogService = service.NewLocalLogService()
@@ -99,3 +108,7 @@ func getExtractSajuPairService() *service.ExtractSajuPairService {
 	})
 	return extractSajuPairService
 }
+
+func getJobQueueService() *service.JobQueueService {
+	return service.GetJobQueueService()
+}
@@ -0,0 +1,36 @@
+// GraphQL directive implementations wired into admgql_generated.DirectiveRoot
+package admgql
+
+import (
+	"context"
+
+	"sajudating_api/api/service"
+	"sajudating_api/api/utils"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Auth implements @auth: the field resolves only when the request carries an authenticated
+// admin (populated by middleware.AdminAuthMiddleware).
+func Auth(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	if _, err := utils.GetAdminUserUIDFromContext(ctx); err != nil {
+		return nil, &gqlerror.Error{
+			Message:    "Authentication required",
+			Extensions: map[string]any{"code": "UNAUTHENTICATED"},
+		}
+	}
+	return next(ctx)
+}
+
+// HasPerm implements @hasPerm(perm): the authenticated admin's role must grant perm
+// (matrix in service/AdminRolePermission.go).
+func HasPerm(ctx context.Context, obj any, next graphql.Resolver, perm string) (any, error) {
+	if err := service.CheckAdminPermission(ctx, perm); err != nil {
+		return nil, &gqlerror.Error{
+			Message:    "Permission denied",
+			Extensions: map[string]any{"code": "FORBIDDEN", "perm": perm},
+		}
+	}
+	return next(ctx)
+}
@@ -14,6 +14,42 @@ type Node interface {
 	GetID() *string
 }
 
+type AdminAuditDiff struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type AdminAuditLog struct {
+	ID         *string           `json:"id,omitempty"`
+	UID        string            `json:"uid"`
+	CreatedAt  int64             `json:"createdAt"`
+	RequestID  string            `json:"requestId"`
+	ActorUID   string            `json:"actorUid"`
+	ActorRole  string            `json:"actorRole"`
+	Action     string            `json:"action"`
+	TargetType string            `json:"targetType"`
+	TargetUID  string            `json:"targetUid"`
+	Before     string            `json:"before"`
+	After      string            `json:"after"`
+	Diff       []*AdminAuditDiff `json:"diff"`
+}
+
+func (AdminAuditLog) IsNode()             {}
+func (this AdminAuditLog) GetID() *string { return this.ID }
+
+type AdminAuditLogSearchInput struct {
+	Limit         int     `json:"limit"`
+	Offset        int     `json:"offset"`
+	ActorUID      *string `json:"actorUid,omitempty"`
+	Action        *string `json:"action,omitempty"`
+	TargetType    *string `json:"targetType,omitempty"`
+	TargetUID     *string `json:"targetUid,omitempty"`
+	RequestID     *string `json:"requestId,omitempty"`
+	FromCreatedAt *int64  `json:"fromCreatedAt,omitempty"`
+	ToCreatedAt   *int64  `json:"toCreatedAt,omitempty"`
+}
+
 type AdminUser struct {
 	ID        *string `json:"id,omitempty"`
 	UID       string  `json:"uid"`
@@ -22,11 +58,37 @@ type AdminUser struct {
 	Username  string  `json:"username"`
 	Email     string  `json:"email"`
 	IsActive  bool    `json:"isActive"`
+	Role      string  `json:"role"`
 }
 
 func (AdminUser) IsNode()             {}
 func (this AdminUser) GetID() *string { return this.ID }
 
+type AiCostBucket struct {
+	ID           *string `json:"id,omitempty"`
+	Key          string  `json:"key"`
+	Count        int     `json:"count"`
+	InputTokens  int     `json:"inputTokens"`
+	OutputTokens int     `json:"outputTokens"`
+	TotalTokens  int     `json:"totalTokens"`
+	ImageCount   int     `json:"imageCount"`
+	Cost         float64 `json:"cost"`
+}
+
+func (AiCostBucket) IsNode()             {}
+func (this AiCostBucket) GetID() *string { return this.ID }
+
+type AiCostSummaryInput struct {
+	GroupBy           AiCostGroupBy `json:"groupBy"`
+	FromCreatedAt     *int64        `json:"fromCreatedAt,omitempty"`
+	ToCreatedAt       *int64        `json:"toCreatedAt,omitempty"`
+	MetaType          *string       `json:"metaType,omitempty"`
+	Model             *string       `json:"model,omitempty"`
+	RunSajuProfileUID *string       `json:"runSajuProfileUid,omitempty"`
+	Limit             *int          `json:"limit,omitempty"`
+	Offset            *int          `json:"offset,omitempty"`
+}
+
 type AiExcutionInput struct {
 	MetaUID          string     `json:"metaUid"`
 	MetaType         string     `json:"metaType"`
@@ -66,6 +128,8 @@ type AiExecution struct {
 	InputTokens       int     `json:"inputTokens"`
 	OutputTokens      int     `json:"outputTokens"`
 	TotalTokens       int     `json:"totalTokens"`
+	ImageCount        int     `json:"imageCount"`
+	Cost              float64 `json:"cost"`
 	RunBy             *string `json:"runBy,omitempty"`
 	RunSajuProfileUID *string `json:"runSajuProfileUid,omitempty"`
 }
@@ -137,6 +201,12 @@ type AiMetaType struct {
 func (AiMetaType) IsNode()             {}
 func (this AiMetaType) GetID() *string { return &this.ID }
 
+type AlmanacInput struct {
+	From            string  `json:"from"`
+	To              *string `json:"to,omitempty"`
+	TzOffsetMinutes *int    `json:"tzOffsetMinutes,omitempty"`
+}
+
 type ChemiGenerationPairInput struct {
 	BirthA   *SajuBirthInput `json:"birthA"`
 	BirthB   *SajuBirthInput `json:"birthB"`
@@ -164,29 +234,33 @@ type ChemiGenerationTargetOutput struct {
 }
 
 type ExtractDaeunPeriod struct {
-	Type         string             `json:"type"`
-	Order        int                `json:"order"`
-	Stem         int                `json:"stem"`
-	Branch       int                `json:"branch"`
-	StemKo       *string            `json:"stemKo,omitempty"`
-	StemHanja    *string            `json:"stemHanja,omitempty"`
-	BranchKo     *string            `json:"branchKo,omitempty"`
-	BranchHanja  *string            `json:"branchHanja,omitempty"`
-	GanjiKo      *string            `json:"ganjiKo,omitempty"`
-	GanjiHanja   *string            `json:"ganjiHanja,omitempty"`
-	StemEl       *ExtractFiveEl     `json:"stemEl,omitempty"`
-	StemYy       *ExtractYinYang    `json:"stemYy,omitempty"`
-	StemTenGod   *ExtractTenGod     `json:"stemTenGod,omitempty"`
-	BranchEl     *ExtractFiveEl     `json:"branchEl,omitempty"`
-	BranchYy     *ExtractYinYang    `json:"branchYy,omitempty"`
-	BranchTenGod *ExtractTenGod     `json:"branchTenGod,omitempty"`
-	BranchTwelve *ExtractTwelveFate `json:"branchTwelve,omitempty"`
-	AgeFrom      int                `json:"ageFrom"`
-	AgeTo        int                `json:"ageTo"`
-	StartYear    int                `json:"startYear"`
-	Year         int                `json:"year"`
-	Month        int                `json:"month"`
-	Day          int                `json:"day"`
+	Type           string             `json:"type"`
+	Order          int                `json:"order"`
+	Stem           int                `json:"stem"`
+	Branch         int                `json:"branch"`
+	StemKo         *string            `json:"stemKo,omitempty"`
+	StemHanja      *string            `json:"stemHanja,omitempty"`
+	BranchKo       *string            `json:"branchKo,omitempty"`
+	BranchHanja    *string            `json:"branchHanja,omitempty"`
+	GanjiKo        *string            `json:"ganjiKo,omitempty"`
+	GanjiHanja     *string            `json:"ganjiHanja,omitempty"`
+	StemEl         *ExtractFiveEl     `json:"stemEl,omitempty"`
+	StemYy         *ExtractYinYang    `json:"stemYy,omitempty"`
+	StemTenGod     *ExtractTenGod     `json:"stemTenGod,omitempty"`
+	BranchEl       *ExtractFiveEl     `json:"branchEl,omitempty"`
+	BranchYy       *ExtractYinYang    `json:"branchYy,omitempty"`
+	BranchTenGod   *ExtractTenGod     `json:"branchTenGod,omitempty"`
+	BranchTwelve   *ExtractTwelveFate `json:"branchTwelve,omitempty"`
+	AgeFrom        int                `json:"ageFrom"`
+	AgeTo          int                `json:"ageTo"`
+	StartYear      int                `json:"startYear"`
+	Year           int                `json:"year"`
+	Month          int                `json:"month"`
+	Day            int                `json:"day"`
+	StartAgeYears  int                `json:"startAgeYears"`
+	StartAgeMonths int                `json:"startAgeMonths"`
+	StartDt        *string            `json:"startDt,omitempty"`
+	Convention     *string            `json:"convention,omitempty"`
 }
 
 type ExtractElDistribution struct {
@@ -468,41 +542,44 @@ type ExtractSajuEdge struct {
 }
 
 type ExtractSajuInput struct {
-	DtLocal       string                `json:"dtLocal"`
-	Tz            string                `json:"tz"`
-	Loc           *ExtractGeoInput      `json:"loc,omitempty"`
-	Calendar      *string               `json:"calendar,omitempty"`
-	LeapMonth     *bool                 `json:"leapMonth,omitempty"`
-	Sex           *string               `json:"sex,omitempty"`
-	TimePrec      *ExtractTimePrecision `json:"timePrec,omitempty"`
-	Engine        *ExtractEngineInput   `json:"engine"`
-	SolarDt       *string               `json:"solarDt,omitempty"`
-	AdjustedDt    *string               `json:"adjustedDt,omitempty"`
-	FortuneBaseDt *string               `json:"fortuneBaseDt,omitempty"`
-	SeunFromYear  *int                  `json:"seunFromYear,omitempty"`
-	SeunToYear    *int                  `json:"seunToYear,omitempty"`
-	WolunYear     *int                  `json:"wolunYear,omitempty"`
-	IlunYear      *int                  `json:"ilunYear,omitempty"`
-	IlunMonth     *int                  `json:"ilunMonth,omitempty"`
+	DtLocal         string                `json:"dtLocal"`
+	Tz              string                `json:"tz"`
+	Loc             *ExtractGeoInput      `json:"loc,omitempty"`
+	Calendar        *string               `json:"calendar,omitempty"`
+	LeapMonth       *bool                 `json:"leapMonth,omitempty"`
+	Sex             *string               `json:"sex,omitempty"`
+	TimePrec        *ExtractTimePrecision `json:"timePrec,omitempty"`
+	Engine          *ExtractEngineInput   `json:"engine"`
+	SolarDt         *string               `json:"solarDt,omitempty"`
+	AdjustedDt      *string               `json:"adjustedDt,omitempty"`
+	FortuneBaseDt   *string               `json:"fortuneBaseDt,omitempty"`
+	SeunFromYear    *int                  `json:"seunFromYear,omitempty"`
+	SeunToYear      *int                  `json:"seunToYear,omitempty"`
+	WolunYear       *int                  `json:"wolunYear,omitempty"`
+	IlunYear        *int                  `json:"ilunYear,omitempty"`
+	IlunMonth       *int                  `json:"ilunMonth,omitempty"`
+	DaeunConvention *string               `json:"daeunConvention,omitempty"`
 }
 
 type ExtractSajuInputDisplay struct {
-	DtLocal       string                `json:"dtLocal"`
-	Tz            string                `json:"tz"`
-	Loc           *ExtractGeo           `json:"loc,omitempty"`
-	Calendar      *string               `json:"calendar,omitempty"`
-	LeapMonth     *bool                 `json:"leapMonth,omitempty"`
-	Sex           *string               `json:"sex,omitempty"`
-	TimePrec      *ExtractTimePrecision `json:"timePrec,omitempty"`
-	Engine        *ExtractEngine        `json:"engine"`
-	SolarDt       *string               `json:"solarDt,omitempty"`
-	AdjustedDt    *string               `json:"adjustedDt,omitempty"`
-	FortuneBaseDt *string               `json:"fortuneBaseDt,omitempty"`
-	SeunFromYear  *int                  `json:"seunFromYear,omitempty"`
-	SeunToYear    *int                  `json:"seunToYear,omitempty"`
-	WolunYear     *int                  `json:"wolunYear,omitempty"`
-	IlunYear      *int                  `json:"ilunYear,omitempty"`
-	IlunMonth     *int                  `json:"ilunMonth,omitempty"`
+	DtLocal         string                `json:"dtLocal"`
+	Tz              string                `json:"tz"`
+	Loc             *ExtractGeo           `json:"loc,omitempty"`
+	Calendar        *string               `json:"calendar,omitempty"`
+	LeapMonth       *bool                 `json:"leapMonth,omitempty"`
+	Sex             *string               `json:"sex,omitempty"`
+	TimePrec        *ExtractTimePrecision `json:"timePrec,omitempty"`
+	Engine          *ExtractEngine        `json:"engine"`
+	SolarDt         *string               `json:"solarDt,omitempty"`
+	AdjustedDt      *string               `json:"adjustedDt,omitempty"`
+	SolarTime       *ExtractSolarTime     `json:"solarTime,omitempty"`
+	FortuneBaseDt   *string               `json:"fortuneBaseDt,omitempty"`
+	SeunFromYear    *int                  `json:"seunFromYear,omitempty"`
+	SeunToYear      *int                  `json:"seunToYear,omitempty"`
+	WolunYear       *int                  `json:"wolunYear,omitempty"`
+	IlunYear        *int                  `json:"ilunYear,omitempty"`
+	IlunMonth       *int                  `json:"ilunMonth,omitempty"`
+	DaeunConvention *string               `json:"daeunConvention,omitempty"`
 }
 
 type ExtractSajuNode struct {
@@ -536,6 +613,15 @@ type ExtractScorePart struct {
 	Note  *string `json:"note,omitempty"`
 }
 
+type ExtractSolarTime struct {
+	Mode              string  `json:"mode"`
+	TzOffsetMinutes   int     `json:"tzOffsetMinutes"`
+	LongitudeMinutes  float64 `json:"longitudeMinutes"`
+	EquationOfTime    float64 `json:"equationOfTime"`
+	CorrectionMinutes int     `json:"correctionMinutes"`
+	SolarDt           string  `json:"solarDt"`
+}
+
 type ItemNCard struct {
 	ID            *string  `json:"id,omitempty"`
 	UID           string   `json:"uid"`
@@ -603,6 +689,35 @@ type ItemnCardsByTokensInput struct {
 	RuleSet *string  `json:"ruleSet,omitempty"`
 }
 
+type Job struct {
+	ID          *string  `json:"id,omitempty"`
+	UID         string   `json:"uid"`
+	CreatedAt   int64    `json:"createdAt"`
+	UpdatedAt   int64    `json:"updatedAt"`
+	Type        string   `json:"type"`
+	RefUID      string   `json:"refUid"`
+	Status      string   `json:"status"`
+	Steps       []string `json:"steps"`
+	Attempts    int      `json:"attempts"`
+	MaxAttempts int      `json:"maxAttempts"`
+	RunAfter    int64    `json:"runAfter"`
+	LeaseOwner  string   `json:"leaseOwner"`
+	LeaseUntil  int64    `json:"leaseUntil"`
+	LastError   string   `json:"lastError"`
+	FinishedAt  int64    `json:"finishedAt"`
+}
+
+func (Job) IsNode()             {}
+func (this Job) GetID() *string { return this.ID }
+
+type JobSearchInput struct {
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+	Type   *string `json:"type,omitempty"`
+	Status *string `json:"status,omitempty"`
+	RefUID *string `json:"refUid,omitempty"`
+}
+
 type Kv struct {
 	K string `json:"k"`
 	V string `json:"v"`
@@ -703,6 +818,8 @@ type SajuBirthInput struct {
 	Date          string  `json:"date"`
 	Time          string  `json:"time"`
 	TimePrecision *string `json:"time_precision,omitempty"`
+	Calendar      *string `json:"calendar,omitempty"`
+	LeapMonth     *bool   `json:"leap_month,omitempty"`
 }
 
 type SajuChart struct {
@@ -802,38 +919,45 @@ type SajuPillarSource struct {
 }
 
 type SajuProfile struct {
-	ID                      *string `json:"id,omitempty"`
-	UID                     string  `json:"uid"`
-	CreatedAt               int64   `json:"createdAt"`
-	UpdatedAt               int64   `json:"updatedAt"`
-	Sex                     string  `json:"sex"`
-	Birthdate               string  `json:"birthdate"`
-	Palja                   string  `json:"palja"`
-	Email                   string  `json:"email"`
-	Image                   string  `json:"image"`
-	ImageMimeType           string  `json:"imageMimeType"`
-	Nickname                string  `json:"nickname"`
-	SajuSummary             string  `json:"sajuSummary"`
-	SajuContent             string  `json:"sajuContent"`
-	PhySummary              string  `json:"phySummary"`
-	PhyContent              string  `json:"phyContent"`
-	MyFeatureEyes           string  `json:"myFeatureEyes"`
-	MyFeatureNose           string  `json:"myFeatureNose"`
-	MyFeatureMouth          string  `json:"myFeatureMouth"`
-	MyFeatureFaceShape      string  `json:"myFeatureFaceShape"`
-	MyFeatureNotes          string  `json:"myFeatureNotes"`
-	PartnerEmbeddingText    string  `json:"partnerEmbeddingText"`
-	PartnerMatchTips        string  `json:"partnerMatchTips"`
-	PartnerSummary          string  `json:"partnerSummary"`
-	PartnerFeatureEyes      string  `json:"partnerFeatureEyes"`
-	PartnerFeatureNose      string  `json:"partnerFeatureNose"`
-	PartnerFeatureMouth     string  `json:"partnerFeatureMouth"`
-	PartnerFeatureFaceShape string  `json:"partnerFeatureFaceShape"`
-	PartnerPersonalityMatch string  `json:"partnerPersonalityMatch"`
-	PartnerSex              string  `json:"partnerSex"`
-	PartnerAge              int     `json:"partnerAge"`
-	PhyPartnerUID           string  `json:"phyPartnerUid"`
-	PhyPartnerSimilarity    float64 `json:"phyPartnerSimilarity"`
+	ID                      *string                        `json:"id,omitempty"`
+	UID                     string                         `json:"uid"`
+	CreatedAt               int64                          `json:"createdAt"`
+	UpdatedAt               int64                          `json:"updatedAt"`
+	Sex                     string                         `json:"sex"`
+	Birthdate               string                         `json:"birthdate"`
+	Palja                   string                         `json:"palja"`
+	Email                   string                         `json:"email"`
+	Image                   string                         `json:"image"`
+	ImageMimeType           string                         `json:"imageMimeType"`
+	Nickname                string                         `json:"nickname"`
+	SajuSummary             string                         `json:"sajuSummary"`
+	SajuContent             string                         `json:"sajuContent"`
+	PhySummary              string                         `json:"phySummary"`
+	PhyContent              string                         `json:"phyContent"`
+	MyFeatureEyes           string                         `json:"myFeatureEyes"`
+	MyFeatureNose           string                         `json:"myFeatureNose"`
+	MyFeatureMouth          string                         `json:"myFeatureMouth"`
+	MyFeatureFaceShape      string                         `json:"myFeatureFaceShape"`
+	MyFeatureNotes          string                         `json:"myFeatureNotes"`
+	PartnerEmbeddingText    string                         `json:"partnerEmbeddingText"`
+	PartnerMatchTips        string                         `json:"partnerMatchTips"`
+	PartnerSummary          string                         `json:"partnerSummary"`
+	PartnerFeatureEyes      string                         `json:"partnerFeatureEyes"`
+	PartnerFeatureNose      string                         `json:"partnerFeatureNose"`
+	PartnerFeatureMouth     string                         `json:"partnerFeatureMouth"`
+	PartnerFeatureFaceShape string                         `json:"partnerFeatureFaceShape"`
+	PartnerPersonalityMatch string                         `json:"partnerPersonalityMatch"`
+	PartnerSex              string                         `json:"partnerSex"`
+	PartnerAge              int                            `json:"partnerAge"`
+	PhyPartnerUID           string                         `json:"phyPartnerUid"`
+	PhyPartnerSimilarity    float64                        `json:"phyPartnerSimilarity"`
+	Status                  string                         `json:"status"`
+	SajuStatus              string                         `json:"sajuStatus"`
+	PhyStatus               string                         `json:"phyStatus"`
+	PartnerStatus           string                         `json:"partnerStatus"`
+	StatusTransitions       []*SajuProfileStatusTransition `json:"statusTransitions"`
+	AiCost                  float64                        `json:"aiCost"`
+	AiTotalTokens           int                            `json:"aiTotalTokens"`
 }
 
 func (SajuProfile) IsNode()             {}
@@ -872,6 +996,22 @@ type SajuProfileSearchInput struct {
 	OrderDirection *string `json:"orderDirection,omitempty"`
 }
 
+type SajuProfileStatusTransition struct {
+	Step string `json:"step"`
+	From string `json:"from"`
+	To   string `json:"to"`
+	At   int64  `json:"at"`
+}
+
+type SajuSearchInput struct {
+	Pattern         string  `json:"pattern"`
+	From            string  `json:"from"`
+	To              string  `json:"to"`
+	TzOffsetMinutes *int    `json:"tzOffsetMinutes,omitempty"`
+	ZishiConvention *string `json:"zishiConvention,omitempty"`
+	Limit           *int    `json:"limit,omitempty"`
+}
+
 type SelectedItemnCard struct {
 	ID             *string  `json:"id,omitempty"`
 	CardID         string   `json:"cardId"`
@@ -918,6 +1058,65 @@ type SystemStats struct {
 func (SystemStats) IsNode()             {}
 func (this SystemStats) GetID() *string { return this.ID }
 
+type AiCostGroupBy string
+
+const (
+	AiCostGroupByDay         AiCostGroupBy = "DAY"
+	AiCostGroupByMetaType    AiCostGroupBy = "META_TYPE"
+	AiCostGroupBySajuProfile AiCostGroupBy = "SAJU_PROFILE"
+	AiCostGroupByModel       AiCostGroupBy = "MODEL"
+)
+
+var AllAiCostGroupBy = []AiCostGroupBy{
+	AiCostGroupByDay,
+	AiCostGroupByMetaType,
+	AiCostGroupBySajuProfile,
+	AiCostGroupByModel,
+}
+
+func (e AiCostGroupBy) IsValid() bool {
+	switch e {
+	case AiCostGroupByDay, AiCostGroupByMetaType, AiCostGroupBySajuProfile, AiCostGroupByModel:
+		return true
+	}
+	return false
+}
+
+func (e AiCostGroupBy) String() string {
+	return string(e)
+}
+
+func (e *AiCostGroupBy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AiCostGroupBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AiCostGroupBy", str)
+	}
+	return nil
+}
+
+func (e AiCostGroupBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AiCostGroupBy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AiCostGroupBy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ExtractFiveEl string
 
 const (
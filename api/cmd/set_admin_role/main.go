@@ -0,0 +1,38 @@
+// One-off CLI: assign a role to an admin account by email (e.g. grant owner to accounts created before roles existed).
+package main
+
+import (
+	"log"
+	"os"
+
+	"sajudating_api/api/config"
+	"sajudating_api/api/dao"
+	"sajudating_api/api/service"
+)
+
+func main() {
+	if len(os.Args) < 3 {
+		log.Fatal("usage: set_admin_role <email> <owner|editor|card_author|viewer>")
+	}
+	email, role := os.Args[1], os.Args[2]
+	if !service.IsValidAdminRole(role) {
+		log.Fatalf("invalid role: %s", role)
+	}
+	if err := config.LoadConfig(); err != nil {
+		log.Fatalf("config: %v", err)
+	}
+	if err := dao.InitDatabase(); err != nil {
+		log.Fatalf("database: %v", err)
+	}
+	defer dao.CloseDatabase()
+
+	repo := dao.NewAdminUserRepo()
+	user, err := repo.FindByEmail(email)
+	if err != nil {
+		log.Fatalf("admin user %s: %v", email, err)
+	}
+	if err := repo.UpdateRole(user.Uid, role); err != nil {
+		log.Fatalf("update role: %v", err)
+	}
+	log.Printf("admin user %s (%s) role %q -> %s", email, user.Uid, user.Role, role)
+}
@@ -2,8 +2,9 @@
 package config
 
 import (
-	"fmt"
+	"log"
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -13,6 +14,8 @@ type Config struct {
 	Database DatabaseConfig
 	OpenAI   OpenAIConfig
 	S3       S3Config
+	Jobs     JobsConfig
+	Prices   map[string]ModelPrice // LLM 모델 단가 (prices.go)
 }
 
 type ServerConfig struct {
@@ -27,7 +30,9 @@ type DatabaseConfig struct {
 }
 
 type OpenAIConfig struct {
-	APIKey string
+	APIKey      string
+	Cassette    string // LLM 호출 record / replay (빈 값이면 사용 안함)
+	CassetteDir string // cassette 파일 위치
 }
 
 type S3Config struct {
@@ -37,11 +42,18 @@ type S3Config struct {
 	Region    string
 }
 
+// JobsConfig: background job queue (SajuProfile 분석 파이프라인)
+type JobsConfig struct {
+	Workers     int // 워커 수
+	MaxAttempts int // 재시도 포함 최대 시도 횟수, 초과시 dead
+	DailyDays   int // 프로필별 일운 피드를 미리 계산해 두는 일수 (오늘 포함)
+}
+
 var AppConfig *Config
 
 func LoadConfig() error {
 	if err := godotenv.Load(); err != nil {
-		fmt.Println("Warning: .env file not found, using environment variables")
+		log.Println("Warning: .env file not found, using environment variables")
 	}
 
 	AppConfig = &Config{
@@ -55,7 +67,9 @@ func LoadConfig() error {
 			DBName: getEnv("DB_NAME", "sajudating"),
 		},
 		OpenAI: OpenAIConfig{
-			APIKey: getEnv("OPENAI_API_KEY", ""),
+			APIKey:      getEnv("OPENAI_API_KEY", ""),
+			Cassette:    getEnv("LLM_CASSETTE", ""),
+			CassetteDir: getEnv("LLM_CASSETTE_DIR", "ext_dao/testdata/cassettes"),
 		},
 		S3: S3Config{
 			Bucket:    getEnv("AWS_IMAGE_S3_BUCKET", ""),
@@ -63,7 +77,18 @@ func LoadConfig() error {
 			SecretKey: getEnv("AWS_IMAGE_SECRET", ""),
 			Region:    getEnv("AWS_REGION", "ap-northeast-2"),
 		},
+		Jobs: JobsConfig{
+			Workers:     getEnvInt("JOB_WORKERS", 4),
+			MaxAttempts: getEnvInt("JOB_MAX_ATTEMPTS", 3),
+			DailyDays:   getEnvInt("DAILY_FEED_DAYS", 7),
+		},
+	}
+
+	prices, err := loadModelPrices()
+	if err != nil {
+		log.Printf("Warning: %v, using default model prices", err)
 	}
+	AppConfig.Prices = prices
 
 	return nil
 }
@@ -84,6 +109,14 @@ func getEnvBool(key string, defaultValue bool) bool {
 	return v == "1" || v == "true" || v == "yes"
 }
 
+func getEnvInt(key string, defaultValue int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return v
+}
+
 // IsDev returns true when ENV is dev (default).
 func IsDev() bool {
 	if AppConfig == nil {
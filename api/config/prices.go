@@ -0,0 +1,76 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ModelPrice: 모델별 USD 단가 - 토큰은 1M 토큰당, 이미지는 장당 (토큰 과금 이미지 모델은 토큰 단가 사용)
+type ModelPrice struct {
+	InputPer1M  float64 `json:"input_per_1m"`
+	OutputPer1M float64 `json:"output_per_1m"`
+	PerImage    float64 `json:"per_image"`
+}
+
+// DefaultModelPrices is the built-in price table; LLM_PRICES (JSON object model → ModelPrice) overrides or adds entries.
+var DefaultModelPrices = map[string]ModelPrice{
+	"gpt-4o":                 {InputPer1M: 2.5, OutputPer1M: 10},
+	"gpt-4o-mini":            {InputPer1M: 0.15, OutputPer1M: 0.6},
+	"gpt-4.1":                {InputPer1M: 2, OutputPer1M: 8},
+	"gpt-4.1-mini":           {InputPer1M: 0.4, OutputPer1M: 1.6},
+	"gpt-4.1-nano":           {InputPer1M: 0.1, OutputPer1M: 0.4},
+	"text-embedding-3-small": {InputPer1M: 0.02},
+	"text-embedding-3-large": {InputPer1M: 0.13},
+	"gpt-image-1":            {InputPer1M: 5, OutputPer1M: 40},
+	"gpt-image-1-mini":       {InputPer1M: 2, OutputPer1M: 8},
+	"dall-e-3":               {PerImage: 0.04},
+	"dall-e-2":               {PerImage: 0.02},
+}
+
+func loadModelPrices() (map[string]ModelPrice, error) {
+	prices := make(map[string]ModelPrice, len(DefaultModelPrices))
+	for model, price := range DefaultModelPrices {
+		prices[model] = price
+	}
+	raw := os.Getenv("LLM_PRICES")
+	if raw == "" {
+		return prices, nil
+	}
+	var overrides map[string]ModelPrice
+	if err := json.Unmarshal([]byte(raw), &overrides); err != nil {
+		return prices, fmt.Errorf("invalid LLM_PRICES: %w", err)
+	}
+	for model, price := range overrides {
+		prices[model] = price
+	}
+	return prices, nil
+}
+
+// PriceOf returns the price for model, matching the longest table key that prefixes it
+// (so dated snapshots like gpt-4o-mini-2024-07-18 use gpt-4o-mini).
+func PriceOf(model string) (ModelPrice, bool) {
+	prices := DefaultModelPrices
+	if AppConfig != nil && AppConfig.Prices != nil {
+		prices = AppConfig.Prices
+	}
+	if price, ok := prices[model]; ok {
+		return price, true
+	}
+	best := ""
+	for key := range prices {
+		if strings.HasPrefix(model, key) && len(key) > len(best) {
+			best = key
+		}
+	}
+	if best == "" {
+		return ModelPrice{}, false
+	}
+	return prices[best], true
+}
+
+// Cost returns the USD cost of a call with the given token usage and generated image count.
+func (p ModelPrice) Cost(inputTokens, outputTokens, images int) float64 {
+	return float64(inputTokens)*p.InputPer1M/1e6 + float64(outputTokens)*p.OutputPer1M/1e6 + float64(images)*p.PerImage
+}
@@ -12,6 +12,10 @@ func stringPtr(value string) *string {
 }
 
 func SajuProfileToModel(profile *entity.SajuProfile) *model.SajuProfile {
+	transitions := make([]*model.SajuProfileStatusTransition, len(profile.StatusTransitions))
+	for i, t := range profile.StatusTransitions {
+		transitions[i] = &model.SajuProfileStatusTransition{Step: t.Step, From: t.From, To: t.To, At: t.At}
+	}
 
 	return &model.SajuProfile{
 		UID:                     profile.Uid,
@@ -44,6 +48,13 @@ func SajuProfileToModel(profile *entity.SajuProfile) *model.SajuProfile {
 		PartnerAge:              profile.PartnerAge,
 		PhyPartnerUID:           profile.PhyPartnerUid,
 		PhyPartnerSimilarity:    profile.PhyPartnerSimilarity,
+		Status:                  entity.NormalizeSajuProfileStatus(profile.Status),
+		SajuStatus:              profile.StepStatus(entity.SajuProfileStepSaju),
+		PhyStatus:               profile.StepStatus(entity.SajuProfileStepPhy),
+		PartnerStatus:           profile.StepStatus(entity.SajuProfileStepPartner),
+		StatusTransitions:       transitions,
+		AiCost:                  profile.AiCost,
+		AiTotalTokens:           profile.AiTotalTokens,
 	}
 }
 
@@ -82,6 +93,8 @@ func AiExecutionToModel(aiExecution *entity.AiExecution) *model.AiExecution {
 		InputTokens:  aiExecution.InputTokens,
 		OutputTokens: aiExecution.OutputTokens,
 		TotalTokens:  aiExecution.TotalTokens,
+		ImageCount:   aiExecution.ImageCount,
+		Cost:         aiExecution.Cost,
 		ErrorMessage: aiExecution.ErrorMessage,
 	}
 
@@ -126,6 +139,54 @@ func AdminUserToModel(user *entity.AdminUser) *model.AdminUser {
 		Username:  user.Username,
 		Email:     user.Email,
 		IsActive:  user.IsActive,
+		Role:      entity.EffectiveAdminRole(user.Role),
+	}
+}
+
+func AdminAuditLogToModel(l *entity.AdminAuditLog) *model.AdminAuditLog {
+	id := l.Uid
+	diff := make([]*model.AdminAuditDiff, len(l.Diff))
+	for i, d := range l.Diff {
+		diff[i] = &model.AdminAuditDiff{Field: d.Field, Before: d.Before, After: d.After}
+	}
+	return &model.AdminAuditLog{
+		ID:         &id,
+		UID:        l.Uid,
+		CreatedAt:  l.CreatedAt,
+		RequestID:  l.RequestId,
+		ActorUID:   l.ActorUid,
+		ActorRole:  l.ActorRole,
+		Action:     l.Action,
+		TargetType: l.TargetType,
+		TargetUID:  l.TargetUid,
+		Before:     l.BeforeJSON,
+		After:      l.AfterJSON,
+		Diff:       diff,
+	}
+}
+
+func JobToModel(j *entity.Job) *model.Job {
+	id := j.Uid
+	steps := j.Steps
+	if steps == nil {
+		steps = []string{}
+	}
+	return &model.Job{
+		ID:          &id,
+		UID:         j.Uid,
+		CreatedAt:   j.CreatedAt,
+		UpdatedAt:   j.UpdatedAt,
+		Type:        j.Type,
+		RefUID:      j.RefUid,
+		Status:      j.Status,
+		Steps:       steps,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		RunAfter:    j.RunAfter,
+		LeaseOwner:  j.LeaseOwner,
+		LeaseUntil:  j.LeaseUntil,
+		LastError:   j.LastError,
+		FinishedAt:  j.FinishedAt,
 	}
 }
 
@@ -0,0 +1,101 @@
+// AdminAuditLog repository for MongoDB operations on admin_audit_logs collection
+package dao
+
+import (
+	"context"
+	"time"
+
+	"sajudating_api/api/dao/entity"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AdminAuditLogRepo struct {
+	collection *mongo.Collection
+}
+
+func NewAdminAuditLogRepo() *AdminAuditLogRepo {
+	return &AdminAuditLogRepo{
+		collection: GetDB().Collection("admin_audit_logs"),
+	}
+}
+
+// AdminAuditLogFilter filters for list (all optional; created_at range in unix millis, inclusive).
+type AdminAuditLogFilter struct {
+	ActorUid   *string
+	Action     *string
+	TargetType *string
+	TargetUid  *string
+	RequestId  *string
+	FromAt     *int64
+	ToAt       *int64
+	Limit      int
+	Offset     int
+}
+
+func (r *AdminAuditLogRepo) Create(log *entity.AdminAuditLog) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	log.CreatedAt = time.Now().UnixMilli()
+
+	_, err := r.collection.InsertOne(ctx, log)
+	return err
+}
+
+func (r *AdminAuditLogRepo) FindWithPagination(f AdminAuditLogFilter) ([]entity.AdminAuditLog, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if f.ActorUid != nil && *f.ActorUid != "" {
+		filter["actor_uid"] = *f.ActorUid
+	}
+	if f.Action != nil && *f.Action != "" {
+		filter["action"] = *f.Action
+	}
+	if f.TargetType != nil && *f.TargetType != "" {
+		filter["target_type"] = *f.TargetType
+	}
+	if f.TargetUid != nil && *f.TargetUid != "" {
+		filter["target_uid"] = *f.TargetUid
+	}
+	if f.RequestId != nil && *f.RequestId != "" {
+		filter["request_id"] = *f.RequestId
+	}
+	createdAt := bson.M{}
+	if f.FromAt != nil {
+		createdAt["$gte"] = *f.FromAt
+	}
+	if f.ToAt != nil {
+		createdAt["$lte"] = *f.ToAt
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find()
+	opts.SetLimit(int64(f.Limit))
+	opts.SetSkip(int64(f.Offset))
+	opts.SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var logs []entity.AdminAuditLog
+	if err = cursor.All(ctx, &logs); err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
@@ -127,3 +127,19 @@ func (r *AdminUserRepo) UpdateActive(uid string, active bool) error {
 	_, err := r.collection.UpdateOne(ctx, filter, update)
 	return err
 }
+
+func (r *AdminUserRepo) UpdateRole(uid string, role string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"uid": uid}
+	update := bson.M{
+		"$set": bson.M{
+			"role":       role,
+			"updated_at": time.Now().UnixMilli(),
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"sajudating_api/api/dao/entity"
@@ -162,3 +163,121 @@ func (r *AiExecutionRepository) FindWithPagination(limit, offset int, metaUID, m
 
 	return executions, total, nil
 }
+
+// AiCost group keys (AggregateCost)
+const (
+	AiCostGroupByDay         = "day"
+	AiCostGroupByMetaType    = "meta_type"
+	AiCostGroupBySajuProfile = "saju_profile"
+	AiCostGroupByModel       = "model"
+)
+
+type AiCostFilter struct {
+	FromCreatedAt     *int64
+	ToCreatedAt       *int64
+	MetaType          *string
+	Model             *string
+	RunSajuProfileUid *string
+}
+
+// AiCostBucket is the usage sum of one group.
+type AiCostBucket struct {
+	Key          string  `bson:"_id"`
+	Count        int     `bson:"count"`
+	InputTokens  int     `bson:"input_tokens"`
+	OutputTokens int     `bson:"output_tokens"`
+	TotalTokens  int     `bson:"total_tokens"`
+	ImageCount   int     `bson:"image_count"`
+	Cost         float64 `bson:"cost"`
+}
+
+var aiCostGroupKey = map[string]any{
+	// created_at(ms) → KST 날짜
+	AiCostGroupByDay: bson.M{"$dateToString": bson.M{
+		"format": "%Y-%m-%d", "date": bson.M{"$toDate": "$created_at"}, "timezone": "Asia/Seoul",
+	}},
+	AiCostGroupByMetaType:    "$meta_type",
+	AiCostGroupBySajuProfile: "$run_saju_profile_uid",
+	AiCostGroupByModel:       "$model",
+}
+
+// AggregateCost sums usage and cost per group; days are sorted ascending, other groups by cost descending.
+func (r *AiExecutionRepository) AggregateCost(groupBy string, f AiCostFilter, limit, offset int) ([]AiCostBucket, int64, error) {
+	groupKey, ok := aiCostGroupKey[groupBy]
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid group by: %s", groupBy)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	match := bson.M{}
+	createdAt := bson.M{}
+	if f.FromCreatedAt != nil {
+		createdAt["$gte"] = *f.FromCreatedAt
+	}
+	if f.ToCreatedAt != nil {
+		createdAt["$lt"] = *f.ToCreatedAt
+	}
+	if len(createdAt) > 0 {
+		match["created_at"] = createdAt
+	}
+	if f.MetaType != nil && *f.MetaType != "" {
+		match["meta_type"] = *f.MetaType
+	}
+	if f.Model != nil && *f.Model != "" {
+		match["model"] = *f.Model
+	}
+	if f.RunSajuProfileUid != nil && *f.RunSajuProfileUid != "" {
+		match["run_saju_profile_uid"] = *f.RunSajuProfileUid
+	} else if groupBy == AiCostGroupBySajuProfile {
+		match["run_saju_profile_uid"] = bson.M{"$nin": bson.A{"", nil}}
+	}
+
+	sort := bson.D{{Key: "cost", Value: -1}, {Key: "_id", Value: 1}}
+	if groupBy == AiCostGroupByDay {
+		sort = bson.D{{Key: "_id", Value: 1}}
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":           groupKey,
+			"count":         bson.M{"$sum": 1},
+			"input_tokens":  bson.M{"$sum": "$input_tokens"},
+			"output_tokens": bson.M{"$sum": "$output_tokens"},
+			"total_tokens":  bson.M{"$sum": "$total_tokens"},
+			"image_count":   bson.M{"$sum": "$image_count"},
+			"cost":          bson.M{"$sum": "$cost"},
+		}}},
+		{{Key: "$sort", Value: sort}},
+		{{Key: "$facet", Value: bson.M{
+			"total":   bson.A{bson.M{"$count": "n"}},
+			"buckets": bson.A{bson.M{"$skip": offset}, bson.M{"$limit": limit}},
+		}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var out []struct {
+		Total []struct {
+			N int64 `bson:"n"`
+		} `bson:"total"`
+		Buckets []AiCostBucket `bson:"buckets"`
+	}
+	if err := cursor.All(ctx, &out); err != nil {
+		return nil, 0, err
+	}
+	if len(out) == 0 {
+		return []AiCostBucket{}, 0, nil
+	}
+	var total int64
+	if len(out[0].Total) > 0 {
+		total = out[0].Total[0].N
+	}
+	return out[0].Buckets, total, nil
+}
@@ -91,6 +91,8 @@ func createIndexes() error {
 		"admin_user_logs",
 		"local_logs",
 		"itemn_cards",
+		"saju_profile_dailies",
+		"jobs",
 	}
 
 	// Create unique index on uid field for all collections
@@ -109,6 +111,13 @@ func createIndexes() error {
 		log.Printf("Successfully ensured vector search index for phy_ideal_partners")
 	}
 
+	// phy_ideal_partners: unique (source_profile_uid, source_job_uid) for job-created partners
+	if err := createPhyIdealPartnerSourceIndex(ctx); err != nil {
+		log.Printf("Warning: Failed to create phy_ideal_partners source index: %v", err)
+	} else {
+		log.Printf("Successfully ensured phy_ideal_partners source index")
+	}
+
 	// Create unique index on email field for admin_users
 	if err := createUniqueEmailIndex(ctx); err != nil {
 		log.Printf("Warning: Failed to create email index for admin_users: %v", err)
@@ -123,6 +132,62 @@ func createIndexes() error {
 		log.Printf("Successfully ensured itemn_cards indexes")
 	}
 
+	// jobs: unique dedupe_key (e.g. one daily feed job per profile and date)
+	if err := createJobIndexes(ctx); err != nil {
+		log.Printf("Warning: Failed to create jobs indexes: %v", err)
+	} else {
+		log.Printf("Successfully ensured jobs indexes")
+	}
+
+	// saju_profile_dailies: unique (profile_uid, date)
+	if err := createSajuProfileDailyIndexes(ctx); err != nil {
+		log.Printf("Warning: Failed to create saju_profile_dailies indexes: %v", err)
+	} else {
+		log.Printf("Successfully ensured saju_profile_dailies indexes")
+	}
+
+	return nil
+}
+
+func createPhyIdealPartnerSourceIndex(ctx context.Context) error {
+	_, err := database.Collection("phy_ideal_partners").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "source_profile_uid", Value: 1}, {Key: "source_job_uid", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("source_profile_job_unique").
+			SetPartialFilterExpression(bson.M{"source_job_uid": bson.M{"$exists": true}}),
+	})
+	if err != nil && !mongo.IsDuplicateKeyError(err) && !isIndexExistsError(err) {
+		return err
+	}
+	return nil
+}
+
+func createJobIndexes(ctx context.Context) error {
+	_, err := database.Collection("jobs").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "dedupe_key", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("dedupe_key_unique").
+			SetPartialFilterExpression(bson.M{"dedupe_key": bson.M{"$exists": true}}),
+	})
+	if err != nil && !mongo.IsDuplicateKeyError(err) && !isIndexExistsError(err) {
+		return err
+	}
+	_, err = database.Collection("job_schedules").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("name_unique"),
+	})
+	if err != nil && !mongo.IsDuplicateKeyError(err) && !isIndexExistsError(err) {
+		return err
+	}
+	return nil
+}
+
+func createSajuProfileDailyIndexes(ctx context.Context) error {
+	_, err := database.Collection("saju_profile_dailies").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "profile_uid", Value: 1}, {Key: "date", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("profile_uid_date_unique"),
+	})
+	if err != nil && !mongo.IsDuplicateKeyError(err) && !isIndexExistsError(err) {
+		return err
+	}
 	return nil
 }
 
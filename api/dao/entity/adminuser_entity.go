@@ -9,15 +9,56 @@ type AdminUser struct {
 	Password  string `bson:"password"`   // hashed password
 	SecretKey string `bson:"secret_key"` // secret key for google otp
 	IsActive  bool   `bson:"is_active"`  // active status
+	Role      string `bson:"role"`       // owner / editor / viewer / card_author (빈값: 역할 도입 이전 계정 → viewer, cmd/set_admin_role 로 지정)
 
 	LastLoginAt int64  `bson:"last_login_at"`
 	SessionKey  string `bson:"session_key"` // 로그인 세션키 - 로그인시 세션키 생성
 }
 
+// 관리자 역할
+const (
+	AdminRoleOwner      = "owner"       // 전체 권한 (계정/역할 관리 포함)
+	AdminRoleEditor     = "editor"      // 사주 프로필·파트너·AI 메타·카드 관리
+	AdminRoleViewer     = "viewer"      // 조회 전용
+	AdminRoleCardAuthor = "card_author" // 카드 등록·수정 및 생성 테스트
+)
+
+// EffectiveAdminRole maps the stored role to the one used for permission checks; accounts
+// created before roles existed (empty role) get the least-privileged viewer role until a role is
+// assigned explicitly (setAdminUserRole or cmd/set_admin_role).
+func EffectiveAdminRole(role string) string {
+	if role == "" {
+		return AdminRoleViewer
+	}
+	return role
+}
+
 type AdminUserLog struct {
 	Uid       string `bson:"uid"`
 	AdminUid  string `bson:"admin_uid"`
 	CreatedAt int64  `bson:"created_at"`
-	Type      string `bson:"type"` // login, logout
+	Type      string `bson:"type"` // login, logout, create, update, activate, deactivate, role
 	Msg       string `bson:"msg"`
 }
+
+// 관리자 뮤테이션 감사 로그 - 누가(actor) 무엇을(action/target) 언제 어떻게(before/after/diff) 변경했는지
+type AdminAuditLog struct {
+	Uid        string           `bson:"uid"`
+	CreatedAt  int64            `bson:"created_at"`
+	RequestId  string           `bson:"request_id"` // X-Request-Id (middleware.RequestIDMiddleware)
+	ActorUid   string           `bson:"actor_uid"`
+	ActorRole  string           `bson:"actor_role"`
+	Action     string           `bson:"action"`      // GraphQL mutation name (putAiMeta, setAiMetaInUse, ...)
+	TargetType string           `bson:"target_type"` // ai_meta, itemn_card, saju_profile, phy_ideal_partner, ai_execution
+	TargetUid  string           `bson:"target_uid"`
+	BeforeJSON string           `bson:"before_json"` // 변경 전 문서 (생성 시 빈값)
+	AfterJSON  string           `bson:"after_json"`  // 변경 후 문서 (삭제 시 빈값)
+	Diff       []AdminAuditDiff `bson:"diff"`
+}
+
+// 감사 로그의 필드 단위 변경 (값은 JSON 표현)
+type AdminAuditDiff struct {
+	Field  string `bson:"field"`
+	Before string `bson:"before"`
+	After  string `bson:"after"`
+}
@@ -37,11 +37,13 @@ type AiExecution struct {
 	ErrorMessage  string  `bson:"error_message"`
 
 	//
-	ElapsedTime  int    `bson:"elapsed_time"`
-	OutputText   string `bson:"output_text"`
-	InputTokens  int    `bson:"input_tokens"`
-	OutputTokens int    `bson:"output_tokens"`
-	TotalTokens  int    `bson:"total_tokens"`
+	ElapsedTime  int     `bson:"elapsed_time"`
+	OutputText   string  `bson:"output_text"`
+	InputTokens  int     `bson:"input_tokens"`
+	OutputTokens int     `bson:"output_tokens"`
+	TotalTokens  int     `bson:"total_tokens"`
+	ImageCount   int     `bson:"image_count"` // 생성 이미지 수
+	Cost         float64 `bson:"cost"`        // USD, config 단가표 기준
 
 	RunBy             string `bson:"run_by"` // admin, system
 	RunSajuProfileUid string `bson:"run_saju_profile_uid"`
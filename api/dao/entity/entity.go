@@ -16,14 +16,20 @@ type SajuProfile struct {
 	UpdatedAt int64  `bson:"updated_at"`
 	Sex       string `bson:"sex"`       // 필수
 	Palja     string `bson:"palja"`     // 팔자
-	Birthdate string `bson:"birthdate"` // yyyymmddhhmm format (hhmm optional)
+	Birthdate string `bson:"birthdate"` // yyyymmddhhmm format (hhmm optional), 음력 입력이면 양력 변환값
+	// 음력 입력 원본 (calendar=LUNAR 일 때만)
+	Calendar       string `bson:"calendar,omitempty"`        // SOLAR / LUNAR (비면 SOLAR)
+	LunarBirthdate string `bson:"lunar_birthdate,omitempty"` // yyyymmddhhmm format (hhmm optional)
+	LeapMonth      bool   `bson:"leap_month,omitempty"`      // 윤달 여부
 	// ImageData     []byte `bson:"image_data"` - 삭제됨
 	ImageMimeType string `bson:"image_mime_type"`
-	Email         string `bson:"email"`          // optional
-	Status        string `bson:"status"`         // init / ing / done / error
-	SajuStatus    string `bson:"saju_status"`    // init / ing / done / error
-	PhyStatus     string `bson:"phy_status"`     // init / ing / done / error
-	PartnerStatus string `bson:"partner_status"` // init / ing / done / error
+	Email         string `bson:"email"` // optional
+	// 상태는 SajuProfileRepository.TransitStep 으로만 변경 (saju_profile_status.go)
+	Status            string                        `bson:"status"`         // init / ing / done / error - 단계 상태에서 도출
+	SajuStatus        string                        `bson:"saju_status"`    // init / ing / done / error
+	PhyStatus         string                        `bson:"phy_status"`     // init / ing / done / error
+	PartnerStatus     string                        `bson:"partner_status"` // init / ing / done / error
+	StatusTransitions []SajuProfileStatusTransition `bson:"status_transitions,omitempty"`
 
 	// 추론결과정보
 	Nickname string `bson:"nickname"` // 사주기반
@@ -58,6 +64,10 @@ type SajuProfile struct {
 	// 파트너 정보
 	PhyPartnerUid        string  `bson:"phy_partner_uid"`        // 파트너 관상 UID
 	PhyPartnerSimilarity float64 `bson:"phy_partner_similarity"` // 파트너 관상 유사도 - 생성시 1, 캐시에서 찾았다면 해당 유사도 값으로 설정
+
+	// AI 사용량 누적 (AiExecution 완료시 SajuProfileRepository.AddAiUsage 로만 증가)
+	AiCost        float64 `bson:"ai_cost"` // USD
+	AiTotalTokens int     `bson:"ai_total_tokens"`
 }
 
 func (p *SajuProfile) GeneratePhyPartnerEmbeddingText() string {
@@ -95,6 +105,10 @@ type PhyIdealPartner struct {
 
 	// 유사도
 	SimilarityScore float64 `bson:"similarity_score"`
+
+	// 관상 job 이 생성한 경우 출처 - (profile uid, job uid) 당 하나 (재시도시 재사용)
+	SourceProfileUid string `bson:"source_profile_uid,omitempty"`
+	SourceJobUid     string `bson:"source_job_uid,omitempty"`
 }
 
 func (p *PhyIdealPartner) GenerateEmbeddingText() string {
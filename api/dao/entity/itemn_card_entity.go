@@ -8,7 +8,7 @@ type ItemNCard struct {
 	Version        int      `bson:"version"`
 	Status         string   `bson:"status"` // e.g. published, draft
 	RuleSet        string   `bson:"rule_set"`
-	Scope          string   `bson:"scope"` // "saju" | "pair", default saju
+	Scope          string   `bson:"scope"` // "saju" | "pair" | "daily"(일운), default saju
 	Title          string   `bson:"title"`
 	Category       string   `bson:"category"`
 	Tags           []string `bson:"tags"`
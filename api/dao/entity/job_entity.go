@@ -0,0 +1,47 @@
+package entity
+
+// Job 상태 (Job.Status)
+const (
+	JobStatusPending = "pending" // 실행 대기 (run_after 이후 리스 가능)
+	JobStatusRunning = "running" // 워커가 리스 보유중 (lease_until 지나면 재리스 가능)
+	JobStatusDone    = "done"
+	JobStatusDead    = "dead" // 재시도 소진 - dead-letter, 관리자 retryJob 으로만 재실행
+)
+
+// 백그라운드 작업 (jobs 컬렉션). SajuProfile 분석 파이프라인 등.
+// 단계(Steps)는 완료시마다 기록되어 재시도/재시작시 완료된 단계는 건너뛴다.
+type Job struct {
+	Uid         string            `bson:"uid"`
+	CreatedAt   int64             `bson:"created_at"`
+	UpdatedAt   int64             `bson:"updated_at"`
+	Type        string            `bson:"type"`                 // saju_profile.saju / saju_profile.phy / saju_profile.daily
+	RefUid      string            `bson:"ref_uid"`              // 대상 uid (SajuProfile uid)
+	DedupeKey   string            `bson:"dedupe_key,omitempty"` // 같은 키의 job 은 하나만 (예: 일운 job 의 type:profile:date)
+	Status      string            `bson:"status"`               // pending / running / done / dead
+	Steps       []string          `bson:"steps"`                // 완료된 단계
+	Payload     map[string]string `bson:"payload"`              // 단계 입력 및 중간 결과
+	Attempts    int               `bson:"attempts"`
+	MaxAttempts int               `bson:"max_attempts"`
+	RunAfter    int64             `bson:"run_after"` // 이 시각 이후 실행 (재시도 backoff)
+	LeaseOwner  string            `bson:"lease_owner"`
+	LeaseUntil  int64             `bson:"lease_until"`
+	LastError   string            `bson:"last_error"`
+	FinishedAt  int64             `bson:"finished_at"`
+}
+
+// HasStep reports whether step was already completed.
+func (j *Job) HasStep(step string) bool {
+	for _, s := range j.Steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+// 주기 작업의 마지막 실행 표시 (job_schedules 컬렉션). 여러 인스턴스·재시작에도 한 번만 등록하도록.
+type JobSchedule struct {
+	Name       string `bson:"name"`         // 예: saju_profile.daily
+	LastRunKey string `bson:"last_run_key"` // 마지막으로 등록을 끝낸 키 (예: KST 날짜)
+	UpdatedAt  int64  `bson:"updated_at"`
+}
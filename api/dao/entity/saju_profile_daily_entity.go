@@ -0,0 +1,27 @@
+// SajuProfileDaily entity: 프로필별 일운(日運) 피드 (saju_profile_dailies collection).
+package entity
+
+// SajuProfileDaily is one materialized day of 일운 for a SajuProfile (profile_uid + date unique).
+type SajuProfileDaily struct {
+	Uid          string                 `bson:"uid"`
+	ProfileUid   string                 `bson:"profile_uid"`
+	Date         string                 `bson:"date"` // 현지(KST) 날짜 yyyy-mm-dd
+	CreatedAt    int64                  `bson:"created_at"`
+	UpdatedAt    int64                  `bson:"updated_at"`
+	GanjiKo      string                 `bson:"ganji_ko"`       // 일진 (예: 갑자)
+	GanjiHanja   string                 `bson:"ganji_hanja"`    // 일진 (예: 甲子)
+	StemTenGod   string                 `bson:"stem_ten_god"`   // 일진 천간 십성 (원국 일간 기준)
+	BranchTenGod string                 `bson:"branch_ten_god"` // 일진 지지 십성
+	BranchTwelve string                 `bson:"branch_twelve"`  // 일진 지지 십이운성
+	Tokens       []string               `bson:"tokens"`
+	Cards        []SajuProfileDailyCard `bson:"cards"` // scope "daily" 카드 중 트리거 통과분 (우선순위·점수 순)
+}
+
+// SajuProfileDailyCard is a snapshot of one triggered daily card.
+type SajuProfileDailyCard struct {
+	CardID      string   `bson:"card_id"`
+	Title       string   `bson:"title"`
+	Score       int      `bson:"score"`
+	Evidence    []string `bson:"evidence"`
+	ContentJSON string   `bson:"content_json"`
+}
@@ -0,0 +1,85 @@
+package entity
+
+// SajuProfile 분석 단계 (SajuProfileStatusTransition.Step)
+const (
+	SajuProfileStepSaju    = "saju"    // 사주 추론
+	SajuProfileStepPhy     = "phy"     // 관상 추론
+	SajuProfileStepPartner = "partner" // 이상형 파트너 바인딩 (필요시 이미지 생성)
+)
+
+// SajuProfile 단계/전체 상태값
+const (
+	SajuProfileStatusInit  = "init"
+	SajuProfileStatusIng   = "ing"
+	SajuProfileStatusDone  = "done"
+	SajuProfileStatusError = "error"
+)
+
+// 상태 전이 기록. Step 이 빈 문자열이면 전체 상태(Status) 전이.
+type SajuProfileStatusTransition struct {
+	Step string `bson:"step"`
+	From string `bson:"from"`
+	To   string `bson:"to"`
+	At   int64  `bson:"at"`
+}
+
+// NormalizeSajuProfileStatus maps legacy empty status to init.
+func NormalizeSajuProfileStatus(status string) string {
+	if status == "" {
+		return SajuProfileStatusInit
+	}
+	return status
+}
+
+// CanTransitSajuProfileStatus reports whether a step may move from → to.
+// init → ing / error, ing → done / error, error → ing (재시도). done 은 최종 상태.
+func CanTransitSajuProfileStatus(from, to string) bool {
+	from, to = NormalizeSajuProfileStatus(from), NormalizeSajuProfileStatus(to)
+	switch from {
+	case SajuProfileStatusInit:
+		return to == SajuProfileStatusIng || to == SajuProfileStatusError
+	case SajuProfileStatusIng:
+		return to == SajuProfileStatusDone || to == SajuProfileStatusError
+	case SajuProfileStatusError:
+		return to == SajuProfileStatusIng
+	}
+	return false
+}
+
+// DeriveSajuProfileStatus computes the overall status from step statuses:
+// 하나라도 error → error, 모두 done → done, 모두 init → init, 그 외 ing.
+func DeriveSajuProfileStatus(steps ...string) string {
+	allDone, allInit := true, true
+	for _, st := range steps {
+		st = NormalizeSajuProfileStatus(st)
+		if st == SajuProfileStatusError {
+			return SajuProfileStatusError
+		}
+		if st != SajuProfileStatusDone {
+			allDone = false
+		}
+		if st != SajuProfileStatusInit {
+			allInit = false
+		}
+	}
+	switch {
+	case allDone:
+		return SajuProfileStatusDone
+	case allInit:
+		return SajuProfileStatusInit
+	}
+	return SajuProfileStatusIng
+}
+
+// StepStatus returns the (normalized) status of a step, "" for an unknown step.
+func (p *SajuProfile) StepStatus(step string) string {
+	switch step {
+	case SajuProfileStepSaju:
+		return NormalizeSajuProfileStatus(p.SajuStatus)
+	case SajuProfileStepPhy:
+		return NormalizeSajuProfileStatus(p.PhyStatus)
+	case SajuProfileStepPartner:
+		return NormalizeSajuProfileStatus(p.PartnerStatus)
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+package entity
+
+import "testing"
+
+func TestCanTransitSajuProfileStatus(t *testing.T) {
+	cases := []struct {
+		from, to string
+		want     bool
+	}{
+		{"", SajuProfileStatusIng, true}, // legacy empty = init
+		{SajuProfileStatusInit, SajuProfileStatusIng, true},
+		{SajuProfileStatusInit, SajuProfileStatusError, true},
+		{SajuProfileStatusInit, SajuProfileStatusDone, false},
+		{SajuProfileStatusIng, SajuProfileStatusDone, true},
+		{SajuProfileStatusIng, SajuProfileStatusError, true},
+		{SajuProfileStatusIng, SajuProfileStatusInit, false},
+		{SajuProfileStatusError, SajuProfileStatusIng, true},
+		{SajuProfileStatusError, SajuProfileStatusDone, false},
+		{SajuProfileStatusDone, SajuProfileStatusIng, false},
+		{SajuProfileStatusDone, SajuProfileStatusError, false},
+	}
+	for _, c := range cases {
+		if got := CanTransitSajuProfileStatus(c.from, c.to); got != c.want {
+			t.Errorf("CanTransitSajuProfileStatus(%q, %q) = %v, want %v", c.from, c.to, got, c.want)
+		}
+	}
+}
+
+func TestDeriveSajuProfileStatus(t *testing.T) {
+	cases := []struct {
+		steps []string
+		want  string
+	}{
+		{[]string{"", "", ""}, SajuProfileStatusInit},
+		{[]string{SajuProfileStatusInit, SajuProfileStatusInit, SajuProfileStatusInit}, SajuProfileStatusInit},
+		{[]string{SajuProfileStatusIng, SajuProfileStatusInit, SajuProfileStatusInit}, SajuProfileStatusIng},
+		{[]string{SajuProfileStatusDone, SajuProfileStatusInit, SajuProfileStatusInit}, SajuProfileStatusIng},
+		{[]string{SajuProfileStatusDone, SajuProfileStatusDone, SajuProfileStatusIng}, SajuProfileStatusIng},
+		{[]string{SajuProfileStatusDone, SajuProfileStatusDone, SajuProfileStatusDone}, SajuProfileStatusDone},
+		{[]string{SajuProfileStatusDone, SajuProfileStatusError, SajuProfileStatusInit}, SajuProfileStatusError},
+	}
+	for _, c := range cases {
+		if got := DeriveSajuProfileStatus(c.steps...); got != c.want {
+			t.Errorf("DeriveSajuProfileStatus(%v) = %q, want %q", c.steps, got, c.want)
+		}
+	}
+}
+
+func TestSajuProfileStepStatus(t *testing.T) {
+	p := &SajuProfile{SajuStatus: SajuProfileStatusDone, PhyStatus: ""}
+	if got := p.StepStatus(SajuProfileStepSaju); got != SajuProfileStatusDone {
+		t.Errorf("saju = %q, want done", got)
+	}
+	if got := p.StepStatus(SajuProfileStepPhy); got != SajuProfileStatusInit {
+		t.Errorf("phy = %q, want init", got)
+	}
+	if got := p.StepStatus("unknown"); got != "" {
+		t.Errorf("unknown = %q, want empty", got)
+	}
+}
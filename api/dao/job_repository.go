@@ -0,0 +1,352 @@
+// Job repository for MongoDB operations on jobs collection (durable background job queue)
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"sajudating_api/api/dao/entity"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrJobLeaseLost is returned by CompleteStep/Finish/Reschedule when the caller no longer holds the
+// job's lease (it expired and another worker leased the job, or the job already finished).
+var ErrJobLeaseLost = errors.New("job lease lost")
+
+type JobRepository struct {
+	collection *mongo.Collection
+}
+
+func NewJobRepository() *JobRepository {
+	return &JobRepository{
+		collection: GetDB().Collection("jobs"),
+	}
+}
+
+// JobFilter filters for list (all optional).
+type JobFilter struct {
+	Type   *string
+	Status *string
+	RefUid *string
+	Limit  int
+	Offset int
+}
+
+func (r *JobRepository) Create(job *entity.Job) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	if job.Status == "" {
+		job.Status = entity.JobStatusPending
+	}
+	if job.RunAfter == 0 {
+		job.RunAfter = now
+	}
+	if job.Steps == nil {
+		job.Steps = []string{}
+	}
+
+	_, err := r.collection.InsertOne(ctx, job)
+	return err
+}
+
+// CreateUnique stores job unless a job with the same DedupeKey already exists.
+// Returns false when the job was a duplicate (nothing stored).
+func (r *JobRepository) CreateUnique(job *entity.Job) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	if job.Status == "" {
+		job.Status = entity.JobStatusPending
+	}
+	if job.RunAfter == 0 {
+		job.RunAfter = now
+	}
+	if job.Steps == nil {
+		job.Steps = []string{}
+	}
+
+	res, err := r.collection.UpdateOne(ctx,
+		bson.M{"dedupe_key": job.DedupeKey},
+		bson.M{"$setOnInsert": job},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) { // 동시 upsert 경합
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return res.UpsertedCount > 0, nil
+}
+
+func (r *JobRepository) FindByUID(uid string) (*entity.Job, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var job entity.Job
+	err := r.collection.FindOne(ctx, bson.M{"uid": uid}).Decode(&job)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Lease atomically claims the oldest runnable job of the given types: a pending job whose run_after
+// has passed, or a running job whose lease expired (worker died). Attempts is incremented.
+// Returns nil, nil when nothing is runnable.
+func (r *JobRepository) Lease(owner string, types []string, leaseFor time.Duration) (*entity.Job, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	filter := bson.M{
+		"type": bson.M{"$in": types},
+		"$or": []bson.M{
+			{"status": entity.JobStatusPending, "run_after": bson.M{"$lte": now}},
+			{"status": entity.JobStatusRunning, "lease_until": bson.M{"$lt": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":      entity.JobStatusRunning,
+			"lease_owner": owner,
+			"lease_until": now + leaseFor.Milliseconds(),
+			"updated_at":  now,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "run_after", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job entity.Job
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// CompleteStep records a finished step and merges payload values (e.g. created partner uid).
+// unsetKeys are removed from payload (e.g. image data no longer needed). owner must hold the lease.
+func (r *JobRepository) CompleteStep(uid, owner, step string, payload map[string]string, unsetKeys ...string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	set := bson.M{"updated_at": time.Now().UnixMilli()}
+	for k, v := range payload {
+		set["payload."+k] = v
+	}
+	update := bson.M{
+		"$set":      set,
+		"$addToSet": bson.M{"steps": step},
+	}
+	if len(unsetKeys) > 0 {
+		unset := bson.M{}
+		for _, k := range unsetKeys {
+			unset["payload."+k] = ""
+		}
+		update["$unset"] = unset
+	}
+	return r.updateLeased(ctx, uid, owner, update)
+}
+
+// Finish ends a job as done or dead, releasing owner's lease. unsetKeys are removed from payload.
+func (r *JobRepository) Finish(uid, owner, status, lastError string, unsetKeys ...string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	update := bson.M{"$set": bson.M{
+		"status":      status,
+		"last_error":  lastError,
+		"lease_owner": "",
+		"lease_until": int64(0),
+		"finished_at": now,
+		"updated_at":  now,
+	}}
+	if len(unsetKeys) > 0 {
+		unset := bson.M{}
+		for _, k := range unsetKeys {
+			unset["payload."+k] = ""
+		}
+		update["$unset"] = unset
+	}
+	return r.updateLeased(ctx, uid, owner, update)
+}
+
+// Reschedule puts a failed job back to pending until runAfter (retry with backoff), releasing owner's lease.
+func (r *JobRepository) Reschedule(uid, owner string, runAfter int64, lastError string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{
+		"status":      entity.JobStatusPending,
+		"run_after":   runAfter,
+		"last_error":  lastError,
+		"lease_owner": "",
+		"lease_until": int64(0),
+		"updated_at":  time.Now().UnixMilli(),
+	}}
+	return r.updateLeased(ctx, uid, owner, update)
+}
+
+// updateLeased applies update only while owner still holds the running job's lease.
+func (r *JobRepository) updateLeased(ctx context.Context, uid, owner string, update bson.M) error {
+	filter := bson.M{"uid": uid, "status": entity.JobStatusRunning, "lease_owner": owner}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrJobLeaseLost
+	}
+	return nil
+}
+
+// Requeue resets a finished (dead) job to pending with a fresh attempt budget. Completed steps are kept.
+func (r *JobRepository) Requeue(uid string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	filter := bson.M{"uid": uid, "status": entity.JobStatusDead}
+	update := bson.M{"$set": bson.M{
+		"status":      entity.JobStatusPending,
+		"attempts":    0,
+		"run_after":   now,
+		"finished_at": int64(0),
+		"updated_at":  now,
+	}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+// PurgePayload clears the payload of a dead job (user image data is kept on dead for retryJob).
+func (r *JobRepository) PurgePayload(uid string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"uid": uid, "status": entity.JobStatusDead}
+	update := bson.M{"$set": bson.M{
+		"payload":    bson.M{},
+		"updated_at": time.Now().UnixMilli(),
+	}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+// ExtendLease pushes lease_until forward while owner still holds the running job.
+// Returns false when the lease was lost (job finished or re-leased by another worker).
+func (r *JobRepository) ExtendLease(uid, owner string, leaseFor time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	filter := bson.M{"uid": uid, "status": entity.JobStatusRunning, "lease_owner": owner}
+	update := bson.M{"$set": bson.M{
+		"lease_until": now + leaseFor.Milliseconds(),
+		"updated_at":  now,
+	}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (r *JobRepository) FindWithPagination(f JobFilter) ([]entity.Job, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if f.Type != nil && *f.Type != "" {
+		filter["type"] = *f.Type
+	}
+	if f.Status != nil && *f.Status != "" {
+		filter["status"] = *f.Status
+	}
+	if f.RefUid != nil && *f.RefUid != "" {
+		filter["ref_uid"] = *f.RefUid
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find()
+	opts.SetLimit(int64(f.Limit))
+	opts.SetSkip(int64(f.Offset))
+	opts.SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var jobs []entity.Job
+	if err = cursor.All(ctx, &jobs); err != nil {
+		return nil, 0, err
+	}
+
+	return jobs, total, nil
+}
+
+// JobScheduleRepository stores the last completed run of periodic schedulers (job_schedules).
+type JobScheduleRepository struct {
+	collection *mongo.Collection
+}
+
+func NewJobScheduleRepository() *JobScheduleRepository {
+	return &JobScheduleRepository{
+		collection: GetDB().Collection("job_schedules"),
+	}
+}
+
+// LastRunKey returns the last run key of the schedule, "" when it never ran.
+func (r *JobScheduleRepository) LastRunKey(name string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var schedule entity.JobSchedule
+	err := r.collection.FindOne(ctx, bson.M{"name": name}).Decode(&schedule)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return schedule.LastRunKey, nil
+}
+
+func (r *JobScheduleRepository) SetLastRunKey(name, key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"name": name}, bson.M{
+		"$set": bson.M{"last_run_key": key, "updated_at": time.Now().UnixMilli()},
+	}, options.Update().SetUpsert(true))
+	return err
+}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -34,6 +35,42 @@ func (r *PhyIdealPartnerRepository) Create(partner *entity.PhyIdealPartner) erro
 	return err
 }
 
+// FindBySource returns the partner created by a profile's phy job, or nil when there is none yet.
+func (r *PhyIdealPartnerRepository) FindBySource(profileUid, jobUid string) (*entity.PhyIdealPartner, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var partner entity.PhyIdealPartner
+	err := r.collection.FindOne(ctx, bson.M{"source_profile_uid": profileUid, "source_job_uid": jobUid}).Decode(&partner)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &partner, nil
+}
+
+// UpsertBySource inserts partner unless one already exists for its (source_profile_uid, source_job_uid)
+// and returns the stored document, so a retried job never creates a second partner.
+func (r *PhyIdealPartnerRepository) UpsertBySource(partner *entity.PhyIdealPartner) (*entity.PhyIdealPartner, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	partner.CreatedAt = now
+	partner.UpdatedAt = now
+
+	filter := bson.M{"source_profile_uid": partner.SourceProfileUid, "source_job_uid": partner.SourceJobUid}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var stored entity.PhyIdealPartner
+	err := r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$setOnInsert": partner}, opts).Decode(&stored)
+	if err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
 func (r *PhyIdealPartnerRepository) FindWithPagination(limit, offset int, sex *string, hasImage *bool) ([]entity.PhyIdealPartner, int64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
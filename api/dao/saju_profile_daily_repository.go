@@ -0,0 +1,81 @@
+// SajuProfileDaily repository for saju_profile_dailies collection (일운 피드 upsert/조회/정리).
+package dao
+
+import (
+	"context"
+	"time"
+
+	"sajudating_api/api/dao/entity"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SajuProfileDailyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewSajuProfileDailyRepository() *SajuProfileDailyRepository {
+	return &SajuProfileDailyRepository{
+		collection: GetDB().Collection("saju_profile_dailies"),
+	}
+}
+
+// Upsert stores daily by (profile_uid, date); uid and created_at are kept when the day already exists.
+func (r *SajuProfileDailyRepository) Upsert(daily *entity.SajuProfileDaily) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	daily.UpdatedAt = now
+	filter := bson.M{"profile_uid": daily.ProfileUid, "date": daily.Date}
+	update := bson.M{
+		"$set": bson.M{
+			"updated_at":     now,
+			"ganji_ko":       daily.GanjiKo,
+			"ganji_hanja":    daily.GanjiHanja,
+			"stem_ten_god":   daily.StemTenGod,
+			"branch_ten_god": daily.BranchTenGod,
+			"branch_twelve":  daily.BranchTwelve,
+			"tokens":         daily.Tokens,
+			"cards":          daily.Cards,
+		},
+		"$setOnInsert": bson.M{
+			"uid":        daily.Uid,
+			"created_at": now,
+		},
+	}
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *SajuProfileDailyRepository) FindByProfileAndDate(profileUid, date string) (*entity.SajuProfileDaily, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var daily entity.SajuProfileDaily
+	err := r.collection.FindOne(ctx, bson.M{"profile_uid": profileUid, "date": date}).Decode(&daily)
+	if err != nil {
+		return nil, err
+	}
+	return &daily, nil
+}
+
+// DeleteBefore removes the profile's days older than date (yyyy-mm-dd).
+func (r *SajuProfileDailyRepository) DeleteBefore(profileUid, date string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.DeleteMany(ctx, bson.M{"profile_uid": profileUid, "date": bson.M{"$lt": date}})
+	return err
+}
+
+// DeleteByProfile removes every day of the profile (프로필 삭제시).
+func (r *SajuProfileDailyRepository) DeleteByProfile(profileUid string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.DeleteMany(ctx, bson.M{"profile_uid": profileUid})
+	return err
+}
@@ -2,6 +2,8 @@ package dao
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -28,6 +30,12 @@ func (r *SajuProfileRepository) Create(profile *entity.SajuProfile) error {
 
 	profile.CreatedAt = time.Now().UnixMilli()
 	profile.UpdatedAt = time.Now().UnixMilli()
+	// 상태는 init 으로 시작 (이후 TransitStep)
+	profile.Status = entity.SajuProfileStatusInit
+	profile.SajuStatus = entity.SajuProfileStatusInit
+	profile.PhyStatus = entity.SajuProfileStatusInit
+	profile.PartnerStatus = entity.SajuProfileStatusInit
+	profile.StatusTransitions = nil
 
 	_, err := r.collection.InsertOne(ctx, profile)
 	return err
@@ -51,6 +59,30 @@ func (r *SajuProfileRepository) FindAll() ([]entity.SajuProfile, error) {
 	return profiles, nil
 }
 
+// FindAllUids returns every profile uid (일운 피드 스케줄러용, uid 만 조회).
+func (r *SajuProfileRepository) FindAllUids() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"uid": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Uid string `bson:"uid"`
+	}
+	if err = cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	uids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		uids = append(uids, row.Uid)
+	}
+	return uids, nil
+}
+
 func (r *SajuProfileRepository) FindWithPagination(limit, offset int, orderBy, orderDirection *string) ([]entity.SajuProfile, int64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -135,57 +167,134 @@ func (r *SajuProfileRepository) FindByEmail(email string) (*entity.SajuProfile,
 	return &profile, nil
 }
 
+// Update saves profile fields except status fields (status changes only via TransitStep).
 func (r *SajuProfileRepository) Update(profile *entity.SajuProfile) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	profile.UpdatedAt = time.Now().UnixMilli()
 
+	raw, err := bson.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	var set bson.M
+	if err := bson.Unmarshal(raw, &set); err != nil {
+		return err
+	}
+	for _, k := range []string{"status", "saju_status", "phy_status", "partner_status", "status_transitions", "ai_cost", "ai_total_tokens"} {
+		delete(set, k)
+	}
+
 	filter := bson.M{"uid": profile.Uid}
-	update := bson.M{"$set": profile}
+	update := bson.M{"$set": set}
 
-	_, err := r.collection.UpdateOne(ctx, filter, update)
+	_, err = r.collection.UpdateOne(ctx, filter, update)
 	return err
 }
 
-func (r *SajuProfileRepository) UpdateStatus(uid string, status, sajuStatus, phyStatus, partnerStatus *string) error {
+// AddAiUsage accumulates the cost / tokens of one AiExecution run for the profile.
+func (r *SajuProfileRepository) AddAiUsage(uid string, cost float64, totalTokens int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	filter := bson.M{"uid": uid}
-	couldUpdate := false
-	updObj := bson.M{}
-	if status != nil {
-		updObj["status"] = *status
-		couldUpdate = true
-	}
-	if sajuStatus != nil {
-		updObj["saju_status"] = *sajuStatus
-		couldUpdate = true
+	_, err := r.collection.UpdateOne(ctx, bson.M{"uid": uid}, bson.M{
+		"$inc": bson.M{"ai_cost": cost, "ai_total_tokens": totalTokens},
+	})
+	return err
+}
+
+var ErrInvalidStatusTransition = errors.New("invalid saju profile status transition")
+
+var sajuProfileStepStatusField = map[string]string{
+	entity.SajuProfileStepSaju:    "saju_status",
+	entity.SajuProfileStepPhy:     "phy_status",
+	entity.SajuProfileStepPartner: "partner_status",
+}
+
+// TransitStep moves one step to status `to` (entity.CanTransitSajuProfileStatus), re-derives the
+// overall status and appends timestamped transitions. Same-status is a no-op. The update is
+// conditioned on the step statuses read, so concurrent step updates retry instead of clobbering.
+func (r *SajuProfileRepository) TransitStep(uid, step, to string) error {
+	field, ok := sajuProfileStepStatusField[step]
+	if !ok {
+		return fmt.Errorf("%w: unknown step %q", ErrInvalidStatusTransition, step)
 	}
-	if phyStatus != nil {
-		updObj["phy_status"] = *phyStatus
-		couldUpdate = true
+	for try := 0; try < 5; try++ {
+		profile, err := r.FindByUID(uid)
+		if err != nil {
+			return err
+		}
+		from := profile.StepStatus(step)
+		if from == to {
+			return nil
+		}
+		if !entity.CanTransitSajuProfileStatus(from, to) {
+			return fmt.Errorf("%w: %s %s -> %s", ErrInvalidStatusTransition, step, from, to)
+		}
+
+		steps := map[string]string{
+			entity.SajuProfileStepSaju:    profile.StepStatus(entity.SajuProfileStepSaju),
+			entity.SajuProfileStepPhy:     profile.StepStatus(entity.SajuProfileStepPhy),
+			entity.SajuProfileStepPartner: profile.StepStatus(entity.SajuProfileStepPartner),
+		}
+		steps[step] = to
+		prevOverall := entity.NormalizeSajuProfileStatus(profile.Status)
+		overall := entity.DeriveSajuProfileStatus(
+			steps[entity.SajuProfileStepSaju], steps[entity.SajuProfileStepPhy], steps[entity.SajuProfileStepPartner],
+		)
+
+		now := time.Now().UnixMilli()
+		transitions := []entity.SajuProfileStatusTransition{{Step: step, From: from, To: to, At: now}}
+		if overall != prevOverall {
+			transitions = append(transitions, entity.SajuProfileStatusTransition{From: prevOverall, To: overall, At: now})
+		}
+
+		filter := transitStepFilter(profile)
+		update := bson.M{
+			"$set": bson.M{
+				field:        to,
+				"status":     overall,
+				"updated_at": now,
+			},
+			"$push": bson.M{"status_transitions": bson.M{"$each": transitions}},
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		res, err := r.collection.UpdateOne(ctx, filter, update)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount > 0 {
+			return nil
+		}
 	}
-	if partnerStatus != nil {
-		updObj["partner_status"] = *partnerStatus
-		couldUpdate = true
+	return fmt.Errorf("saju profile %s: %s status update conflict", uid, step)
+}
+
+// transitStepFilter matches the profile only while its step statuses are still the ones read.
+// An empty status (legacy document) also matches a missing field.
+func transitStepFilter(profile *entity.SajuProfile) bson.M {
+	statusEq := func(status string) any {
+		if status == "" {
+			return bson.M{"$in": bson.A{"", nil}}
+		}
+		return status
 	}
-	if !couldUpdate { // 업데이트 할게 없으면 리턴
-		return nil
+	return bson.M{
+		"uid":            profile.Uid,
+		"saju_status":    statusEq(profile.SajuStatus),
+		"phy_status":     statusEq(profile.PhyStatus),
+		"partner_status": statusEq(profile.PartnerStatus),
 	}
-	updObj["updated_at"] = time.Now().UnixMilli()
-	update := bson.M{"$set": updObj}
-	_, err := r.collection.UpdateOne(ctx, filter, update)
-	return err
 }
+
 func (r *SajuProfileRepository) UpdateSajuSummary(uid, summary, content, nickname, partner_tips string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	filter := bson.M{"uid": uid}
 	update := bson.M{"$set": bson.M{
-		"saju_status":        "done",
 		"saju_summary":       summary,
 		"saju_content":       content,
 		"nickname":           nickname,
@@ -241,6 +350,42 @@ func (r *SajuProfileRepository) UpdatePhyAnalysisResponse(uid, summary, content
 	return err
 }
 
+// UpdatePhyProfile sets only the phy analysis, own face feature and ideal partner fields of profile,
+// leaving fields written concurrently by other steps (e.g. saju_summary) untouched.
+func (r *SajuProfileRepository) UpdatePhyProfile(profile *entity.SajuProfile) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"uid": profile.Uid}
+	update := bson.M{"$set": bson.M{
+		"phy_summary":                profile.PhySummary,
+		"phy_content":                profile.PhyContent,
+		"my_feature_eyes":            profile.MyFeatureEyes,
+		"my_feature_nose":            profile.MyFeatureNose,
+		"my_feature_mouth":           profile.MyFeatureMouth,
+		"my_feature_face_shape":      profile.MyFeatureFaceShape,
+		"my_feature_notes":           profile.MyFeatureNotes,
+		"partner_summary":            profile.PartnerSummary,
+		"partner_feature_eyes":       profile.PartnerFeatureEyes,
+		"partner_feature_nose":       profile.PartnerFeatureNose,
+		"partner_feature_mouth":      profile.PartnerFeatureMouth,
+		"partner_feature_face_shape": profile.PartnerFeatureFaceShape,
+		"partner_personality_match":  profile.PartnerPersonalityMatch,
+		"partner_sex":                profile.PartnerSex,
+		"partner_age":                profile.PartnerAge,
+		"phy_partner_uid":            profile.PhyPartnerUid,
+		"updated_at":                 time.Now().UnixMilli(),
+	}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (r *SajuProfileRepository) UpdatePartner(uid string, partner_uid string, partner_similarity float64) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
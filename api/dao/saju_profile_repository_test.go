@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"reflect"
+	"testing"
+
+	"sajudating_api/api/dao/entity"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestTransitStepFilter_LegacyDocument(t *testing.T) {
+	// 상태 필드 도입 이전 문서: saju_status / phy_status / partner_status 가 없음
+	raw, err := bson.Marshal(bson.M{"uid": "p1", "status": "done", "saju_summary": "요약"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var profile entity.SajuProfile
+	if err := bson.Unmarshal(raw, &profile); err != nil {
+		t.Fatal(err)
+	}
+
+	filter := transitStepFilter(&profile)
+	missing := bson.M{"$in": bson.A{"", nil}}
+	for _, field := range []string{"saju_status", "phy_status", "partner_status"} {
+		if !reflect.DeepEqual(filter[field], missing) {
+			t.Errorf("%s filter = %v, want %v (matches empty or missing)", field, filter[field], missing)
+		}
+	}
+
+	profile.SajuStatus = entity.SajuProfileStatusIng
+	if got := transitStepFilter(&profile)["saju_status"]; got != entity.SajuProfileStatusIng {
+		t.Errorf("saju_status filter = %v, want %q", got, entity.SajuProfileStatusIng)
+	}
+}
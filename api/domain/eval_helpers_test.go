@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// evalTestAt: 평가 테스트 공통 기준 시각 (나이·세운 계산용)
+var evalTestAt = time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
+
+// docOf builds the SajuDoc of raw at evalTestAt.
+func docOf(t *testing.T, raw RawPillars) *SajuDoc {
+	t.Helper()
+	doc, err := BuildSajuDocAt(BirthInput{}, raw, evalTestAt)
+	if err != nil {
+		t.Fatalf("BuildSajuDocAt() error = %v", err)
+	}
+	return doc
+}
+
+// evalOf returns the first kind eval of raw's SajuDoc, failing the test when there is none.
+func evalOf(t *testing.T, raw RawPillars, kind EvalKind) EvalItem {
+	t.Helper()
+	for _, e := range docOf(t, raw).Evals {
+		if e.K == kind {
+			return e
+		}
+	}
+	t.Fatalf("no %s eval", kind)
+	return EvalItem{}
+}
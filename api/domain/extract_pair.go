@@ -828,21 +828,26 @@ func calcPairElementComplement(a, b *ElDistribution) float64 {
 	return clamp(0, 100, (1.0-diff/1.6)*100.0)
 }
 
+// calcUsefulGodSupport: 상대 원국에 내 용신·희신이 많고 기신·구신이 적을수록 높다 (양방향 평균).
+// 용신 평가가 없는 문서는 비겁+인성 비율로 대신한다.
 func calcUsefulGodSupport(aDoc, bDoc *SajuDoc) float64 {
 	if aDoc == nil || bDoc == nil || aDoc.ElBalance == nil || bDoc.ElBalance == nil {
 		return 50
 	}
-	aVals := []float64{aDoc.ElBalance.Wood, aDoc.ElBalance.Fire, aDoc.ElBalance.Earth, aDoc.ElBalance.Metal, aDoc.ElBalance.Water}
-	bVals := []float64{bDoc.ElBalance.Wood, bDoc.ElBalance.Fire, bDoc.ElBalance.Earth, bDoc.ElBalance.Metal, bDoc.ElBalance.Water}
-
-	aDm := fiveElementIdx(stemElement(aDoc.DayMaster))
-	bDm := fiveElementIdx(stemElement(bDoc.DayMaster))
-	aRes := mod5(aDm + 4)
-	bRes := mod5(bDm + 4)
+	aVals := elRatios(aDoc.ElBalance)
+	bVals := elRatios(bDoc.ElBalance)
+	return clamp(0, 100, (usefulGodSupportFrom(aDoc, bVals)+usefulGodSupportFrom(bDoc, aVals))/2.0*100.0)
+}
 
-	supportA := bVals[aDm] + bVals[aRes]
-	supportB := aVals[bDm] + aVals[bRes]
-	return clamp(0, 100, ((supportA+supportB)/2.0)*100.0)
+// usefulGodSupportFrom: doc 의 용신 기준으로 본 상대 오행 비율 other 의 지원도 (0~1).
+func usefulGodSupportFrom(doc *SajuDoc, other [5]float64) float64 {
+	gods, ok := UsefulGodsOf(doc)
+	if !ok {
+		dm := fiveElementIdx(stemElement(doc.DayMaster))
+		return other[dm] + other[mod5(dm+4)]
+	}
+	at := func(el FiveEl) float64 { return other[fiveElementIdx(el)] }
+	return clamp(0, 1, 0.5+(at(gods.Yong)+0.6*at(gods.Hee)-at(gods.Gi)-0.6*at(gods.Gu))*1.25)
 }
 
 func calcRoleFit(aDoc, bDoc *SajuDoc) float64 {
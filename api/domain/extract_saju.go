@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"time"
+
+	ttycdom "sajudating_api/api/domain/ttyc"
 )
 
 type StemId uint8     // 0..9
@@ -84,6 +87,7 @@ type BirthInput struct {
 	Engine     Engine        `json:"engine"`               // 룰셋 메타
 	SolarDt    string        `json:"solarDt,omitempty"`    // 음력 입력 시 양력 변환 결과
 	AdjustedDt string        `json:"adjustedDt,omitempty"` // 진태양시 보정 후 적용 시간
+	SolarTime  *SolarTime    `json:"solarTime,omitempty"`  // 진태양시 보정 내역 (계산 결과, loc 입력 시)
 	// 운세 계산 기준/범위 입력 (옵션): 기본은 dtLocal 기준 포인트만 계산
 	FortuneBaseDt string `json:"fortuneBaseDt,omitempty"` // 운세 기준일시(없으면 dtLocal)
 	SeunFromYear  *int   `json:"seunFromYear,omitempty"`  // 세운 범위 시작 연도(옵션)
@@ -91,6 +95,8 @@ type BirthInput struct {
 	WolunYear     *int   `json:"wolunYear,omitempty"`     // 월운 목록 대상 연도(옵션)
 	IlunYear      *int   `json:"ilunYear,omitempty"`      // 일운 목록 대상 연도(옵션)
 	IlunMonth     *int   `json:"ilunMonth,omitempty"`     // 일운 목록 대상 월(옵션, 1..12)
+	// 대운수 산정 방식 (옵션): EXACT(기본, 3일=1년·1일=4개월) | ROUNDED(일수/3 반올림)
+	DaeunConvention string `json:"daeunConvention,omitempty"`
 }
 
 type Geo struct {
@@ -98,6 +104,16 @@ type Geo struct {
 	Lon float64 `json:"lon"`
 }
 
+// SolarTime: 표준시 → 지방태양시 보정 내역. 일주·시주는 SolarDt 기준으로 산출된다.
+type SolarTime struct {
+	Mode              string  `json:"mode"`              // MEAN | APPARENT
+	TzOffsetMinutes   int     `json:"tzOffsetMinutes"`   // 출생 시점 실제 표준시 오프셋 (서머타임·UTC+8:30 이력 반영)
+	LongitudeMinutes  float64 `json:"longitudeMinutes"`  // 경도 보정 (분)
+	EquationOfTime    float64 `json:"equationOfTime"`    // 균시차 (분, APPARENT)
+	CorrectionMinutes int     `json:"correctionMinutes"` // 적용 보정량 (분)
+	SolarDt           string  `json:"solarDt"`           // 보정된 지방태양시
+}
+
 type Engine struct {
 	Name   string         `json:"name"`             // 엔진명
 	Ver    string         `json:"ver"`              // 엔진버전
@@ -105,6 +121,52 @@ type Engine struct {
 	Params map[string]any `json:"params,omitempty"` // 파라미터
 }
 
+// EngineParamZishiConvention: Engine.Params 키 - 자시 일주 경계 유파 (DAY_CHANGE_00 | YAJASI | DAY_CHANGE_23, 기본 DAY_CHANGE_00)
+const EngineParamZishiConvention = "zishiConvention"
+
+// EngineParamSolarTimeMode: Engine.Params 키 - 진태양시 보정 (NONE | MEAN | APPARENT, loc 있으면 기본 APPARENT)
+const EngineParamSolarTimeMode = "solarTimeMode"
+
+// ZishiConventionOf reads the 자시 convention from Engine.Params.
+func ZishiConventionOf(engine Engine) (ttycdom.TtycZishiConvention, error) {
+	raw, ok := engine.Params[EngineParamZishiConvention]
+	if !ok || raw == nil {
+		return ttycdom.NormalizeZishiConvention("")
+	}
+	v, isStr := raw.(string)
+	if !isStr {
+		return "", fmt.Errorf("invalid zishi convention: %v", raw)
+	}
+	return ttycdom.NormalizeZishiConvention(ttycdom.TtycZishiConvention(strings.ToUpper(strings.TrimSpace(v))))
+}
+
+// SolarTimeModeOf resolves the solar time mode for input: loc 가 있으면 기본 APPARENT, 없으면 NONE.
+// 보정일시(adjustedDt)가 주어지면 이미 보정된 시간으로 보고 NONE.
+func SolarTimeModeOf(input BirthInput) (ttycdom.TtycSolarTimeMode, error) {
+	mode := ttycdom.TtycSolarTimeMode("")
+	if raw, ok := input.Engine.Params[EngineParamSolarTimeMode]; ok && raw != nil {
+		v, isStr := raw.(string)
+		if !isStr {
+			return "", fmt.Errorf("invalid solar time mode: %v", raw)
+		}
+		mode = ttycdom.TtycSolarTimeMode(strings.ToUpper(strings.TrimSpace(v)))
+	}
+	if mode == "" && input.Loc != nil {
+		mode = ttycdom.TtycSolarTimeModeApparent
+	}
+	mode, err := ttycdom.NormalizeSolarTimeMode(mode)
+	if err != nil {
+		return "", err
+	}
+	if input.AdjustedDt != "" {
+		return ttycdom.TtycSolarTimeModeNone, nil
+	}
+	if mode != ttycdom.TtycSolarTimeModeNone && input.Loc == nil {
+		return "", fmt.Errorf("solar time mode %s requires loc", mode)
+	}
+	return mode, nil
+}
+
 // ── 사주 구조 ──
 
 type Pillar struct {
@@ -125,7 +187,7 @@ type Node struct {
 	Branch   *BranchId   `json:"branch,omitempty"`   // kind=BRANCH
 	El       FiveEl      `json:"el"`                 // 오행
 	Yy       YinYang     `json:"yy"`                 // 음양
-	TenGod   *TenGod     `json:"tenGod,omitempty"`   // 일간 기준 십성
+	TenGod   *TenGod     `json:"tenGod,omitempty"`   // 일간 기준 십성 (BRANCH 는 지지 오행·음양 기준)
 	Twelve   *TwelveFate `json:"twelve,omitempty"`   // 일간 기준 십이운성 (BRANCH만)
 	Strength *float64    `json:"strength,omitempty"` // 오행 세기/역량
 }
@@ -221,6 +283,12 @@ type DaeunPeriod struct {
 	Year         int         `json:"year,omitempty"`         // 기준 연도(세운/월운/일운)
 	Month        int         `json:"month,omitempty"`        // 기준 월(월운/일운)
 	Day          int         `json:"day,omitempty"`          // 기준 일(일운)
+	// 대운 전용: 절입까지 거리로 산정한 시작 나이(만)와 정확한 시작 시점
+	StartAgeYears  int    `json:"startAgeYears"`        // 시작 나이(년, 0 도 유효)
+	StartAgeMonths int    `json:"startAgeMonths"`       // 시작 나이(개월, 0..11)
+	StartDt        string `json:"startDt,omitempty"`    // 시작 일시(현지, YYYY-MM-DDTHH:mm)
+	StartTs        int64  `json:"startTs,omitempty"`    // 시작 시각(UTC ms)
+	Convention     string `json:"convention,omitempty"` // 대운수 산정 방식(EXACT|ROUNDED)
 }
 
 // ── 오행 분포 ──
@@ -419,7 +487,23 @@ func BuildSajuDocAt(input BirthInput, raw RawPillars, now time.Time) (*SajuDoc,
 	if err := validateRawPillars(raw); err != nil {
 		return nil, err
 	}
+	zishi, err := ZishiConventionOf(input.Engine)
+	if err != nil {
+		return nil, err
+	}
+	solarMode, err := SolarTimeModeOf(input)
+	if err != nil {
+		return nil, err
+	}
 	in := normalizeBirthInput(input, raw)
+	in.Engine.Params = withEngineParam(in.Engine.Params, EngineParamZishiConvention, string(zishi))
+	in.Engine.Params = withEngineParam(in.Engine.Params, EngineParamSolarTimeMode, string(solarMode))
+	in.SolarTime = nil
+	if solarMode != ttycdom.TtycSolarTimeModeNone && in.TimePrec != TimePrecisionUnknown {
+		if calc, _, ok := birthTtycCalc(in); ok && calc.SolarTime != nil && calc.SolarLocal != nil {
+			in.SolarTime = toSolarTime(*calc.SolarTime, *calc.SolarLocal)
+		}
+	}
 
 	pillarRawMap := make(map[PillarKey]RawPillar, 4)
 	pillars := make([]Pillar, 0, 4)
@@ -467,6 +551,7 @@ func BuildSajuDocAt(input BirthInput, raw RawPillars, now time.Time) (*SajuDoc,
 		branchYy := branchYinYang(rawP.Branch)
 		branchStrength := base * 1.20
 		branchTwelve := twelveFateByBranch(dayMaster, rawP.Branch)
+		branchTenGod := tenGodByElementAndYinYang(dayMaster, branchEl, branchYy)
 		branchNode := Node{
 			ID:       nextNodeID,
 			Kind:     "BRANCH",
@@ -474,6 +559,7 @@ func BuildSajuDocAt(input BirthInput, raw RawPillars, now time.Time) (*SajuDoc,
 			Branch:   ptrBranch(rawP.Branch),
 			El:       branchEl,
 			Yy:       branchYy,
+			TenGod:   &branchTenGod,
 			Twelve:   &branchTwelve,
 			Strength: &branchStrength,
 		}
@@ -658,6 +744,7 @@ func BuildSajuDocAt(input BirthInput, raw RawPillars, now time.Time) (*SajuDoc,
 			Notes:   "입력 정밀도와 시주 계산 가능 여부",
 		},
 	})
+	facts = append(facts, buildSinsalFacts(SinsalSysOf(in.Engine), pillarRawMap, refsByPillar, orderedKeys)...)
 
 	balanceScoreValue := calcBalanceScore(elBalance)
 	daySupportScoreValue := calcDayMasterSupportScore(dayMaster, elBalance)
@@ -719,10 +806,17 @@ func BuildSajuDocAt(input BirthInput, raw RawPillars, now time.Time) (*SajuDoc,
 			}),
 		},
 	}
+	strengthEval := buildStrengthEval(in.Engine.Sys, pillarRawMap, refsByPillar, orderedKeys, edges, baseConfidence)
+	evals = append(evals, strengthEval)
+	evals = append(evals, buildGyeokgukEval(in.Engine.Sys, pillarRawMap, refsByPillar, elBalance, baseConfidence))
+	evals = append(evals, buildYongsinEvals(in.Engine.Sys, dayMaster, raw.Month.Branch, nodes, elBalance, strengthEval.Score.Total, baseConfidence)...)
 
 	hourCtx := buildHourContext(in, raw, nodes, edges, facts, evals)
 	emptyBranches := gongMangBranches(raw.Day.Stem, raw.Day.Branch)
-	daeunList := buildDaeunList(in, raw, dayMaster)
+	daeunList, err := buildDaeunList(in, raw, dayMaster)
+	if err != nil {
+		return nil, err
+	}
 	daeun, seun, wolun, ilun := buildRunFortunes(in, raw, dayMaster, daeunList, now)
 	createdAt := now.UTC().Format(time.RFC3339)
 
@@ -751,6 +845,16 @@ func BuildSajuDocAt(input BirthInput, raw RawPillars, now time.Time) (*SajuDoc,
 	return doc, nil
 }
 
+// withEngineParam returns a copy of params with key set (입력 map 은 변경하지 않는다).
+func withEngineParam(params map[string]any, key string, value any) map[string]any {
+	out := make(map[string]any, len(params)+1)
+	for k, v := range params {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
+
 func normalizeBirthInput(input BirthInput, raw RawPillars) BirthInput {
 	out := input
 	if out.Tz == "" {
@@ -888,8 +992,72 @@ func buildHourContext(input BirthInput, raw RawPillars, nodes []Node, edges []Ed
 	return ctx
 }
 
-func buildDaeunList(input BirthInput, raw RawPillars, dayMaster StemId) []DaeunPeriod {
-	year := parseYear(input.DtLocal)
+// solarBirthDt 는 양력 기준 출생일시를 돌려준다. 음력 입력이면 변환 결과(SolarDt)를 쓴다.
+func solarBirthDt(input BirthInput) string {
+	if input.SolarDt != "" {
+		return input.SolarDt
+	}
+	return input.DtLocal
+}
+
+// birthTtycCalc 은 입력 일시(보정일시 우선)를 tz 기준 ttyc 명식으로 계산한다. 시간 미상은 정오 기준.
+func birthTtycCalc(input BirthInput) (ttycdom.TtycPillarCalcResult, *time.Location, bool) {
+	dt := solarBirthDt(input)
+	if input.AdjustedDt != "" {
+		dt = input.AdjustedDt
+	}
+	local, ok := parseLocalDateTime(dt)
+	if !ok {
+		return ttycdom.TtycPillarCalcResult{}, nil, false
+	}
+	if _, hasHour := parseHour(dt); !hasHour {
+		local = local.Add(12 * time.Hour)
+	}
+	loc, err := time.LoadLocation(input.Tz)
+	if err != nil {
+		return ttycdom.TtycPillarCalcResult{}, nil, false
+	}
+	at := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), 0, 0, loc)
+	_, offsetSec := at.Zone()
+	offsetMinutes := offsetSec / 60
+	zishi, err := ZishiConventionOf(input.Engine)
+	if err != nil {
+		return ttycdom.TtycPillarCalcResult{}, nil, false
+	}
+	solarMode, err := SolarTimeModeOf(input)
+	if err != nil {
+		return ttycdom.TtycPillarCalcResult{}, nil, false
+	}
+	var longitude *float64
+	if input.Loc != nil {
+		longitude = &input.Loc.Lon
+	}
+	calc, err := ttycdom.CalculatePillars(ttycdom.TtycPillarCalcInput{
+		Ts:              at.UnixMilli(),
+		TzOffsetMinutes: &offsetMinutes,
+		ZishiConvention: zishi,
+		SolarTimeMode:   solarMode,
+		Longitude:       longitude,
+	})
+	if err != nil {
+		return ttycdom.TtycPillarCalcResult{}, nil, false
+	}
+	return calc, loc, true
+}
+
+func toSolarTime(corr ttycdom.TtycSolarTimeCorrection, local ttycdom.TtycLocalDateTimeParts) *SolarTime {
+	return &SolarTime{
+		Mode:              string(corr.Mode),
+		TzOffsetMinutes:   corr.TzOffsetMinutes,
+		LongitudeMinutes:  math.Round(corr.LongitudeMinutes*100) / 100,
+		EquationOfTime:    math.Round(corr.EquationOfTimeMinutes*100) / 100,
+		CorrectionMinutes: corr.CorrectionMinutes,
+		SolarDt:           fmt.Sprintf("%04d-%02d-%02dT%02d:%02d", local.Year, local.Month, local.Day, local.Hour, local.Minute),
+	}
+}
+
+func buildDaeunList(input BirthInput, raw RawPillars, dayMaster StemId) ([]DaeunPeriod, error) {
+	year := parseYear(solarBirthDt(input))
 	male, sexKnown := parseMale(input.Sex)
 	yangYear := int(raw.Year.Stem)%2 == 0
 	forward := yangYear
@@ -897,6 +1065,27 @@ func buildDaeunList(input BirthInput, raw RawPillars, dayMaster StemId) []DaeunP
 		forward = (yangYear && male) || (!yangYear && !male)
 	}
 
+	// 대운수: 출생~인접 절입(순행 다음 절, 역행 직전 절) 거리를 3일=1년으로 환산.
+	// 출생일시를 해석할 수 없으면 대운수 1로 둔다.
+	startAgeYears, startAgeMonths := 1, 0
+	var startAt time.Time
+	convention := ""
+	if birth, loc, ok := birthTtycCalc(input); ok {
+		sex := ttycdom.TtycSexUnknown
+		if sexKnown && male {
+			sex = ttycdom.TtycSexM
+		} else if sexKnown {
+			sex = ttycdom.TtycSexF
+		}
+		start, err := ttycdom.CalculateDaeunStart(birth, sex, ttycdom.TtycDaeunConvention(input.DaeunConvention))
+		if err != nil {
+			return nil, err
+		}
+		startAgeYears, startAgeMonths = start.Years, start.Months
+		startAt = time.UnixMilli(start.StartTs).In(loc)
+		convention = string(start.Convention)
+	}
+
 	ret := make([]DaeunPeriod, 0, 8)
 	// 기본 8개 대운(10년 단위) 생성.
 	for i := 1; i <= 8; i++ {
@@ -906,23 +1095,30 @@ func buildDaeunList(input BirthInput, raw RawPillars, dayMaster StemId) []DaeunP
 		}
 		stem := StemId(mod10(int(raw.Month.Stem) + shift))
 		branch := BranchId(mod12(int(raw.Month.Branch) + shift))
-		ageFrom := 1 + (i-1)*10
+		ageFrom := startAgeYears + (i-1)*10
 		ageTo := ageFrom + 9
-		startYear := 0
-		if year > 0 {
-			startYear = year + ageFrom
+		period := DaeunPeriod{
+			Type:           fortuneTypeDaeun,
+			Order:          i,
+			Stem:           stem,
+			Branch:         branch,
+			AgeFrom:        ageFrom,
+			AgeTo:          ageTo,
+			StartAgeYears:  ageFrom,
+			StartAgeMonths: startAgeMonths,
+			Convention:     convention,
+		}
+		if !startAt.IsZero() {
+			at := startAt.AddDate((i-1)*10, 0, 0)
+			period.StartYear = at.Year()
+			period.StartDt = at.Format("2006-01-02T15:04")
+			period.StartTs = at.UnixMilli()
+		} else if year > 0 {
+			period.StartYear = year + ageFrom
 		}
-		ret = append(ret, EnrichFortunePeriod(DaeunPeriod{
-			Type:      fortuneTypeDaeun,
-			Order:     i,
-			Stem:      stem,
-			Branch:    branch,
-			AgeFrom:   ageFrom,
-			AgeTo:     ageTo,
-			StartYear: startYear,
-		}, dayMaster))
+		ret = append(ret, EnrichFortunePeriod(period, dayMaster))
 	}
-	return ret
+	return ret, nil
 }
 
 func buildRunFortunes(input BirthInput, raw RawPillars, dayMaster StemId, daeunList []DaeunPeriod, now time.Time) (*DaeunPeriod, *DaeunPeriod, *DaeunPeriod, *DaeunPeriod) {
@@ -932,7 +1128,16 @@ func buildRunFortunes(input BirthInput, raw RawPillars, dayMaster StemId, daeunL
 		year = parseYear(fortuneBase)
 	}
 
-	daeun := pickCurrentDaeun(daeunList, year, now.Year())
+	// 대운은 운세 기준일시(없으면 현재) 시점으로 선택
+	baseAt := now
+	if input.FortuneBaseDt != "" {
+		if local, ok := parseLocalDateTime(input.FortuneBaseDt); ok {
+			if loc, err := time.LoadLocation(input.Tz); err == nil {
+				baseAt = time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), 0, 0, loc)
+			}
+		}
+	}
+	daeun := PickCurrentDaeun(daeunList, baseAt.UnixMilli())
 	if daeun == nil && len(daeunList) > 0 {
 		fallback := daeunList[0]
 		fallback.Type = fortuneTypeDaeun
@@ -967,19 +1172,16 @@ func buildRunFortunes(input BirthInput, raw RawPillars, dayMaster StemId, daeunL
 	return daeun, &seun, &wolun, &ilun
 }
 
-func pickCurrentDaeun(daeunList []DaeunPeriod, birthYear, baseYear int) *DaeunPeriod {
+// PickCurrentDaeun 은 baseTs(UTC ms) 시점에 시작된 마지막 대운을 고른다.
+// 첫 대운 시작 전이거나 시작 시각이 없는 목록이면 첫 대운.
+func PickCurrentDaeun(daeunList []DaeunPeriod, baseTs int64) *DaeunPeriod {
 	if len(daeunList) == 0 {
 		return nil
 	}
 	idx := 0
-	if birthYear > 0 && baseYear > 0 {
-		age := baseYear - birthYear + 1
-		if age < 1 {
-			age = 1
-		}
-		idx = (age - 1) / 10
-		if idx >= len(daeunList) {
-			idx = len(daeunList) - 1
+	for i, d := range daeunList {
+		if d.StartTs != 0 && d.StartTs <= baseTs {
+			idx = i
 		}
 	}
 	out := daeunList[idx]
@@ -1186,6 +1388,24 @@ func stemRelationSpec(a, b StemId) (edgeSpec, bool) {
 	return edgeSpec{}, false
 }
 
+// StemRelations returns the relation types between two stems (SajuDoc 천간 엣지와 같은 규칙: 합만).
+func StemRelations(a, b StemId) []RelType {
+	if spec, ok := stemRelationSpec(a, b); ok {
+		return []RelType{spec.Type}
+	}
+	return nil
+}
+
+// BranchRelations returns the relation types between two branches (SajuDoc 지지 엣지와 같은 규칙·순서).
+func BranchRelations(a, b BranchId) []RelType {
+	specs := branchRelationSpecs(a, b)
+	out := make([]RelType, 0, len(specs))
+	for _, spec := range specs {
+		out = append(out, spec.Type)
+	}
+	return out
+}
+
 func branchRelationSpecs(a, b BranchId) []edgeSpec {
 	if int(a) > int(b) {
 		a, b = b, a
@@ -1481,7 +1701,7 @@ func resolveFortuneBaseDt(input BirthInput) string {
 	if input.FortuneBaseDt != "" {
 		return input.FortuneBaseDt
 	}
-	return input.DtLocal
+	return solarBirthDt(input)
 }
 
 func parseLocalDate(dt string) (int, int, int, bool) {
@@ -1502,6 +1722,24 @@ func parseLocalDate(dt string) (int, int, int, bool) {
 	return 0, 0, 0, false
 }
 
+func parseLocalDateTime(dt string) (time.Time, bool) {
+	layouts := []string{
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04",
+		"2006-01-02 15:04",
+		"2006-01-02T15",
+		"2006-01-02 15",
+		"2006-01-02",
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, dt); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
 func parseHour(dt string) (int, bool) {
 	layouts := []string{
 		"2006-01-02T15:04:05",
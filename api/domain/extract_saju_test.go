@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 )
@@ -50,8 +52,12 @@ func TestBuildSajuDocAt_KnownHour(t *testing.T) {
 	if doc.Daeun == nil || doc.Seun == nil || doc.Wolun == nil || doc.Ilun == nil {
 		t.Fatalf("expected all run fortunes, got daeun=%+v seun=%+v wolun=%+v ilun=%+v", doc.Daeun, doc.Seun, doc.Wolun, doc.Ilun)
 	}
-	if doc.Daeun.Type != fortuneTypeDaeun || doc.Daeun.Order != 4 || doc.Daeun.Stem != 1 || doc.Daeun.Branch != 9 {
-		t.Fatalf("daeun = %+v, want type=%s order=4 stem=1 branch=9", doc.Daeun, fortuneTypeDaeun)
+	// 순행(庚년 남): 망종(1990-06-06 07:46)까지 21일 21시간 → 대운수 7년 3개월, 2026-02 은 3대운(2017-09~)
+	if doc.Daeun.Type != fortuneTypeDaeun || doc.Daeun.Order != 3 || doc.Daeun.Stem != 0 || doc.Daeun.Branch != 8 {
+		t.Fatalf("daeun = %+v, want type=%s order=3 stem=0 branch=8", doc.Daeun, fortuneTypeDaeun)
+	}
+	if doc.Daeun.StartAgeYears != 27 || doc.Daeun.StartAgeMonths != 3 || doc.Daeun.StartDt != "2017-09-01T07:07" || doc.Daeun.Convention != "EXACT" {
+		t.Fatalf("daeun start = %+v, want 27y3m at 2017-09-01T07:07 (EXACT)", doc.Daeun)
 	}
 	if doc.Daeun.GanjiKo == "" || doc.Daeun.StemTenGod == nil || doc.Daeun.BranchTenGod == nil || doc.Daeun.BranchTwelve == nil {
 		t.Fatalf("daeun metadata is not filled: %+v", doc.Daeun)
@@ -73,6 +79,66 @@ func TestBuildSajuDocAt_KnownHour(t *testing.T) {
 	}
 }
 
+func TestBuildSajuDocAt_DaeunStart(t *testing.T) {
+	raw := RawPillars{
+		Year:  RawPillar{Stem: 6, Branch: 6},
+		Month: RawPillar{Stem: 7, Branch: 5},
+		Day:   RawPillar{Stem: 4, Branch: 10},
+		Hour:  &RawPillar{Stem: 9, Branch: 3},
+	}
+	now := time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		sex        string
+		convention string
+		baseDt     string
+		wantFirst  string
+		wantYears  int
+		wantMonths int
+		wantOrder  int
+	}{
+		{name: "남 순행 EXACT", sex: "M", wantFirst: "1997-09-01T07:07", wantYears: 7, wantMonths: 3, wantOrder: 3},
+		{name: "남 순행 ROUNDED", sex: "M", convention: "ROUNDED", wantFirst: "1997-05-15T10:24", wantYears: 7, wantOrder: 3},
+		// 역행: 입하(1990-05-06 03:35)부터 9일 6시간 48분 → 3년 1개월 + 4일여
+		{name: "여 역행 EXACT", sex: "F", wantFirst: "1993-06-19T11:23", wantYears: 3, wantMonths: 1, wantOrder: 4},
+		{name: "기준일시 1대운 직전", sex: "M", baseDt: "1997-09-01 07:06", wantFirst: "1997-09-01T07:07", wantYears: 7, wantMonths: 3, wantOrder: 1},
+		{name: "기준일시 2대운 시작", sex: "M", baseDt: "2007-09-01 07:08", wantFirst: "1997-09-01T07:07", wantYears: 7, wantMonths: 3, wantOrder: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := BirthInput{
+				DtLocal:         "1990-05-15 10:24",
+				Tz:              "Asia/Seoul",
+				TimePrec:        TimePrecisionMinute,
+				Sex:             tt.sex,
+				DaeunConvention: tt.convention,
+				FortuneBaseDt:   tt.baseDt,
+			}
+			doc, err := BuildSajuDocAt(input, raw, now)
+			if err != nil {
+				t.Fatalf("BuildSajuDocAt() error = %v", err)
+			}
+			first := doc.DaeunList[0]
+			if first.StartDt != tt.wantFirst || first.StartAgeYears != tt.wantYears || first.StartAgeMonths != tt.wantMonths {
+				t.Fatalf("first daeun = %s %dy%dm, want %s %dy%dm",
+					first.StartDt, first.StartAgeYears, first.StartAgeMonths, tt.wantFirst, tt.wantYears, tt.wantMonths)
+			}
+			if doc.DaeunList[1].AgeFrom != tt.wantYears+10 {
+				t.Fatalf("second daeun ageFrom = %d, want %d", doc.DaeunList[1].AgeFrom, tt.wantYears+10)
+			}
+			if doc.Daeun == nil || doc.Daeun.Order != tt.wantOrder {
+				t.Fatalf("current daeun = %+v, want order %d", doc.Daeun, tt.wantOrder)
+			}
+		})
+	}
+
+	_, err := BuildSajuDocAt(BirthInput{DtLocal: "1990-05-15 10:24", Tz: "Asia/Seoul", Sex: "M", DaeunConvention: "YEARLY"}, raw, now)
+	if err == nil {
+		t.Fatal("expected error for invalid daeunConvention")
+	}
+}
+
 func TestBuildSajuDocAt_MissingHourCandidates(t *testing.T) {
 	input := BirthInput{
 		DtLocal:  "1990-05-15",
@@ -633,3 +699,91 @@ func TestNewScore_NormClamping(t *testing.T) {
 		t.Errorf("norm = %d, want 0 (clamped)", s2.Norm0_100)
 	}
 }
+
+func TestBuildSajuDocAt_ZishiConventionRecorded(t *testing.T) {
+	raw := RawPillars{
+		Year:  RawPillar{Stem: 6, Branch: 6},
+		Month: RawPillar{Stem: 7, Branch: 5},
+		Day:   RawPillar{Stem: 4, Branch: 10},
+		Hour:  &RawPillar{Stem: 8, Branch: 0},
+	}
+	now := time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name   string
+		params map[string]any
+		want   string
+	}{
+		{name: "기본값", params: nil, want: "DAY_CHANGE_00"},
+		{name: "23시 일변", params: map[string]any{EngineParamZishiConvention: "day_change_23", "x": 1}, want: "DAY_CHANGE_23"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			input := BirthInput{
+				DtLocal:  "1990-05-14 23:30",
+				Tz:       "Asia/Seoul",
+				TimePrec: TimePrecisionMinute,
+				Sex:      "M",
+				Engine:   Engine{Name: "sxtwl", Ver: "1", Params: tt.params},
+			}
+			doc, err := BuildSajuDocAt(input, raw, now)
+			if err != nil {
+				t.Fatalf("BuildSajuDocAt() error = %v", err)
+			}
+			if got := doc.Input.Engine.Params[EngineParamZishiConvention]; got != tt.want {
+				t.Fatalf("engine zishi = %v, want %s", got, tt.want)
+			}
+			if tt.params != nil && tt.params[EngineParamZishiConvention] != "day_change_23" {
+				t.Fatalf("input params mutated: %+v", tt.params)
+			}
+		})
+	}
+
+	input := BirthInput{DtLocal: "1990-05-14 23:30", Tz: "Asia/Seoul", Engine: Engine{Params: map[string]any{EngineParamZishiConvention: "NOON"}}}
+	if _, err := BuildSajuDocAt(input, raw, now); err == nil {
+		t.Fatalf("expected error for invalid zishi convention")
+	}
+}
+
+func TestSolarTimeModeOf(t *testing.T) {
+	seoul := &Geo{Lat: 37.57, Lon: 126.98}
+	tests := []struct {
+		name    string
+		input   BirthInput
+		want    string
+		wantErr bool
+	}{
+		{name: "loc 없음", input: BirthInput{}, want: "NONE"},
+		{name: "loc 있으면 APPARENT", input: BirthInput{Loc: seoul}, want: "APPARENT"},
+		{name: "명시 MEAN", input: BirthInput{Loc: seoul, Engine: Engine{Params: map[string]any{EngineParamSolarTimeMode: "mean"}}}, want: "MEAN"},
+		{name: "명시 NONE", input: BirthInput{Loc: seoul, Engine: Engine{Params: map[string]any{EngineParamSolarTimeMode: "NONE"}}}, want: "NONE"},
+		{name: "보정일시 입력은 보정 생략", input: BirthInput{Loc: seoul, AdjustedDt: "1990-05-15 10:00"}, want: "NONE"},
+		{name: "loc 없이 APPARENT", input: BirthInput{Engine: Engine{Params: map[string]any{EngineParamSolarTimeMode: "APPARENT"}}}, wantErr: true},
+		{name: "알 수 없는 값", input: BirthInput{Loc: seoul, Engine: Engine{Params: map[string]any{EngineParamSolarTimeMode: "TRUE"}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SolarTimeModeOf(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil || string(got) != tt.want {
+				t.Fatalf("SolarTimeModeOf() = %q, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// 대운 시작 나이 0년 0개월도 JSON 에 남아야 한다 (GraphQL Int!)
+func TestDaeunPeriod_ZeroStartAgeSerialized(t *testing.T) {
+	b, err := json.Marshal(DaeunPeriod{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{`"startAgeYears":0`, `"startAgeMonths":0`} {
+		if !strings.Contains(string(b), key) {
+			t.Errorf("DaeunPeriod JSON %s missing %s", b, key)
+		}
+	}
+}
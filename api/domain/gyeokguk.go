@@ -0,0 +1,276 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// 격국(格局) 판정: 월지 지장간의 투출로 정격(正格)을 잡고, 조건을 채우면 외격(外格: 화기격·종격)이 우선한다.
+// 후보는 모두 점수와 판정 경로(path)를 갖고, 최고점 후보가 결론이 된다 (SajuDoc.Evals 의 GYEOKGUK).
+
+const EvalGyeokguk EvalKind = "GYEOKGUK"
+
+const (
+	GyeokgukTypeNormal  = "NORMAL"  // 정격
+	GyeokgukTypeSpecial = "SPECIAL" // 외격
+)
+
+// 후보 점수 (0~100)
+const (
+	gyeokScoreMainExposed  = 90.0 // 월지 본기 투출
+	gyeokScoreSubExposed   = 75.0 // 본기 외 지장간 투출 (idx 가 뒤일수록 -10)
+	gyeokScoreMainHidden   = 70.0 // 투출 없이 본기
+	gyeokScoreRokIn        = 80.0 // 건록격·양인격 (월지 본기가 비겁)
+	gyeokScoreHwagi        = 92.0 // 화기격
+	gyeokScoreFollowBase   = 84.0 // 종격 하한 (세력 차이만큼 가산, 최대 90)
+	gyeokFollowWeakRatio   = 0.16 // 종아·종재·종살: 비겁+인성 비율 상한
+	gyeokFollowStrongRatio = 0.78 // 종왕·종강: 비겁+인성 비율 하한
+	gyeokHwagiMinRatio     = 0.40 // 화기격: 화신(化神) 오행 비율 하한
+	gyeokHwagiMaxSelf      = 0.16 // 화기격: 일간 본래 오행 비율 상한 (뿌리가 남으면 화하지 않는다)
+)
+
+// gyeokCandidate: 격국 후보 한 건
+type gyeokCandidate struct {
+	Code  string
+	Name  string
+	Type  string
+	Score float64
+	Path  []string
+	Refs  []NodeId
+}
+
+// gyeokNormalCode: 월지 십성 → 정격 코드·이름 (비겁은 건록·양인에서 따로 처리)
+var gyeokNormalCode = map[TenGod][2]string{
+	SikShin:   {"SIKSHIN", "식신격"},
+	SangGwan:  {"SANGGWAN", "상관격"},
+	PyeonJae:  {"PYEONJAE", "편재격"},
+	JeongJae:  {"JEONGJAE", "정재격"},
+	PyeonGwan: {"PYEONGWAN", "편관격"},
+	JeongGwan: {"JEONGGWAN", "정관격"},
+	PyeonIn:   {"PYEONIN", "편인격"},
+	JeongIn:   {"JEONGIN", "정인격"},
+}
+
+// buildGyeokgukEval evaluates 격국 candidates and returns the chosen one as an EvalItem.
+func buildGyeokgukEval(sys string, raw map[PillarKey]RawPillar, refs map[PillarKey]pillarNodeRef, dist *ElDistribution, confidence float64) EvalItem {
+	cands := gyeokNormalCandidates(raw, refs)
+	cands = append(cands, gyeokHwagiCandidates(raw, refs, dist)...)
+	cands = append(cands, gyeokFollowCandidates(raw, refs, dist)...)
+	// 점수 내림차순, 같으면 외격 우선 (외격은 조건을 채웠을 때만 후보가 된다)
+	sort.SliceStable(cands, func(i, j int) bool {
+		if cands[i].Score != cands[j].Score {
+			return cands[i].Score > cands[j].Score
+		}
+		return cands[i].Type == GyeokgukTypeSpecial && cands[j].Type != GyeokgukTypeSpecial
+	})
+	chosen := cands[0]
+
+	// 차점 후보와 가까우면 확신도를 낮춘다
+	if len(cands) > 1 && chosen.Score-cands[1].Score < 10 {
+		confidence -= 0.10
+	}
+
+	candidates := make([]map[string]any, 0, len(cands))
+	parts := make([]ScorePart, 0, len(cands))
+	for _, c := range cands {
+		candidates = append(candidates, map[string]any{
+			"code":  c.Code,
+			"name":  c.Name,
+			"type":  c.Type,
+			"score": c.Score,
+			"path":  c.Path,
+		})
+		parts = append(parts, ScorePart{Label: c.Code, W: 1.0, Raw: c.Score, Refs: c.Refs, Note: c.Name})
+	}
+	exposed := make([]int, 0, 3)
+	for _, k := range gyeokExposedKeys(raw) {
+		exposed = append(exposed, int(raw[k].Stem))
+	}
+
+	return EvalItem{
+		ID:   "eval.gyeokguk",
+		K:    EvalGyeokguk,
+		N:    chosen.Name,
+		V:    map[string]any{"code": chosen.Code, "name": chosen.Name, "type": chosen.Type, "candidates": candidates},
+		Refs: chosen.Refs,
+		Evidence: Evidence{
+			RuleId:  "rule.eval.gyeokguk",
+			RuleVer: "v1",
+			Sys:     sys,
+			Inputs: EvidenceInputs{
+				Nodes:  chosen.Refs,
+				Params: map[string]any{"path": chosen.Path, "monthBranch": int(raw["M"].Branch), "exposedStems": exposed},
+			},
+			Notes: "월지 본기·투출 정격, 건록·양인, 화기격·종격 외격",
+		},
+		Score: newScore(chosen.Score, 0, 100, confidence, parts),
+	}
+}
+
+// gyeokExposedKeys: 투출을 보는 천간 자리 (일간 제외 년·월·시)
+func gyeokExposedKeys(raw map[PillarKey]RawPillar) []PillarKey {
+	keys := []PillarKey{"Y", "M"}
+	if _, ok := raw["H"]; ok {
+		keys = append(keys, "H")
+	}
+	return keys
+}
+
+// gyeokNormalCandidates: 월지 지장간 중 투출한 것(본기 우선)과 본기로 정격 후보를 만든다.
+func gyeokNormalCandidates(raw map[PillarKey]RawPillar, refs map[PillarKey]pillarNodeRef) []gyeokCandidate {
+	dm := raw["D"].Stem
+	month := refs["M"]
+	hidden := hiddenStems(raw["M"].Branch)
+	var out []gyeokCandidate
+	for idx, h := range hidden {
+		var exposedRefs []NodeId
+		var exposedAt []string
+		for _, k := range gyeokExposedKeys(raw) {
+			if raw[k].Stem == h {
+				exposedRefs = append(exposedRefs, refs[k].Stem)
+				exposedAt = append(exposedAt, string(k))
+			}
+		}
+		nodeRefs := append([]NodeId{month.Branch, month.Hidden[idx]}, exposedRefs...)
+		tg := tenGodByStem(dm, h)
+
+		if tg == BiGyeon || tg == GeobJae {
+			// 월지 본기가 비겁이면 건록·양인(음간은 월겁)격, 여기·중기 비겁은 격을 이루지 않는다
+			if idx != 0 {
+				continue
+			}
+			code, name := "GEONROK", "건록격"
+			if tg == GeobJae {
+				code, name = "WOLGEOB", "월겁격"
+				if stemYinYang(dm) == "YANG" {
+					code, name = "YANGIN", "양인격"
+				}
+			}
+			out = append(out, gyeokCandidate{
+				Code: code, Name: name, Type: GyeokgukTypeNormal, Score: gyeokScoreRokIn,
+				Path: []string{"정격", "월지본기:" + string(tg), "비겁월령"},
+				Refs: nodeRefs,
+			})
+			continue
+		}
+
+		cn := gyeokNormalCode[tg]
+		switch {
+		case len(exposedRefs) > 0:
+			score := gyeokScoreMainExposed
+			step := "본기투출"
+			if idx > 0 {
+				score = gyeokScoreSubExposed - float64(idx-1)*10
+				step = fmt.Sprintf("지장간%d투출", idx)
+			}
+			path := []string{"정격", fmt.Sprintf("월지지장간%d:%s", idx, tg), step}
+			for _, at := range exposedAt {
+				path = append(path, "투출:"+at)
+			}
+			out = append(out, gyeokCandidate{Code: cn[0], Name: cn[1], Type: GyeokgukTypeNormal, Score: score, Path: path, Refs: nodeRefs})
+		case idx == 0:
+			out = append(out, gyeokCandidate{
+				Code: cn[0], Name: cn[1], Type: GyeokgukTypeNormal, Score: gyeokScoreMainHidden,
+				Path: []string{"정격", "월지본기:" + string(tg), "투출없음"},
+				Refs: nodeRefs,
+			})
+		}
+	}
+	return out
+}
+
+// gyeokHwagiCandidates: 일간이 월간·시간과 천간합하고 화신(化神)이 월령을 얻어 세력이 충분하며
+// 일간 본래 오행이 약하면 화기격.
+func gyeokHwagiCandidates(raw map[PillarKey]RawPillar, refs map[PillarKey]pillarNodeRef, dist *ElDistribution) []gyeokCandidate {
+	dm := raw["D"].Stem
+	monthEl := branchElement(raw["M"].Branch)
+	var out []gyeokCandidate
+	for _, k := range []PillarKey{"M", "H"} {
+		p, ok := raw[k]
+		if !ok {
+			continue
+		}
+		spec, ok := stemRelationSpec(dm, p.Stem)
+		if !ok || spec.Result == nil || *spec.Result != monthEl {
+			continue
+		}
+		ratio := elRatio(dist, *spec.Result)
+		if ratio < gyeokHwagiMinRatio || elRatio(dist, stemElement(dm)) > gyeokHwagiMaxSelf {
+			continue
+		}
+		el := *spec.Result
+		out = append(out, gyeokCandidate{
+			Code:  "HWAGI_" + string(el),
+			Name:  "화" + el.Ko() + "격",
+			Type:  GyeokgukTypeSpecial,
+			Score: gyeokScoreHwagi,
+			Path:  []string{"외격", "화기격", "일간합:" + string(k), "화신득령:" + string(el), fmt.Sprintf("화신비율:%.2f", ratio)},
+			Refs:  []NodeId{refs["D"].Stem, refs[k].Stem, refs["M"].Branch},
+		})
+		break
+	}
+	return out
+}
+
+// gyeokFollowCandidates: 비겁+인성 비율로 종격을 본다.
+// 아주 약하면 가장 센 식상·재성·관성을 따라 종아·종재·종살, 아주 강하고 월령이 일간 편이면 종왕(비겁)·종강(인성).
+func gyeokFollowCandidates(raw map[PillarKey]RawPillar, refs map[PillarKey]pillarNodeRef, dist *ElDistribution) []gyeokCandidate {
+	if dist == nil {
+		return nil
+	}
+	dm := raw["D"].Stem
+	v := []float64{dist.Wood, dist.Fire, dist.Earth, dist.Metal, dist.Water}
+	self := fiveElementIdx(stemElement(dm))
+	resource := mod5(self + 4)
+	support := v[self] + v[resource]
+	monthIdx := fiveElementIdx(branchElement(raw["M"].Branch))
+	baseRefs := []NodeId{refs["D"].Stem, refs["M"].Branch}
+
+	switch {
+	case support <= gyeokFollowWeakRatio:
+		groups := []struct {
+			idx        int
+			code, name string
+		}{
+			{mod5(self + 1), "JONG_A", "종아격"},
+			{mod5(self + 2), "JONG_JAE", "종재격"},
+			{mod5(self + 3), "JONG_SAL", "종살격"},
+		}
+		best := groups[0]
+		for _, g := range groups[1:] {
+			if v[g.idx] > v[best.idx] {
+				best = g
+			}
+		}
+		// 따르는 세력이 월령을 얻지 못하면 종하지 않는다
+		if best.idx != monthIdx {
+			return nil
+		}
+		score := clamp(gyeokScoreFollowBase, 90, gyeokScoreFollowBase+(gyeokFollowWeakRatio-support)*100)
+		return []gyeokCandidate{{
+			Code: best.code, Name: best.name, Type: GyeokgukTypeSpecial, Score: score,
+			Path: []string{"외격", "종격", fmt.Sprintf("비겁인성:%.2f", support), "종:" + string(fiveElByIdx(best.idx))},
+			Refs: baseRefs,
+		}}
+	case support >= gyeokFollowStrongRatio && (monthIdx == self || monthIdx == resource):
+		code, name := "JONG_WANG", "종왕격"
+		if v[resource] > v[self] {
+			code, name = "JONG_GANG", "종강격"
+		}
+		score := clamp(gyeokScoreFollowBase, 90, gyeokScoreFollowBase+(support-gyeokFollowStrongRatio)*100)
+		return []gyeokCandidate{{
+			Code: code, Name: name, Type: GyeokgukTypeSpecial, Score: score,
+			Path: []string{"외격", "종격", fmt.Sprintf("비겁인성:%.2f", support), "월령:" + string(fiveElByIdx(monthIdx))},
+			Refs: baseRefs,
+		}}
+	}
+	return nil
+}
+
+func elRatio(dist *ElDistribution, el FiveEl) float64 {
+	return elRatios(dist)[fiveElementIdx(el)]
+}
+
+func fiveElByIdx(idx int) FiveEl {
+	return stemFiveEl[mod5(idx)*2]
+}
@@ -0,0 +1,94 @@
+package domain
+
+import "testing"
+
+func TestGyeokguk_Cases(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      RawPillars
+		want     string
+		wantType string
+	}{
+		{
+			// 丑 본기 己 가 월간에 투출 → 甲 기준 정재
+			name: "본기 투출 정재격",
+			raw:  sinsalTestRaw,
+			want: "정재격", wantType: GyeokgukTypeNormal,
+		},
+		{
+			// 寅 본기 甲 은 丁 의 정인, 투출 없음 (乙亥 戊寅 丁酉)
+			name: "투출 없는 본기 정인격",
+			raw:  RawPillars{Year: RawPillar{Stem: 1, Branch: 11}, Month: RawPillar{Stem: 4, Branch: 2}, Day: RawPillar{Stem: 3, Branch: 9}},
+			want: "정인격", wantType: GyeokgukTypeNormal,
+		},
+		{
+			// 辰 본기 戊 투출 없이 중기 乙 이 년간에 투출 → 丙 기준 정인 (乙丑 庚辰 丙申 壬辰)
+			name: "중기 투출 우선",
+			raw:  RawPillars{Year: RawPillar{Stem: 1, Branch: 1}, Month: RawPillar{Stem: 6, Branch: 4}, Day: RawPillar{Stem: 2, Branch: 8}, Hour: &RawPillar{Stem: 8, Branch: 4}},
+			want: "정인격", wantType: GyeokgukTypeNormal,
+		},
+		{
+			// 庚 일간 酉월 → 양인격 (甲辰 癸酉 庚午 壬午)
+			name: "양인격",
+			raw:  RawPillars{Year: RawPillar{Stem: 0, Branch: 4}, Month: RawPillar{Stem: 9, Branch: 9}, Day: RawPillar{Stem: 6, Branch: 6}, Hour: &RawPillar{Stem: 8, Branch: 6}},
+			want: "양인격", wantType: GyeokgukTypeNormal,
+		},
+		{
+			// 甲 일간 寅월 → 건록격 (庚午 戊寅 甲午 庚午)
+			name: "건록격",
+			raw:  RawPillars{Year: RawPillar{Stem: 6, Branch: 6}, Month: RawPillar{Stem: 4, Branch: 2}, Day: RawPillar{Stem: 0, Branch: 6}, Hour: &RawPillar{Stem: 6, Branch: 6}},
+			want: "건록격", wantType: GyeokgukTypeNormal,
+		},
+		{
+			// 甲己合土, 未월 토 득령, 토 세력 (戊戌 己未 甲戌 己巳)
+			name: "화토격",
+			raw:  RawPillars{Year: RawPillar{Stem: 4, Branch: 10}, Month: RawPillar{Stem: 5, Branch: 7}, Day: RawPillar{Stem: 0, Branch: 10}, Hour: &RawPillar{Stem: 5, Branch: 5}},
+			want: "화토격", wantType: GyeokgukTypeSpecial,
+		},
+		{
+			// 丙午 네 기둥: 비겁 일색 → 종왕격
+			name: "종왕격",
+			raw:  RawPillars{Year: RawPillar{Stem: 2, Branch: 6}, Month: RawPillar{Stem: 0, Branch: 6}, Day: RawPillar{Stem: 2, Branch: 6}, Hour: &RawPillar{Stem: 0, Branch: 6}},
+			want: "종왕격", wantType: GyeokgukTypeSpecial,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := evalOf(t, tt.raw, EvalGyeokguk)
+			v := e.V.(map[string]any)
+			if e.N != tt.want || v["type"] != tt.wantType {
+				t.Fatalf("gyeokguk = %s (%v), want %s (%s); candidates %v", e.N, v["type"], tt.want, tt.wantType, v["candidates"])
+			}
+			if e.Evidence.RuleId != "rule.eval.gyeokguk" || len(e.Evidence.Inputs.Params["path"].([]string)) == 0 {
+				t.Fatalf("evidence = %+v", e.Evidence)
+			}
+			if len(e.Score.Parts) != len(v["candidates"].([]map[string]any)) || e.Score.Total != e.Score.Parts[0].Raw {
+				t.Fatalf("score parts = %+v", e.Score)
+			}
+		})
+	}
+}
+
+func TestGyeokguk_Candidates(t *testing.T) {
+	// 중기 투출: 본기(투출 없음) 후보도 남고, 결론 refs 에 투출 천간이 들어간다
+	raw := RawPillars{Year: RawPillar{Stem: 1, Branch: 1}, Month: RawPillar{Stem: 6, Branch: 4}, Day: RawPillar{Stem: 2, Branch: 8}, Hour: &RawPillar{Stem: 8, Branch: 4}}
+	e := evalOf(t, raw, EvalGyeokguk)
+	cands := e.V.(map[string]any)["candidates"].([]map[string]any)
+	if len(cands) < 2 || cands[0]["code"] != "JEONGIN" || cands[1]["code"] != "SIKSHIN" {
+		t.Fatalf("candidates = %v", cands)
+	}
+	if path := cands[0]["path"].([]string); path[len(path)-1] != "투출:Y" {
+		t.Fatalf("path = %v", path)
+	}
+	// 월지 = node 7, 중기 乙 = node 9, 년간 乙 = node 1
+	if len(e.Refs) != 3 || e.Refs[0] != 7 || e.Refs[1] != 9 || e.Refs[2] != 1 {
+		t.Fatalf("refs = %v", e.Refs)
+	}
+
+	// 시주 미상은 확신도가 낮다
+	noHour := raw
+	noHour.Hour = nil
+	if evalOf(t, noHour, EvalGyeokguk).Score.Confidence >= e.Score.Confidence {
+		t.Error("missing hour should lower confidence")
+	}
+}
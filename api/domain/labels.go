@@ -0,0 +1,42 @@
+package domain
+
+// 한글 표기: ItemNCard 토큰 등 화면/카드용 이름 (SajuDoc 값은 영문 코드 유지)
+
+var tenGodKo = map[TenGod]string{
+	BiGyeon:   "비견",
+	GeobJae:   "겁재",
+	SikShin:   "식신",
+	SangGwan:  "상관",
+	PyeonJae:  "편재",
+	JeongJae:  "정재",
+	PyeonGwan: "편관",
+	JeongGwan: "정관",
+	PyeonIn:   "편인",
+	JeongIn:   "정인",
+}
+
+var fiveElKo = map[FiveEl]string{
+	"WOOD":  "목",
+	"FIRE":  "화",
+	"EARTH": "토",
+	"METAL": "금",
+	"WATER": "수",
+}
+
+// Ko returns the Korean name of the ten god (예: "정재").
+func (g TenGod) Ko() string {
+	return tenGodKo[g]
+}
+
+// Ko returns the Korean name of the element (목·화·토·금·수).
+func (el FiveEl) Ko() string {
+	return fiveElKo[el]
+}
+
+// StemKo returns the Korean stem character (예: 0 → "갑").
+func StemKo(stem StemId) string {
+	if !isValidStem(stem) {
+		return ""
+	}
+	return stemKorChars[stem]
+}
@@ -0,0 +1,334 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 신살(神煞) 룰팩: 규칙은 기준(일간·년지·일지·간지·지지쌍·공망)과 대상 표로만 정의한다.
+// 유파별 변형은 Engine.Sys 로 고르고(SinsalSysOf), 결과는 SajuDoc.Facts 의 SINSAL 팩트로 나간다.
+
+const FactSinsal FactKind = "SINSAL"
+
+// 신살 유파 (Engine.Sys)
+const (
+	SinsalSysStandard = "KR_STANDARD" // 기본: 삼합 신살은 년지·일지 기준, 양인은 양간만, 공망은 일주 기준
+	SinsalSysClassic  = "KR_CLASSIC"  // 삼합 신살은 년지 기준만, 양인 음간 포함, 괴강 戊戌 포함, 공망 년주·일주 기준
+	SinsalSysSimple   = "SIMPLE"      // 子午卯酉 도화·寅申巳亥 역마·辰戌丑未 화개 (지지 자체), 천을귀인 庚辛→寅午
+)
+
+type sinsalBasis string
+
+const (
+	sinsalByDayStem      sinsalBasis = "DAY_STEM"      // 일간 → 대상 지지
+	sinsalByYearBranch   sinsalBasis = "YEAR_BRANCH"   // 년지 삼합국 → 대상 지지 (년지 자신 제외)
+	sinsalByDayBranch    sinsalBasis = "DAY_BRANCH"    // 일지 삼합국 → 대상 지지 (일지 자신 제외)
+	sinsalByBranch       sinsalBasis = "BRANCH"        // 지지 자체
+	sinsalByPillar       sinsalBasis = "PILLAR"        // 기둥 간지 자체
+	sinsalByDayPillar    sinsalBasis = "DAY_PILLAR"    // 일주 간지만
+	sinsalByBranchPair   sinsalBasis = "BRANCH_PAIR"   // 두 기둥 지지 쌍
+	sinsalByDayGongMang  sinsalBasis = "DAY_GONGMANG"  // 일주 공망 지지
+	sinsalByYearGongMang sinsalBasis = "YEAR_GONGMANG" // 년주 공망 지지
+)
+
+// sinsalRule: 기준별 대상 표. 삼합국은 지지 index%4 (0 申子辰, 1 巳酉丑, 2 寅午戌, 3 亥卯未).
+type sinsalRule struct {
+	Code     string
+	Name     string
+	Bases    []sinsalBasis
+	ByStem   [10][]BranchId // DAY_STEM
+	ByGroup  [4]BranchId    // YEAR_BRANCH / DAY_BRANCH
+	Branches []BranchId     // BRANCH
+	Ganji    [][2]uint8     // PILLAR / DAY_PILLAR (stem, branch)
+	Pairs    [][2]BranchId  // BRANCH_PAIR
+}
+
+var (
+	sinsalDoHwa    = sinsalRule{Code: "DOHWA", Name: "도화", Bases: []sinsalBasis{sinsalByYearBranch, sinsalByDayBranch}, ByGroup: [4]BranchId{9, 6, 3, 0}}
+	sinsalYeokMa   = sinsalRule{Code: "YEOKMA", Name: "역마", Bases: []sinsalBasis{sinsalByYearBranch, sinsalByDayBranch}, ByGroup: [4]BranchId{2, 11, 8, 5}}
+	sinsalHwaGae   = sinsalRule{Code: "HWAGAE", Name: "화개", Bases: []sinsalBasis{sinsalByYearBranch, sinsalByDayBranch}, ByGroup: [4]BranchId{4, 1, 10, 7}}
+	sinsalCheonEul = sinsalRule{Code: "CHEONEUL", Name: "천을귀인", Bases: []sinsalBasis{sinsalByDayStem},
+		// 甲戊庚 丑未, 乙己 子申, 丙丁 亥酉, 辛 寅午, 壬癸 卯巳
+		ByStem: [10][]BranchId{{1, 7}, {0, 8}, {11, 9}, {11, 9}, {1, 7}, {0, 8}, {1, 7}, {2, 6}, {3, 5}, {3, 5}}}
+	sinsalMunChang = sinsalRule{Code: "MUNCHANG", Name: "문창귀인", Bases: []sinsalBasis{sinsalByDayStem},
+		// 甲巳 乙午 丙申 丁酉 戊申 己酉 庚亥 辛子 壬寅 癸卯
+		ByStem: [10][]BranchId{{5}, {6}, {8}, {9}, {8}, {9}, {11}, {0}, {2}, {3}}}
+	sinsalYangIn = sinsalRule{Code: "YANGIN", Name: "양인", Bases: []sinsalBasis{sinsalByDayStem},
+		// 甲卯 丙午 戊午 庚酉 壬子 (양간만)
+		ByStem: [10][]BranchId{{3}, nil, {6}, nil, {6}, nil, {9}, nil, {0}, nil}}
+	sinsalHongYeom = sinsalRule{Code: "HONGYEOM", Name: "홍염", Bases: []sinsalBasis{sinsalByDayStem},
+		// 甲午 乙午 丙寅 丁未 戊辰 己辰 庚戌 辛酉 壬子 癸申
+		ByStem: [10][]BranchId{{6}, {6}, {2}, {7}, {4}, {4}, {10}, {9}, {0}, {8}}}
+	sinsalBaekHo = sinsalRule{Code: "BAEKHO", Name: "백호", Bases: []sinsalBasis{sinsalByPillar},
+		// 甲辰 乙未 丙戌 丁丑 戊辰 壬戌 癸丑
+		Ganji: [][2]uint8{{0, 4}, {1, 7}, {2, 10}, {3, 1}, {4, 4}, {8, 10}, {9, 1}}}
+	sinsalGoeGang = sinsalRule{Code: "GOEGANG", Name: "괴강", Bases: []sinsalBasis{sinsalByDayPillar},
+		// 庚辰 庚戌 壬辰 壬戌
+		Ganji: [][2]uint8{{6, 4}, {6, 10}, {8, 4}, {8, 10}}}
+	sinsalWonJin = sinsalRule{Code: "WONJIN", Name: "원진", Bases: []sinsalBasis{sinsalByBranchPair},
+		// 子未 丑午 寅酉 卯申 辰亥 巳戌
+		Pairs: [][2]BranchId{{0, 7}, {1, 6}, {2, 9}, {3, 8}, {4, 11}, {5, 10}}}
+	sinsalGwiMun = sinsalRule{Code: "GWIMUN", Name: "귀문", Bases: []sinsalBasis{sinsalByBranchPair},
+		// 子酉 丑午 寅未 卯申 辰亥 巳戌
+		Pairs: [][2]BranchId{{0, 9}, {1, 6}, {2, 7}, {3, 8}, {4, 11}, {5, 10}}}
+	sinsalGongMang = sinsalRule{Code: "GONGMANG", Name: "공망", Bases: []sinsalBasis{sinsalByDayGongMang}}
+)
+
+// sinsalRulePacks: 유파별 룰팩 (기본 룰에서 바뀌는 항목만 덮어쓴다)
+var sinsalRulePacks = map[string][]sinsalRule{
+	SinsalSysStandard: {
+		sinsalDoHwa, sinsalYeokMa, sinsalHwaGae, sinsalCheonEul, sinsalMunChang, sinsalYangIn,
+		sinsalBaekHo, sinsalGoeGang, sinsalWonJin, sinsalGwiMun, sinsalHongYeom, sinsalGongMang,
+	},
+	SinsalSysClassic: {
+		withBases(sinsalDoHwa, sinsalByYearBranch),
+		withBases(sinsalYeokMa, sinsalByYearBranch),
+		withBases(sinsalHwaGae, sinsalByYearBranch),
+		sinsalCheonEul, sinsalMunChang,
+		// 음간 양인: 乙辰 丁未 己未 辛戌 癸丑
+		withStems(sinsalYangIn, [10][]BranchId{{3}, {4}, {6}, {7}, {6}, {7}, {9}, {10}, {0}, {1}}),
+		sinsalBaekHo,
+		withGanji(sinsalGoeGang, [][2]uint8{{6, 4}, {6, 10}, {8, 4}, {8, 10}, {4, 10}}),
+		sinsalWonJin, sinsalGwiMun, sinsalHongYeom,
+		withBases(sinsalGongMang, sinsalByDayGongMang, sinsalByYearGongMang),
+	},
+	SinsalSysSimple: {
+		{Code: "DOHWA", Name: "도화", Bases: []sinsalBasis{sinsalByBranch}, Branches: []BranchId{0, 3, 6, 9}},
+		{Code: "YEOKMA", Name: "역마", Bases: []sinsalBasis{sinsalByBranch}, Branches: []BranchId{2, 5, 8, 11}},
+		{Code: "HWAGAE", Name: "화개", Bases: []sinsalBasis{sinsalByBranch}, Branches: []BranchId{1, 4, 7, 10}},
+		// 甲戊 丑未, 乙己 子申, 丙丁 亥酉, 庚辛 寅午, 壬癸 卯巳
+		withStems(sinsalCheonEul, [10][]BranchId{{1, 7}, {0, 8}, {11, 9}, {11, 9}, {1, 7}, {0, 8}, {2, 6}, {2, 6}, {3, 5}, {3, 5}}),
+		sinsalMunChang, sinsalYangIn, sinsalBaekHo, sinsalGoeGang, sinsalWonJin, sinsalGwiMun, sinsalHongYeom, sinsalGongMang,
+	},
+}
+
+func withBases(r sinsalRule, bases ...sinsalBasis) sinsalRule {
+	r.Bases = bases
+	return r
+}
+
+func withStems(r sinsalRule, byStem [10][]BranchId) sinsalRule {
+	r.ByStem = byStem
+	return r
+}
+
+func withGanji(r sinsalRule, ganji [][2]uint8) sinsalRule {
+	r.Ganji = ganji
+	return r
+}
+
+// SinsalSysOf resolves the 신살 유파 from Engine.Sys (대소문자 무시, 모르는 값이면 KR_STANDARD).
+func SinsalSysOf(engine Engine) string {
+	sys := strings.ToUpper(strings.TrimSpace(engine.Sys))
+	if _, ok := sinsalRulePacks[sys]; ok {
+		return sys
+	}
+	return SinsalSysStandard
+}
+
+// sinsalHit: 규칙 하나가 성립한 자리 (pillars 는 대상 기둥, 지지쌍이면 두 기둥)
+type sinsalHit struct {
+	rule    *sinsalRule
+	pillars []PillarKey
+	bases   []sinsalBasis
+	refs    []NodeId
+}
+
+// buildSinsalFacts evaluates the 유파 룰팩 over the chart and returns one SINSAL fact per (신살, 자리).
+func buildSinsalFacts(sys string, raw map[PillarKey]RawPillar, refs map[PillarKey]pillarNodeRef, keys []PillarKey) []FactItem {
+	rules := sinsalRulePacks[sys]
+	var hits []*sinsalHit
+	byKey := map[string]*sinsalHit{}
+	add := func(rule *sinsalRule, basis sinsalBasis, pillars []PillarKey, nodes ...NodeId) {
+		key := rule.Code + "." + pillarKeysJoin(pillars)
+		hit, ok := byKey[key]
+		if !ok {
+			hit = &sinsalHit{rule: rule, pillars: pillars}
+			byKey[key] = hit
+			hits = append(hits, hit)
+		}
+		hit.bases = appendUniqueBasis(hit.bases, basis)
+		for _, n := range nodes {
+			hit.refs = appendUniqueNode(hit.refs, n)
+		}
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		for _, basis := range rule.Bases {
+			switch basis {
+			case sinsalByDayStem:
+				for _, k := range keys {
+					if containsBranch(rule.ByStem[raw["D"].Stem], raw[k].Branch) {
+						add(rule, basis, []PillarKey{k}, refs[k].Branch, refs["D"].Stem)
+					}
+				}
+			case sinsalByYearBranch, sinsalByDayBranch:
+				from := PillarKey("Y")
+				if basis == sinsalByDayBranch {
+					from = "D"
+				}
+				target := rule.ByGroup[raw[from].Branch%4]
+				for _, k := range keys {
+					if k != from && raw[k].Branch == target {
+						add(rule, basis, []PillarKey{k}, refs[k].Branch, refs[from].Branch)
+					}
+				}
+			case sinsalByBranch:
+				for _, k := range keys {
+					if containsBranch(rule.Branches, raw[k].Branch) {
+						add(rule, basis, []PillarKey{k}, refs[k].Branch)
+					}
+				}
+			case sinsalByPillar, sinsalByDayPillar:
+				for _, k := range keys {
+					if basis == sinsalByDayPillar && k != "D" {
+						continue
+					}
+					if containsGanji(rule.Ganji, raw[k]) {
+						add(rule, basis, []PillarKey{k}, refs[k].Stem, refs[k].Branch)
+					}
+				}
+			case sinsalByBranchPair:
+				for i := 0; i < len(keys); i++ {
+					for j := i + 1; j < len(keys); j++ {
+						a, b := raw[keys[i]].Branch, raw[keys[j]].Branch
+						if containsBranchPair(rule.Pairs, a, b) {
+							add(rule, basis, []PillarKey{keys[i], keys[j]}, refs[keys[i]].Branch, refs[keys[j]].Branch)
+						}
+					}
+				}
+			case sinsalByDayGongMang, sinsalByYearGongMang:
+				from := PillarKey("D")
+				if basis == sinsalByYearGongMang {
+					from = "Y"
+				}
+				empty := gongMangBranches(raw[from].Stem, raw[from].Branch)
+				for _, k := range keys {
+					if k != from && containsBranch(empty, raw[k].Branch) {
+						add(rule, basis, []PillarKey{k}, refs[k].Branch, refs[from].Stem, refs[from].Branch)
+					}
+				}
+			}
+		}
+	}
+
+	facts := make([]FactItem, 0, len(hits))
+	for _, hit := range hits {
+		bases := make([]string, 0, len(hit.bases))
+		for _, b := range hit.bases {
+			bases = append(bases, string(b))
+		}
+		pillars := make([]string, 0, len(hit.pillars))
+		for _, k := range hit.pillars {
+			pillars = append(pillars, string(k))
+		}
+		code := strings.ToLower(hit.rule.Code)
+		facts = append(facts, FactItem{
+			ID:   "fact.sinsal." + code + "." + pillarKeysJoin(hit.pillars),
+			K:    FactSinsal,
+			N:    hit.rule.Name,
+			V:    map[string]any{"code": hit.rule.Code, "pillars": pillars, "bases": bases},
+			Refs: hit.refs,
+			Evidence: Evidence{
+				RuleId:  "rule.sinsal." + code,
+				RuleVer: "v1",
+				Sys:     sys,
+				Inputs:  EvidenceInputs{Nodes: hit.refs, Params: map[string]any{"bases": bases}},
+				Notes:   fmt.Sprintf("%s 기준 %s", strings.Join(bases, "·"), hit.rule.Name),
+			},
+		})
+	}
+	return facts
+}
+
+// SinsalOfBranch returns the 신살 names a transit branch (운의 지지) brings against the natal chart (일운·세운용).
+// 지지쌍 규칙은 원국 일지와, 간지 규칙은 제외한다.
+func SinsalOfBranch(sys string, natal RawPillars, branch BranchId) []string {
+	rules, ok := sinsalRulePacks[sys]
+	if !ok {
+		rules = sinsalRulePacks[SinsalSysStandard]
+	}
+	var names []string
+	for _, rule := range rules {
+		hit := false
+		for _, basis := range rule.Bases {
+			switch basis {
+			case sinsalByDayStem:
+				hit = hit || containsBranch(rule.ByStem[natal.Day.Stem], branch)
+			case sinsalByYearBranch:
+				hit = hit || rule.ByGroup[natal.Year.Branch%4] == branch
+			case sinsalByDayBranch:
+				hit = hit || rule.ByGroup[natal.Day.Branch%4] == branch
+			case sinsalByBranch:
+				hit = hit || containsBranch(rule.Branches, branch)
+			case sinsalByBranchPair:
+				hit = hit || containsBranchPair(rule.Pairs, natal.Day.Branch, branch)
+			case sinsalByDayGongMang:
+				hit = hit || containsBranch(gongMangBranches(natal.Day.Stem, natal.Day.Branch), branch)
+			case sinsalByYearGongMang:
+				hit = hit || containsBranch(gongMangBranches(natal.Year.Stem, natal.Year.Branch), branch)
+			}
+		}
+		if hit {
+			names = append(names, rule.Name)
+		}
+	}
+	return names
+}
+
+func pillarKeysJoin(keys []PillarKey) string {
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, string(k))
+	}
+	return strings.Join(parts, "-")
+}
+
+func containsBranch(list []BranchId, b BranchId) bool {
+	for _, v := range list {
+		if v == b {
+			return true
+		}
+	}
+	return false
+}
+
+func containsBranchPair(pairs [][2]BranchId, a, b BranchId) bool {
+	for _, p := range pairs {
+		if (p[0] == a && p[1] == b) || (p[0] == b && p[1] == a) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsGanji(list [][2]uint8, p RawPillar) bool {
+	for _, g := range list {
+		if StemId(g[0]) == p.Stem && BranchId(g[1]) == p.Branch {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUniqueBasis(list []sinsalBasis, b sinsalBasis) []sinsalBasis {
+	for _, v := range list {
+		if v == b {
+			return list
+		}
+	}
+	return append(list, b)
+}
+
+func appendUniqueNode(list []NodeId, n NodeId) []NodeId {
+	for _, v := range list {
+		if v == n {
+			return list
+		}
+	}
+	list = append(list, n)
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func sinsalFactsOf(t *testing.T, sys string, raw RawPillars) map[string]FactItem {
+	t.Helper()
+	doc, err := BuildSajuDocAt(BirthInput{Engine: Engine{Sys: sys}}, raw, time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("BuildSajuDocAt() error = %v", err)
+	}
+	out := map[string]FactItem{}
+	for _, f := range doc.Facts {
+		if f.K == FactSinsal {
+			out[f.ID] = f
+		}
+	}
+	return out
+}
+
+// 庚午 己丑 甲子 丙寅 (甲 일간)
+var sinsalTestRaw = RawPillars{
+	Year:  RawPillar{Stem: 6, Branch: 6},
+	Month: RawPillar{Stem: 5, Branch: 1},
+	Day:   RawPillar{Stem: 0, Branch: 0},
+	Hour:  &RawPillar{Stem: 2, Branch: 2},
+}
+
+func TestBuildSinsalFacts_Standard(t *testing.T) {
+	facts := sinsalFactsOf(t, "", sinsalTestRaw)
+	for _, id := range []string{
+		"fact.sinsal.cheoneul.M", // 甲 → 丑
+		"fact.sinsal.hongyeom.Y", // 甲 → 午
+		"fact.sinsal.yeokma.H",   // 일지 子(申子辰) → 寅
+		"fact.sinsal.wonjin.Y-M", // 丑午 원진
+		"fact.sinsal.gwimun.Y-M", // 丑午 귀문
+	} {
+		if _, ok := facts[id]; !ok {
+			t.Errorf("missing %s (got %v)", id, facts)
+		}
+	}
+	if len(facts) != 5 {
+		t.Errorf("sinsal facts = %d, want 5: %v", len(facts), facts)
+	}
+
+	f := facts["fact.sinsal.yeokma.H"]
+	if f.N != "역마" || f.Evidence.RuleId != "rule.sinsal.yeokma" || f.Evidence.Sys != SinsalSysStandard {
+		t.Fatalf("yeokma fact = %+v", f)
+	}
+	// 기준 일지(11) + 대상 시지(14): 노드는 기둥마다 천간·지지·지장간 순
+	if len(f.Refs) != 2 || f.Refs[0] != 11 || f.Refs[1] != 14 || len(f.Evidence.Inputs.Nodes) != 2 {
+		t.Fatalf("yeokma refs = %v", f.Refs)
+	}
+	if v := f.V.(map[string]any); v["bases"].([]string)[0] != string(sinsalByDayBranch) {
+		t.Fatalf("yeokma bases = %v", v["bases"])
+	}
+}
+
+func TestBuildSinsalFacts_Variants(t *testing.T) {
+	// SIMPLE: 子午卯酉 자체가 도화, 寅申巳亥 자체가 역마
+	simple := sinsalFactsOf(t, "simple", sinsalTestRaw)
+	for _, id := range []string{"fact.sinsal.dohwa.Y", "fact.sinsal.dohwa.D", "fact.sinsal.yeokma.H", "fact.sinsal.hwagae.M"} {
+		if f, ok := simple[id]; !ok || f.Evidence.Sys != SinsalSysSimple {
+			t.Errorf("SIMPLE missing %s", id)
+		}
+	}
+
+	// 庚辰 일주: 괴강, 庚 천을귀인 — 기본은 丑未, SIMPLE 은 寅午
+	raw := RawPillars{
+		Year:  RawPillar{Stem: 4, Branch: 10}, // 戊戌
+		Month: RawPillar{Stem: 1, Branch: 1},  // 乙丑
+		Day:   RawPillar{Stem: 6, Branch: 4},  // 庚辰
+	}
+	std := sinsalFactsOf(t, SinsalSysStandard, raw)
+	if _, ok := std["fact.sinsal.goegang.D"]; !ok {
+		t.Error("KR_STANDARD: 庚辰 일주 괴강 expected")
+	}
+	if _, ok := std["fact.sinsal.cheoneul.M"]; !ok {
+		t.Error("KR_STANDARD: 庚 → 丑 천을귀인 expected")
+	}
+	if _, ok := sinsalFactsOf(t, SinsalSysSimple, raw)["fact.sinsal.cheoneul.M"]; ok {
+		t.Error("SIMPLE: 庚 → 寅午 only")
+	}
+	// 戊戌 년주: KR_CLASSIC 괴강은 일주만이라 없음, 庚辰 일지 기준 화개(申子辰 → 辰)는 일지 자신이라 제외
+	classic := sinsalFactsOf(t, SinsalSysClassic, raw)
+	if _, ok := classic["fact.sinsal.goegang.Y"]; ok {
+		t.Error("KR_CLASSIC: 괴강 is day pillar only")
+	}
+	if _, ok := classic["fact.sinsal.hwagae.D"]; ok {
+		t.Error("basis branch itself must not be a target")
+	}
+	if _, ok := classic["fact.sinsal.goegang.D"]; !ok {
+		t.Error("KR_CLASSIC: 庚辰 일주 괴강 expected")
+	}
+	if SinsalSysOf(Engine{Sys: "unknown"}) != SinsalSysStandard || SinsalSysOf(Engine{Sys: " kr_classic "}) != SinsalSysClassic {
+		t.Error("SinsalSysOf normalization")
+	}
+}
+
+func TestSinsalOfBranch(t *testing.T) {
+	// 甲子 일주: 午 는 SIMPLE 도화, 子午 는 원진 아님, 戌 은 공망
+	names := SinsalOfBranch(SinsalSysSimple, sinsalTestRaw, 6)
+	if !containsName(names, "도화") || !containsName(names, "홍염") {
+		t.Errorf("SIMPLE 午 = %v, want 도화·홍염", names)
+	}
+	if names := SinsalOfBranch(SinsalSysStandard, sinsalTestRaw, 10); !containsName(names, "공망") {
+		t.Errorf("戌 = %v, want 공망", names)
+	}
+	if names := SinsalOfBranch(SinsalSysStandard, sinsalTestRaw, 7); !containsName(names, "원진") || !containsName(names, "천을귀인") {
+		t.Errorf("未 = %v, want 원진·천을귀인", names)
+	}
+}
+
+func containsName(list []string, name string) bool {
+	for _, v := range list {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,248 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 신강·신약 점수 모델 (0~100, 50 이 중화).
+//
+//	support(x) = x 에서 일간을 돕는(비겁·인성) 몫 (0~1)
+//	base      = 0.35×득령 + 0.20×득지 + 0.15×통근 + 0.30×득세   (각 support × 100)
+//	strength  = clamp(0, 100, 50 + 1.25×(base − 35) + 일간합 + 지지삼합)
+//
+// 득령은 월지, 득지는 일지, 통근은 년·시지의 지장간을 여기·중기·정기 비중으로 보고, 충을 맞은 지지는 0.7배로 줄인다.
+// 득세는 년·월·시간 천간이며, 월령을 얻은 천간합(합화)은 화신 오행으로 센다.
+// 35 는 간지 조합 표본에서 base 의 중앙값이라 중화가 가운데 오도록 맞춘 값이다.
+
+const EvalStrength EvalKind = "STRENGTH"
+
+// 7단계 강약 (상한 미만이면 해당 단계, 마지막은 나머지)
+var strengthLevels = []struct {
+	Code  string
+	Label string
+	Upper float64
+}{
+	{"EXTREME_WEAK", "극신약", 15},
+	{"WEAK", "신약", 30},
+	{"SLIGHT_WEAK", "약신약", 43},
+	{"BALANCED", "중화", 57},
+	{"SLIGHT_STRONG", "약신강", 70},
+	{"STRONG", "신강", 85},
+	{"EXTREME_STRONG", "극신강", 101},
+}
+
+// hiddenStemRoleWeight: hiddenStemTable 순서에 맞춘 지장간 비중 (정기 0.6·중기 0.25·여기 0.15, 둘이면 정기 0.7·중기 0.3)
+var hiddenStemRoleWeight = [12][]float64{
+	{1.0},             // 子 癸(정기)
+	{0.6, 0.15, 0.25}, // 丑 己(정기) 癸(여기) 辛(중기)
+	{0.6, 0.25, 0.15}, // 寅 甲(정기) 丙(중기) 戊(여기)
+	{1.0},             // 卯 乙(정기)
+	{0.6, 0.15, 0.25}, // 辰 戊(정기) 乙(여기) 癸(중기)
+	{0.6, 0.15, 0.25}, // 巳 丙(정기) 戊(여기) 庚(중기)
+	{0.7, 0.3},        // 午 丁(정기) 己(중기)
+	{0.6, 0.15, 0.25}, // 未 己(정기) 丁(여기) 乙(중기)
+	{0.6, 0.25, 0.15}, // 申 庚(정기) 壬(중기) 戊(여기)
+	{1.0},             // 酉 辛(정기)
+	{0.6, 0.15, 0.25}, // 戌 戊(정기) 辛(여기) 丁(중기)
+	{0.7, 0.3},        // 亥 壬(정기) 甲(중기)
+}
+
+const (
+	strengthNeutralBase  = 35.0 // base 중앙값 (→ 50)
+	strengthChongFactor  = 0.7  // 충 맞은 지지의 뿌리 비중
+	strengthDayHapAdj    = -6.0 // 일간이 월간·시간과 합으로 묶임
+	strengthSamhapAdj    = 3.0  // 지지 삼합 한 건 (화신이 비겁·인성이면 +, 아니면 −)
+	strengthSamhapAdjMax = 9.0
+)
+
+// StrengthLevel returns the 7-level code and Korean label for a 0~100 strength score.
+func StrengthLevel(score float64) (code, label string) {
+	for _, lv := range strengthLevels {
+		if score < lv.Upper {
+			return lv.Code, lv.Label
+		}
+	}
+	last := strengthLevels[len(strengthLevels)-1]
+	return last.Code, last.Label
+}
+
+// calcStrength scores day-master strength and returns the total with its ScorePart breakdown.
+func calcStrength(raw map[PillarKey]RawPillar, refs map[PillarKey]pillarNodeRef, keys []PillarKey, edges []Edge) (float64, []ScorePart) {
+	dm := raw["D"].Stem
+	self := fiveElementIdx(stemElement(dm))
+	supports := func(el FiveEl) bool {
+		idx := fiveElementIdx(el)
+		return idx == self || idx == mod5(self+4)
+	}
+
+	pillarOf := make(map[NodeId]PillarKey, len(keys)*2)
+	for _, k := range keys {
+		pillarOf[refs[k].Stem] = k
+		pillarOf[refs[k].Branch] = k
+	}
+	chonged := map[PillarKey]bool{}
+	stemHwa := map[PillarKey]FiveEl{}
+	var dayHap []PillarKey
+	samhapAdj := 0.0
+	var samhapRefs []NodeId
+	monthEl := branchElement(raw["M"].Branch)
+	for _, e := range edges {
+		if e.Active != nil && !*e.Active {
+			continue
+		}
+		a, okA := pillarOf[e.A]
+		b, okB := pillarOf[e.B]
+		if !okA || !okB {
+			continue
+		}
+		isStem := e.A == refs[a].Stem
+		switch {
+		case e.T == relChong && !isStem:
+			chonged[a], chonged[b] = true, true
+		case e.T == relHe && isStem && e.Result != nil:
+			if a == "D" || b == "D" {
+				other := a
+				if a == "D" {
+					other = b
+				}
+				if other == "M" || other == "H" {
+					dayHap = append(dayHap, other)
+				}
+				continue
+			}
+			if *e.Result == monthEl {
+				stemHwa[a], stemHwa[b] = *e.Result, *e.Result
+			}
+		case e.T == relSamhap && e.Result != nil:
+			if supports(*e.Result) {
+				samhapAdj += strengthSamhapAdj
+			} else {
+				samhapAdj -= strengthSamhapAdj
+			}
+			samhapRefs = append(samhapRefs, e.A, e.B)
+		}
+	}
+	samhapAdj = clamp(-strengthSamhapAdjMax, strengthSamhapAdjMax, samhapAdj)
+
+	// 지지 뿌리: 지장간 비중 합 중 비겁·인성 몫
+	rootOf := func(k PillarKey) (float64, []NodeId) {
+		p := raw[k]
+		share := 0.0
+		var nodeRefs []NodeId
+		for idx, h := range hiddenStems(p.Branch) {
+			if supports(stemElement(h)) {
+				share += hiddenStemRoleWeight[p.Branch][idx]
+				nodeRefs = append(nodeRefs, refs[k].Hidden[idx])
+			}
+		}
+		if chonged[k] {
+			share *= strengthChongFactor
+		}
+		return share, nodeRefs
+	}
+	chongNote := func(ks ...PillarKey) string {
+		var hit []string
+		for _, k := range ks {
+			if chonged[k] {
+				hit = append(hit, string(k))
+			}
+		}
+		if len(hit) == 0 {
+			return ""
+		}
+		return fmt.Sprintf("충 %s ×%.1f", strings.Join(hit, ","), strengthChongFactor)
+	}
+
+	monthRoot, monthRefs := rootOf("M")
+	dayRoot, dayRefs := rootOf("D")
+	var sideRoot float64
+	var sideRefs []NodeId
+	var sideKeys []PillarKey
+	for _, k := range []PillarKey{"Y", "H"} {
+		if _, ok := raw[k]; !ok {
+			continue
+		}
+		share, r := rootOf(k)
+		sideRoot += share
+		sideRefs = append(sideRefs, r...)
+		sideKeys = append(sideKeys, k)
+	}
+	sideRoot /= float64(len(sideKeys))
+
+	// 득세: 일간 외 천간 (합화한 천간은 화신 오행)
+	var stemKeys []PillarKey
+	var stemRefs []NodeId
+	var hwaNotes []string
+	stemSupport := 0.0
+	for _, k := range []PillarKey{"Y", "M", "H"} {
+		p, ok := raw[k]
+		if !ok {
+			continue
+		}
+		stemKeys = append(stemKeys, k)
+		el := stemElement(p.Stem)
+		if hwa, ok := stemHwa[k]; ok {
+			el = hwa
+			hwaNotes = append(hwaNotes, string(k)+"→"+string(hwa))
+		}
+		if supports(el) {
+			stemSupport++
+			stemRefs = append(stemRefs, refs[k].Stem)
+		}
+	}
+	stemSupport /= float64(len(stemKeys))
+
+	parts := []ScorePart{
+		{Label: "deukryeong", W: 0.35, Raw: monthRoot * 100, Refs: monthRefs, Note: strings.TrimSpace("득령 " + chongNote("M"))},
+		{Label: "deukji", W: 0.20, Raw: dayRoot * 100, Refs: dayRefs, Note: strings.TrimSpace("득지 " + chongNote("D"))},
+		{Label: "tonggeun", W: 0.15, Raw: sideRoot * 100, Refs: sideRefs, Note: strings.TrimSpace("통근 " + chongNote(sideKeys...))},
+		{Label: "deukse", W: 0.30, Raw: stemSupport * 100, Refs: stemRefs, Note: strings.TrimSpace("득세 " + strings.Join(hwaNotes, ","))},
+	}
+	base := 0.0
+	for _, p := range parts {
+		base += p.W * p.Raw
+	}
+	total := 50 + 1.25*(base-strengthNeutralBase)
+
+	if len(dayHap) > 0 {
+		hapRefs := []NodeId{refs["D"].Stem}
+		for _, k := range dayHap {
+			hapRefs = append(hapRefs, refs[k].Stem)
+		}
+		parts = append(parts, ScorePart{Label: "day_hap", W: 1, Raw: strengthDayHapAdj, Refs: hapRefs, Note: "일간 합으로 묶임"})
+		total += strengthDayHapAdj
+	}
+	if samhapAdj != 0 {
+		parts = append(parts, ScorePart{Label: "samhap", W: 1, Raw: samhapAdj, Refs: samhapRefs, Note: "지지 삼합 화신"})
+		total += samhapAdj
+	}
+	return clamp(0, 100, total), parts
+}
+
+// buildStrengthEval wraps calcStrength as the STRENGTH eval (v: score·level·label).
+func buildStrengthEval(sys string, raw map[PillarKey]RawPillar, refs map[PillarKey]pillarNodeRef, keys []PillarKey, edges []Edge, confidence float64) EvalItem {
+	total, parts := calcStrength(raw, refs, keys, edges)
+	code, label := StrengthLevel(total)
+	nodeRefs := []NodeId{refs["D"].Stem}
+	for _, p := range parts {
+		for _, id := range p.Refs {
+			nodeRefs = appendUniqueNode(nodeRefs, id)
+		}
+	}
+	return EvalItem{
+		ID:   "eval.strength",
+		K:    EvalStrength,
+		N:    label,
+		V:    map[string]any{"score": total, "level": code, "label": label},
+		Refs: nodeRefs,
+		Evidence: Evidence{
+			RuleId:  "rule.eval.strength",
+			RuleVer: "v1",
+			Sys:     sys,
+			Inputs:  EvidenceInputs{Nodes: nodeRefs, Params: map[string]any{"chongFactor": strengthChongFactor}},
+			Notes:   "득령·득지·통근(지장간 여기·중기·정기)·득세, 합화·충 보정",
+		},
+		Score: newScore(total, 0, 100, confidence, parts),
+	}
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func strengthPart(e EvalItem, label string) (ScorePart, bool) {
+	for _, p := range e.Score.Parts {
+		if p.Label == label {
+			return p, true
+		}
+	}
+	return ScorePart{}, false
+}
+
+func TestStrengthLevel(t *testing.T) {
+	for score, want := range map[float64]string{0: "극신약", 14.9: "극신약", 15: "신약", 42: "약신약", 50: "중화", 60: "약신강", 80: "신강", 100: "극신강"} {
+		if _, got := StrengthLevel(score); got != want {
+			t.Errorf("StrengthLevel(%v) = %s, want %s", score, got, want)
+		}
+	}
+}
+
+func TestStrengthEval(t *testing.T) {
+	// 丙午·甲午·丙午·甲午 (일간 丙): 네 지지 午 정기 丁(0.7) 이 모두 뿌리, 천간은 丙(비견)·甲(편인) 뿐
+	e := evalOf(t, RawPillars{Year: RawPillar{Stem: 2, Branch: 6}, Month: RawPillar{Stem: 0, Branch: 6}, Day: RawPillar{Stem: 2, Branch: 6}, Hour: &RawPillar{Stem: 0, Branch: 6}}, EvalStrength)
+	if e.N != "극신강" || e.V.(map[string]any)["level"] != "EXTREME_STRONG" || e.Evidence.RuleId != "rule.eval.strength" {
+		t.Fatalf("strength = %+v", e)
+	}
+	if p, _ := strengthPart(e, "deukryeong"); p.Raw != 70 || p.W != 0.35 {
+		t.Fatalf("deukryeong = %+v", p)
+	}
+	if p, _ := strengthPart(e, "deukse"); p.Raw != 100 {
+		t.Fatalf("deukse = %+v", p)
+	}
+
+	// 甲 일간이 월간 己 와 합: 일간합 감점
+	if p, ok := strengthPart(evalOf(t, sinsalTestRaw, EvalStrength), "day_hap"); !ok || p.Raw != strengthDayHapAdj {
+		t.Fatalf("day_hap = %+v %v", p, ok)
+	}
+}
+
+func TestStrengthEval_Relations(t *testing.T) {
+	// 庚申 戊寅 甲子 甲子: 寅申 충 → 월지 뿌리 甲(정기 0.6) 이 0.7배
+	e := evalOf(t, RawPillars{Year: RawPillar{Stem: 6, Branch: 8}, Month: RawPillar{Stem: 4, Branch: 2}, Day: RawPillar{Stem: 0, Branch: 0}, Hour: &RawPillar{Stem: 0, Branch: 0}}, EvalStrength)
+	p, _ := strengthPart(e, "deukryeong")
+	if p.Raw < 41.99 || p.Raw > 42.01 || !strings.Contains(p.Note, "충 M") {
+		t.Fatalf("deukryeong with chong = %+v", p)
+	}
+
+	// 丁卯 壬寅 丙午: 丁壬合木 이 寅월 득령 → 두 천간 모두 목(인성)으로 득세
+	e = evalOf(t, RawPillars{Year: RawPillar{Stem: 3, Branch: 3}, Month: RawPillar{Stem: 8, Branch: 2}, Day: RawPillar{Stem: 2, Branch: 6}}, EvalStrength)
+	p, _ = strengthPart(e, "deukse")
+	if p.Raw != 100 || !strings.Contains(p.Note, "M→WOOD") {
+		t.Fatalf("deukse with hwa = %+v", p)
+	}
+}
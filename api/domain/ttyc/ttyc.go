@@ -1,13 +1,16 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 )
 
 const (
 	MINUTE_MS int64 = 60_000
+	HOUR_MS   int64 = 3_600_000
 	DAY_MS    int64 = 86_400_000
 
 	KST_OFFSET_MINUTES = 9 * 60
@@ -25,6 +28,8 @@ type TtycFiveElement string
 type TtycYinYang string
 type TtycTenGod string
 type TtycTwelveFate string
+type TtycDaeunConvention string
+type TtycZishiConvention string
 
 const (
 	TtycTimePrecisionMinute  TtycTimePrecision = "MINUTE"
@@ -45,6 +50,19 @@ const (
 	TtycFortuneTypeIlun  TtycFortuneType = "일운"
 )
 
+// 대운수 산정 방식: 출생~인접 절입까지의 시간을 3일=1년으로 환산
+const (
+	TtycDaeunConventionExact   TtycDaeunConvention = "EXACT"   // 1일=4개월, 1시진=10일 비례 환산 (년/월)
+	TtycDaeunConventionRounded TtycDaeunConvention = "ROUNDED" // 일수/3 반올림 (최소 1년), 월 없음
+)
+
+// 자시(子時, 23:00~01:00) 일주 경계 유파
+const (
+	TtycZishiConventionDayChange00 TtycZishiConvention = "DAY_CHANGE_00" // 자정 일변 (기본): 일주는 00시 일변, 23시대 子시도 당일 일간 기준 (sxtwl 과 동일)
+	TtycZishiConventionYajasi      TtycZishiConvention = "YAJASI"        // 야자시/조자시: 일주는 00시 일변, 23시대 子시 천간은 다음날 일간 기준 (甲일 23:30 → 丙子)
+	TtycZishiConventionDayChange23 TtycZishiConvention = "DAY_CHANGE_23" // 자시 일변: 23시부터 다음날 일주, 子시도 다음날 일간 기준
+)
+
 const (
 	TtycFiveElementWood  TtycFiveElement = "목"
 	TtycFiveElementFire  TtycFiveElement = "화"
@@ -122,9 +140,12 @@ type TtycPillars struct {
 }
 
 type TtycPillarCalcInput struct {
-	Ts              int64             `json:"ts"`
-	TzOffsetMinutes *int              `json:"tzOffsetMinutes,omitempty"`
-	TimePrecision   TtycTimePrecision `json:"timePrecision,omitempty"`
+	Ts              int64               `json:"ts"`
+	TzOffsetMinutes *int                `json:"tzOffsetMinutes,omitempty"`
+	TimePrecision   TtycTimePrecision   `json:"timePrecision,omitempty"`
+	ZishiConvention TtycZishiConvention `json:"zishiConvention,omitempty"`
+	SolarTimeMode   TtycSolarTimeMode   `json:"solarTimeMode,omitempty"`
+	Longitude       *float64            `json:"longitude,omitempty"` // SolarTimeMode MEAN/APPARENT 에 필요
 }
 
 type TtycPillarBoundaries struct {
@@ -137,10 +158,14 @@ type TtycPillarCalcResult struct {
 	Ts              int64                  `json:"ts"`
 	TzOffsetMinutes int                    `json:"tzOffsetMinutes"`
 	TimePrecision   TtycTimePrecision      `json:"timePrecision"`
+	ZishiConvention TtycZishiConvention    `json:"zishiConvention"`
 	Local           TtycLocalDateTimeParts `json:"local"`
 	DayMasterStem   int                    `json:"dayMasterStem"`
 	Pillars         TtycPillars            `json:"pillars"`
 	Boundaries      TtycPillarBoundaries   `json:"boundaries"`
+	// 진태양시 보정 시에만: 일주·시주는 SolarLocal 기준
+	SolarTime  *TtycSolarTimeCorrection `json:"solarTime,omitempty"`
+	SolarLocal *TtycLocalDateTimeParts  `json:"solarLocal,omitempty"`
 }
 
 type TtycFortuneRequest struct {
@@ -150,6 +175,8 @@ type TtycFortuneRequest struct {
 	WolunYear    *int   `json:"wolunYear,omitempty"`
 	IlunYear     *int   `json:"ilunYear,omitempty"`
 	IlunMonth    *int   `json:"ilunMonth,omitempty"`
+
+	DaeunConvention TtycDaeunConvention `json:"daeunConvention,omitempty"`
 }
 
 type TtycFortunePeriod struct {
@@ -164,6 +191,24 @@ type TtycFortunePeriod struct {
 	Month     int             `json:"month,omitempty"`
 	Day       int             `json:"day,omitempty"`
 	SourceTs  int64           `json:"sourceTs"`
+
+	// 대운 전용: 시작 나이(만, 년/개월)와 정확한 시작/종료(미포함) 시각
+	StartAgeYears  int                 `json:"startAgeYears"`
+	StartAgeMonths int                 `json:"startAgeMonths"`
+	StartTs        int64               `json:"startTs,omitempty"`
+	EndTs          int64               `json:"endTs,omitempty"`
+	Convention     TtycDaeunConvention `json:"convention,omitempty"`
+}
+
+// TtycDaeunStart is the first 대운's start: the adjacent 절 counted from (next when forward, current
+// when backward), the converted start age and the exact start instant.
+type TtycDaeunStart struct {
+	Convention TtycDaeunConvention `json:"convention"`
+	Forward    bool                `json:"forward"`
+	TermTs     int64               `json:"termTs"`
+	Years      int                 `json:"years"`
+	Months     int                 `json:"months"`
+	StartTs    int64               `json:"startTs"`
 }
 
 type TtycFortuneFlow struct {
@@ -184,6 +229,9 @@ type TtycCalculateInput struct {
 	TzOffsetMinutes *int                `json:"tzOffsetMinutes,omitempty"`
 	Sex             TtycSex             `json:"sex,omitempty"`
 	TimePrecision   TtycTimePrecision   `json:"timePrecision,omitempty"`
+	ZishiConvention TtycZishiConvention `json:"zishiConvention,omitempty"`
+	SolarTimeMode   TtycSolarTimeMode   `json:"solarTimeMode,omitempty"`
+	Longitude       *float64            `json:"longitude,omitempty"`
 	Fortune         *TtycFortuneRequest `json:"fortune,omitempty"`
 }
 
@@ -260,7 +308,6 @@ var ttycTwelveFateStartBranchByDayStem = [...]int{
 
 type ttycSolarMonthStartTemplate struct {
 	Month  int
-	Day    int
 	Branch int
 	Name   string
 }
@@ -268,18 +315,18 @@ type ttycSolarMonthStartTemplate struct {
 const ttycSxtwlDayCycleOffset int64 = 2
 
 var ttycSolarMonthStarts = [...]ttycSolarMonthStartTemplate{
-	{Month: 1, Day: 6, Branch: 1, Name: "소한(丑월)"},
-	{Month: 2, Day: 4, Branch: 2, Name: "입춘(寅월)"},
-	{Month: 3, Day: 6, Branch: 3, Name: "경칩(卯월)"},
-	{Month: 4, Day: 5, Branch: 4, Name: "청명(辰월)"},
-	{Month: 5, Day: 6, Branch: 5, Name: "입하(巳월)"},
-	{Month: 6, Day: 6, Branch: 6, Name: "망종(午월)"},
-	{Month: 7, Day: 7, Branch: 7, Name: "소서(未월)"},
-	{Month: 8, Day: 8, Branch: 8, Name: "입추(申월)"},
-	{Month: 9, Day: 8, Branch: 9, Name: "백로(酉월)"},
-	{Month: 10, Day: 8, Branch: 10, Name: "한로(戌월)"},
-	{Month: 11, Day: 7, Branch: 11, Name: "입동(亥월)"},
-	{Month: 12, Day: 7, Branch: 0, Name: "대설(子월)"},
+	{Month: 1, Branch: 1, Name: "소한(丑월)"},
+	{Month: 2, Branch: 2, Name: "입춘(寅월)"},
+	{Month: 3, Branch: 3, Name: "경칩(卯월)"},
+	{Month: 4, Branch: 4, Name: "청명(辰월)"},
+	{Month: 5, Branch: 5, Name: "입하(巳월)"},
+	{Month: 6, Branch: 6, Name: "망종(午월)"},
+	{Month: 7, Branch: 7, Name: "소서(未월)"},
+	{Month: 8, Branch: 8, Name: "입추(申월)"},
+	{Month: 9, Branch: 9, Name: "백로(酉월)"},
+	{Month: 10, Branch: 10, Name: "한로(戌월)"},
+	{Month: 11, Branch: 11, Name: "입동(亥월)"},
+	{Month: 12, Branch: 0, Name: "대설(子월)"},
 }
 
 func ttycMod(n, m int) int {
@@ -295,6 +342,24 @@ func ttycFloorDiv(n, d int64) int64 {
 	return q
 }
 
+// 지원 연도: 절기는 태양 황경으로 직접 계산하므로 (역산) 그레고리력 1~9999 년 전 범위.
+// 1800~2200 밖은 ΔT 추정 오차로 절입 시각 정확도가 떨어진다.
+const (
+	TtycMinYear = 1
+	TtycMaxYear = 9999
+)
+
+// ErrOutOfRange: 엔진 지원 범위를 벗어난 날짜 (errors.Is 로 판별).
+var ErrOutOfRange = errors.New("date out of supported range")
+
+// CheckYearRange returns an error wrapping ErrOutOfRange if year is outside TtycMinYear..TtycMaxYear.
+func CheckYearRange(year int) error {
+	if year < TtycMinYear || year > TtycMaxYear {
+		return fmt.Errorf("%w: year %d (supported %d-%d)", ErrOutOfRange, year, TtycMinYear, TtycMaxYear)
+	}
+	return nil
+}
+
 func ttycNormalizeTzOffsetMinutes(v *int) (int, error) {
 	if v == nil {
 		return KST_OFFSET_MINUTES, nil
@@ -319,20 +384,6 @@ func ttycNormalizeSex(v TtycSex) TtycSex {
 	return v
 }
 
-func ttycLookupSolarTermStartDay(year, month int) (int, bool) {
-	if month < 1 || month > 12 {
-		return 0, false
-	}
-	if year < ttycSolarTermStartYear || year > ttycSolarTermEndYear {
-		return 0, false
-	}
-	day := ttycSolarTermStartDayTable[year-ttycSolarTermStartYear][month-1]
-	if day == 0 {
-		return 0, false
-	}
-	return int(day), true
-}
-
 func ToLocalDateTimeParts(ts int64, tzOffsetMinutes *int) (TtycLocalDateTimeParts, error) {
 	tzOffset, err := ttycNormalizeTzOffsetMinutes(tzOffsetMinutes)
 	if err != nil {
@@ -560,6 +611,11 @@ func ttycBuildGanjiMeta(stem, branch, dayMasterStem int) (TtycGanjiMeta, error)
 	}, nil
 }
 
+// GanjiMetaOf returns the meta of stem/branch with 십성·십이운성 relative to dayMasterStem (운·일진 표시용).
+func GanjiMetaOf(stem, branch, dayMasterStem int) (TtycGanjiMeta, error) {
+	return ttycBuildGanjiMeta(stem, branch, dayMasterStem)
+}
+
 type ttycYearPillarCalc struct {
 	Stem          int
 	Branch        int
@@ -567,18 +623,8 @@ type ttycYearPillarCalc struct {
 	LichunStartTs int64
 }
 
-func ttycCalcYearPillar(ts int64, local TtycLocalDateTimeParts, tzOffsetMinutes int) (ttycYearPillarCalc, error) {
-	lichunDay := 4
-	if day, ok := ttycLookupSolarTermStartDay(local.Year, 2); ok {
-		lichunDay = day
-	}
-	lichunStartTs, err := ToUnixTimestamp(
-		TtycTimestampParts{Year: local.Year, Month: 2, Day: lichunDay, Hour: 0, Minute: 0},
-		&tzOffsetMinutes,
-	)
-	if err != nil {
-		return ttycYearPillarCalc{}, err
-	}
+func ttycCalcYearPillar(ts int64, local TtycLocalDateTimeParts) (ttycYearPillarCalc, error) {
+	lichunStartTs := ttycMonthTermStartTs(local.Year, 2)
 	cycleYear := local.Year
 	if ts < lichunStartTs {
 		cycleYear = local.Year - 1
@@ -597,23 +643,12 @@ type ttycMonthBranchBoundary struct {
 	Name    string
 }
 
-func ttycBuildMonthBranchBoundaries(year, tzOffsetMinutes int) ([]ttycMonthBranchBoundary, error) {
+func ttycBuildMonthBranchBoundaries(year int) []ttycMonthBranchBoundary {
 	out := make([]ttycMonthBranchBoundary, 0, len(ttycSolarMonthStarts)*3)
 	for _, y := range []int{year - 1, year, year + 1} {
 		for _, item := range ttycSolarMonthStarts {
-			day := item.Day
-			if v, ok := ttycLookupSolarTermStartDay(y, item.Month); ok {
-				day = v
-			}
-			startTs, err := ToUnixTimestamp(
-				TtycTimestampParts{Year: y, Month: item.Month, Day: day, Hour: 0, Minute: 0},
-				&tzOffsetMinutes,
-			)
-			if err != nil {
-				return nil, err
-			}
 			out = append(out, ttycMonthBranchBoundary{
-				StartTs: startTs,
+				StartTs: ttycMonthTermStartTs(y, item.Month),
 				Branch:  item.Branch,
 				Name:    item.Name,
 			})
@@ -622,7 +657,7 @@ func ttycBuildMonthBranchBoundaries(year, tzOffsetMinutes int) ([]ttycMonthBranc
 	sort.Slice(out, func(i, j int) bool {
 		return out[i].StartTs < out[j].StartTs
 	})
-	return out, nil
+	return out
 }
 
 type ttycMonthBranchCalc struct {
@@ -631,11 +666,8 @@ type ttycMonthBranchCalc struct {
 	TermName string
 }
 
-func ttycCalcMonthBranch(ts int64, localYear, tzOffsetMinutes int) (ttycMonthBranchCalc, error) {
-	boundaries, err := ttycBuildMonthBranchBoundaries(localYear, tzOffsetMinutes)
-	if err != nil {
-		return ttycMonthBranchCalc{}, err
-	}
+func ttycCalcMonthBranch(ts int64, localYear int) (ttycMonthBranchCalc, error) {
+	boundaries := ttycBuildMonthBranchBoundaries(localYear)
 	current := boundaries[0]
 	for _, b := range boundaries {
 		if b.StartTs <= ts {
@@ -669,7 +701,8 @@ type ttycDayPillarCalc struct {
 	DayStartTs int64
 }
 
-func ttycCalcDayPillar(local TtycLocalDateTimeParts, tzOffsetMinutes int) (ttycDayPillarCalc, error) {
+// ttycCalcDayPillar: 기본은 현지 00시 일변. DAY_CHANGE_23 이면 23시부터 다음날 일주 (일 시작 = 전날 23시).
+func ttycCalcDayPillar(local TtycLocalDateTimeParts, tzOffsetMinutes int, zishi TtycZishiConvention) (ttycDayPillarCalc, error) {
 	dayStartTs, err := ToUnixTimestamp(
 		TtycTimestampParts{Year: local.Year, Month: local.Month, Day: local.Day, Hour: 0, Minute: 0},
 		&tzOffsetMinutes,
@@ -677,6 +710,12 @@ func ttycCalcDayPillar(local TtycLocalDateTimeParts, tzOffsetMinutes int) (ttycD
 	if err != nil {
 		return ttycDayPillarCalc{}, err
 	}
+	if zishi == TtycZishiConventionDayChange23 {
+		if local.Hour >= 23 {
+			dayStartTs += DAY_MS
+		}
+		dayStartTs -= HOUR_MS
+	}
 	anchorStartTs, err := ToUnixTimestamp(
 		TtycTimestampParts{Year: 1984, Month: 2, Day: 2, Hour: 0, Minute: 0},
 		&tzOffsetMinutes,
@@ -684,7 +723,7 @@ func ttycCalcDayPillar(local TtycLocalDateTimeParts, tzOffsetMinutes int) (ttycD
 	if err != nil {
 		return ttycDayPillarCalc{}, err
 	}
-	diffDays := ttycFloorDiv(dayStartTs-anchorStartTs, DAY_MS) + ttycSxtwlDayCycleOffset
+	diffDays := ttycFloorDiv(dayStartTs-anchorStartTs+HOUR_MS, DAY_MS) + ttycSxtwlDayCycleOffset
 	return ttycDayPillarCalc{
 		Stem:       ttycMod(int(diffDays), 10),
 		Branch:     ttycMod(int(diffDays), 12),
@@ -715,17 +754,24 @@ func CalculatePillars(input TtycPillarCalcInput) (TtycPillarCalcResult, error) {
 		return TtycPillarCalcResult{}, err
 	}
 	timePrecision := ttycNormalizeTimePrecision(input.TimePrecision)
+	zishi, err := ttycNormalizeZishiConvention(input.ZishiConvention)
+	if err != nil {
+		return TtycPillarCalcResult{}, err
+	}
 	ts := input.Ts
 
 	local, err := ToLocalDateTimeParts(ts, &tzOffsetMinutes)
 	if err != nil {
 		return TtycPillarCalcResult{}, err
 	}
-	yearPillar, err := ttycCalcYearPillar(ts, local, tzOffsetMinutes)
+	if err := CheckYearRange(local.Year); err != nil {
+		return TtycPillarCalcResult{}, err
+	}
+	yearPillar, err := ttycCalcYearPillar(ts, local)
 	if err != nil {
 		return TtycPillarCalcResult{}, err
 	}
-	monthBranch, err := ttycCalcMonthBranch(ts, local.Year, tzOffsetMinutes)
+	monthBranch, err := ttycCalcMonthBranch(ts, local.Year)
 	if err != nil {
 		return TtycPillarCalcResult{}, err
 	}
@@ -733,10 +779,27 @@ func CalculatePillars(input TtycPillarCalcInput) (TtycPillarCalcResult, error) {
 	if err != nil {
 		return TtycPillarCalcResult{}, err
 	}
-	dayPillar, err := ttycCalcDayPillar(local, tzOffsetMinutes)
+	// 일주·시주는 (진태양시 보정 시) 지방태양시 기준
+	solarTime, err := ttycCalcSolarTime(input, ts, tzOffsetMinutes)
 	if err != nil {
 		return TtycPillarCalcResult{}, err
 	}
+	pillarLocal := local
+	var solarLocal *TtycLocalDateTimeParts
+	correctionMs := int64(0)
+	if solarTime != nil {
+		correctionMs = int64(solarTime.CorrectionMinutes) * MINUTE_MS
+		pillarLocal, err = ToLocalDateTimeParts(ts+correctionMs, &tzOffsetMinutes)
+		if err != nil {
+			return TtycPillarCalcResult{}, err
+		}
+		solarLocal = &pillarLocal
+	}
+	dayPillar, err := ttycCalcDayPillar(pillarLocal, tzOffsetMinutes, zishi)
+	if err != nil {
+		return TtycPillarCalcResult{}, err
+	}
+	dayPillar.DayStartTs -= correctionMs
 	dayMasterStem := dayPillar.Stem
 
 	yearMeta, err := ttycBuildGanjiMeta(yearPillar.Stem, yearPillar.Branch, dayMasterStem)
@@ -758,7 +821,11 @@ func CalculatePillars(input TtycPillarCalcInput) (TtycPillarCalcResult, error) {
 		Day:   dayMeta,
 	}
 	if timePrecision != TtycTimePrecisionUnknown {
-		hourPillar, err := ttycCalcHourPillar(dayMasterStem, local.Hour)
+		hourDayStem := dayMasterStem
+		if zishi == TtycZishiConventionYajasi && pillarLocal.Hour >= 23 { // 야자시: 일주는 당일, 子시 천간은 다음날 일간
+			hourDayStem = ttycMod(dayMasterStem+1, 10)
+		}
+		hourPillar, err := ttycCalcHourPillar(hourDayStem, pillarLocal.Hour)
 		if err != nil {
 			return TtycPillarCalcResult{}, err
 		}
@@ -773,6 +840,7 @@ func CalculatePillars(input TtycPillarCalcInput) (TtycPillarCalcResult, error) {
 		Ts:              ts,
 		TzOffsetMinutes: tzOffsetMinutes,
 		TimePrecision:   timePrecision,
+		ZishiConvention: zishi,
 		Local:           local,
 		DayMasterStem:   dayMasterStem,
 		Pillars:         pillars,
@@ -781,9 +849,30 @@ func CalculatePillars(input TtycPillarCalcInput) (TtycPillarCalcResult, error) {
 			MonthTermStartTs: monthBranch.StartTs,
 			DayStartTs:       dayPillar.DayStartTs,
 		},
+		SolarTime:  solarTime,
+		SolarLocal: solarLocal,
 	}, nil
 }
 
+// ttycCalcSolarTime: SolarTimeMode 가 NONE 이면 nil. MEAN/APPARENT 는 경도가 필요하다.
+func ttycCalcSolarTime(input TtycPillarCalcInput, ts int64, tzOffsetMinutes int) (*TtycSolarTimeCorrection, error) {
+	mode, err := ttycNormalizeSolarTimeMode(input.SolarTimeMode)
+	if err != nil {
+		return nil, err
+	}
+	if mode == TtycSolarTimeModeNone {
+		return nil, nil
+	}
+	if input.Longitude == nil {
+		return nil, fmt.Errorf("solar time mode %s requires longitude", mode)
+	}
+	corr, err := SolarTimeCorrection(ts, tzOffsetMinutes, *input.Longitude, mode)
+	if err != nil {
+		return nil, err
+	}
+	return &corr, nil
+}
+
 func ttycIsForwardDaeun(yearStem int, sex TtycSex) bool {
 	yangYear := yearStem%2 == 0
 	if sex == TtycSexM {
@@ -803,6 +892,12 @@ type ttycFortunePeriodExtra struct {
 	Year      int
 	Month     int
 	Day       int
+
+	StartAgeYears  int
+	StartAgeMonths int
+	StartTs        int64
+	EndTs          int64
+	Convention     TtycDaeunConvention
 }
 
 func ttycToFortunePeriod(
@@ -828,25 +923,113 @@ func ttycToFortunePeriod(
 		Year:          extra.Year,
 		Month:         extra.Month,
 		Day:           extra.Day,
+
+		StartAgeYears:  extra.StartAgeYears,
+		StartAgeMonths: extra.StartAgeMonths,
+		StartTs:        extra.StartTs,
+		EndTs:          extra.EndTs,
+		Convention:     extra.Convention,
 	}, nil
 }
 
-func ttycBuildDaeunList(birth TtycPillarCalcResult, sex TtycSex) ([]TtycFortunePeriod, error) {
-	forward := ttycIsForwardDaeun(birth.Pillars.Year.Stem, sex)
+func ttycNormalizeZishiConvention(v TtycZishiConvention) (TtycZishiConvention, error) {
+	switch v {
+	case "":
+		return TtycZishiConventionDayChange00, nil
+	case TtycZishiConventionDayChange00, TtycZishiConventionYajasi, TtycZishiConventionDayChange23:
+		return v, nil
+	default:
+		return "", fmt.Errorf("invalid zishi convention: %q", v)
+	}
+}
+
+// NormalizeZishiConvention returns the 자시 convention (empty → DAY_CHANGE_00) or an error for unknown values.
+func NormalizeZishiConvention(v TtycZishiConvention) (TtycZishiConvention, error) {
+	return ttycNormalizeZishiConvention(v)
+}
+
+func ttycNormalizeDaeunConvention(v TtycDaeunConvention) (TtycDaeunConvention, error) {
+	switch v {
+	case "":
+		return TtycDaeunConventionExact, nil
+	case TtycDaeunConventionExact, TtycDaeunConventionRounded:
+		return v, nil
+	default:
+		return "", fmt.Errorf("invalid daeun convention: %q", v)
+	}
+}
+
+func ttycAddLocalYearsMonths(ts int64, tzOffsetMinutes, years, months int) int64 {
+	loc := time.FixedZone("", tzOffsetMinutes*60)
+	return time.UnixMilli(ts).In(loc).AddDate(years, months, 0).UnixMilli()
+}
+
+// CalculateDaeunStart counts from birth to the next 절 (순행) or back to the current 절 (역행) and
+// converts that span with convention (3일=1년).
+func CalculateDaeunStart(birth TtycPillarCalcResult, sex TtycSex, convention TtycDaeunConvention) (TtycDaeunStart, error) {
+	convention, err := ttycNormalizeDaeunConvention(convention)
+	if err != nil {
+		return TtycDaeunStart{}, err
+	}
+	forward := ttycIsForwardDaeun(birth.Pillars.Year.Stem, ttycNormalizeSex(sex))
+
+	termTs := birth.Boundaries.MonthTermStartTs
+	if forward {
+		for _, b := range ttycBuildMonthBranchBoundaries(birth.Local.Year) {
+			if b.StartTs > birth.Ts {
+				termTs = b.StartTs
+				break
+			}
+		}
+	}
+	span := termTs - birth.Ts
+	if span < 0 {
+		span = -span
+	}
+
+	out := TtycDaeunStart{Convention: convention, Forward: forward, TermTs: termTs}
+	switch convention {
+	case TtycDaeunConventionRounded:
+		out.Years = int(math.Round(float64(span) / float64(3*DAY_MS)))
+		if out.Years < 1 {
+			out.Years = 1
+		}
+		out.StartTs = ttycAddLocalYearsMonths(birth.Ts, birth.TzOffsetMinutes, out.Years, 0)
+	default:
+		// 1일 = 4개월 → 6시간 = 1개월, 나머지는 120배 (1시진 = 10일)
+		const monthSpan = DAY_MS / 4
+		totalMonths := int(span / monthSpan)
+		out.Years = totalMonths / 12
+		out.Months = totalMonths % 12
+		out.StartTs = ttycAddLocalYearsMonths(birth.Ts, birth.TzOffsetMinutes, out.Years, out.Months) + (span%monthSpan)*120
+	}
+	return out, nil
+}
+
+func ttycBuildDaeunList(birth TtycPillarCalcResult, sex TtycSex, convention TtycDaeunConvention) ([]TtycFortunePeriod, error) {
+	start, err := CalculateDaeunStart(birth, sex, convention)
+	if err != nil {
+		return nil, err
+	}
 	baseMonthStem := birth.Pillars.Month.Stem
 	baseMonthBranch := birth.Pillars.Month.Branch
 
 	list := make([]TtycFortunePeriod, 0, 8)
 	for order := 1; order <= 8; order++ {
 		shift := order
-		if !forward {
+		if !start.Forward {
 			shift = -order
 		}
 		stem := ttycMod(baseMonthStem+shift, 10)
 		branch := ttycMod(baseMonthBranch+shift, 12)
-		ageFrom := 1 + (order-1)*10
+		ageFrom := start.Years + (order-1)*10
 		ageTo := ageFrom + 9
-		startYear := birth.Local.Year + ageFrom
+		startTs := ttycAddLocalYearsMonths(start.StartTs, birth.TzOffsetMinutes, (order-1)*10, 0)
+		endTs := ttycAddLocalYearsMonths(start.StartTs, birth.TzOffsetMinutes, order*10, 0)
+		startLocal, err := ToLocalDateTimeParts(startTs, &birth.TzOffsetMinutes)
+		if err != nil {
+			return nil, err
+		}
 
 		item, err := ttycToFortunePeriod(
 			TtycFortuneTypeDaeun,
@@ -855,11 +1038,16 @@ func ttycBuildDaeunList(birth TtycPillarCalcResult, sex TtycSex) ([]TtycFortuneP
 			birth.DayMasterStem,
 			birth.Ts,
 			ttycFortunePeriodExtra{
-				Order:     order,
-				AgeFrom:   ageFrom,
-				AgeTo:     ageTo,
-				StartYear: startYear,
-				Year:      startYear,
+				Order:          order,
+				AgeFrom:        ageFrom,
+				AgeTo:          ageTo,
+				StartYear:      startLocal.Year,
+				Year:           startLocal.Year,
+				StartAgeYears:  ageFrom,
+				StartAgeMonths: start.Months,
+				StartTs:        startTs,
+				EndTs:          endTs,
+				Convention:     start.Convention,
 			},
 		)
 		if err != nil {
@@ -870,20 +1058,17 @@ func ttycBuildDaeunList(birth TtycPillarCalcResult, sex TtycSex) ([]TtycFortuneP
 	return list, nil
 }
 
-func ttycPickCurrentDaeun(daeunList []TtycFortunePeriod, birthYear, baseYear int) *TtycFortunePeriod {
+// ttycPickCurrentDaeun returns the 대운 whose [StartTs, EndTs) holds baseTs; before the first 대운
+// starts it returns the first one, after the last the last one.
+func ttycPickCurrentDaeun(daeunList []TtycFortunePeriod, baseTs int64) *TtycFortunePeriod {
 	if len(daeunList) == 0 {
 		return nil
 	}
-	age := baseYear - birthYear + 1
-	if age < 1 {
-		age = 1
-	}
-	idx := (age - 1) / 10
-	if idx < 0 {
-		idx = 0
-	}
-	if idx >= len(daeunList) {
-		idx = len(daeunList) - 1
+	idx := 0
+	for i, item := range daeunList {
+		if item.StartTs <= baseTs {
+			idx = i
+		}
 	}
 	picked := daeunList[idx]
 	picked.Type = TtycFortuneTypeDaeun
@@ -1119,11 +1304,11 @@ func CalculateFortuneFlow(
 		return TtycFortuneFlow{}, err
 	}
 
-	daeunList, err := ttycBuildDaeunList(birth, ttycNormalizeSex(sex))
+	daeunList, err := ttycBuildDaeunList(birth, ttycNormalizeSex(sex), req.DaeunConvention)
 	if err != nil {
 		return TtycFortuneFlow{}, err
 	}
-	daeun := ttycPickCurrentDaeun(daeunList, birth.Local.Year, baseCalc.Local.Year)
+	daeun := ttycPickCurrentDaeun(daeunList, baseCalc.Ts)
 
 	seun, err := ttycToFortunePeriod(
 		TtycFortuneTypeSeun,
@@ -1203,6 +1388,9 @@ func CalculateTtyc(input TtycCalculateInput) (TtycCalculateResult, error) {
 		Ts:              input.BirthTs,
 		TzOffsetMinutes: input.TzOffsetMinutes,
 		TimePrecision:   input.TimePrecision,
+		ZishiConvention: input.ZishiConvention,
+		SolarTimeMode:   input.SolarTimeMode,
+		Longitude:       input.Longitude,
 	})
 	if err != nil {
 		return TtycCalculateResult{}, err
@@ -1221,12 +1409,18 @@ type TtycCalculatorOptions struct {
 	TzOffsetMinutes *int
 	TimePrecision   TtycTimePrecision
 	Sex             TtycSex
+	ZishiConvention TtycZishiConvention
+	SolarTimeMode   TtycSolarTimeMode
+	Longitude       *float64
 }
 
 type TtycCalculator struct {
 	tzOffsetMinutes int
 	timePrecision   TtycTimePrecision
 	sex             TtycSex
+	zishiConvention TtycZishiConvention
+	solarTimeMode   TtycSolarTimeMode
+	longitude       *float64
 }
 
 func NewTtycCalculator(opts *TtycCalculatorOptions) (*TtycCalculator, error) {
@@ -1234,21 +1428,41 @@ func NewTtycCalculator(opts *TtycCalculatorOptions) (*TtycCalculator, error) {
 		tzPtr         *int
 		timePrecision TtycTimePrecision
 		sex           TtycSex
+		zishi         TtycZishiConvention
+		solarMode     TtycSolarTimeMode
+		longitude     *float64
 	)
 	if opts != nil {
 		tzPtr = opts.TzOffsetMinutes
 		timePrecision = opts.TimePrecision
 		sex = opts.Sex
+		zishi = opts.ZishiConvention
+		solarMode = opts.SolarTimeMode
+		longitude = opts.Longitude
 	}
 
 	tzOffsetMinutes, err := ttycNormalizeTzOffsetMinutes(tzPtr)
 	if err != nil {
 		return nil, err
 	}
+	zishi, err = ttycNormalizeZishiConvention(zishi)
+	if err != nil {
+		return nil, err
+	}
+	solarMode, err = ttycNormalizeSolarTimeMode(solarMode)
+	if err != nil {
+		return nil, err
+	}
+	if solarMode != TtycSolarTimeModeNone && longitude == nil {
+		return nil, fmt.Errorf("solar time mode %s requires longitude", solarMode)
+	}
 	return &TtycCalculator{
 		tzOffsetMinutes: tzOffsetMinutes,
 		timePrecision:   ttycNormalizeTimePrecision(timePrecision),
 		sex:             ttycNormalizeSex(sex),
+		zishiConvention: zishi,
+		solarTimeMode:   solarMode,
+		longitude:       longitude,
 	}, nil
 }
 
@@ -1257,6 +1471,9 @@ func (c *TtycCalculator) CalculatePillars(ts int64) (TtycPillarCalcResult, error
 		Ts:              ts,
 		TzOffsetMinutes: &c.tzOffsetMinutes,
 		TimePrecision:   c.timePrecision,
+		ZishiConvention: c.zishiConvention,
+		SolarTimeMode:   c.solarTimeMode,
+		Longitude:       c.longitude,
 	})
 }
 
@@ -1266,6 +1483,9 @@ func (c *TtycCalculator) Calculate(ts int64, fortune *TtycFortuneRequest) (TtycC
 		TzOffsetMinutes: &c.tzOffsetMinutes,
 		Sex:             c.sex,
 		TimePrecision:   c.timePrecision,
+		ZishiConvention: c.zishiConvention,
+		SolarTimeMode:   c.solarTimeMode,
+		Longitude:       c.longitude,
 		Fortune:         fortune,
 	})
 }
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+// 만세력: 날짜 구간의 날짜별 일진·월주·연주, 그날 드는 절기(정확한 시각), 공망, 음력 날짜.
+// 간지는 현지 00:00 기준 (절입일의 월주·연주는 절입 시각 이후 바뀐다 → SolarTerm 참조).
+
+const TtycAlmanacMaxDays = 400
+
+// ttycSolarTermKo/Hanja: 태양 황경 15° 단위 (0=춘분 0°, 21=입춘 315°)
+var ttycSolarTermKo = [...]string{
+	"춘분", "청명", "곡우", "입하", "소만", "망종", "하지", "소서", "대서", "입추", "처서", "백로",
+	"추분", "한로", "상강", "입동", "소설", "대설", "동지", "소한", "대한", "입춘", "우수", "경칩",
+}
+var ttycSolarTermHanja = [...]string{
+	"春分", "淸明", "穀雨", "立夏", "小滿", "芒種", "夏至", "小暑", "大暑", "立秋", "處暑", "白露",
+	"秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至", "小寒", "大寒", "立春", "雨水", "驚蟄",
+}
+
+type TtycAlmanacInput struct {
+	From            TtycSolarDate `json:"from"`
+	To              TtycSolarDate `json:"to"` // inclusive
+	TzOffsetMinutes *int          `json:"tzOffsetMinutes,omitempty"`
+}
+
+type TtycGanji struct {
+	Stem       int             `json:"stem"`
+	Branch     int             `json:"branch"`
+	GanjiKo    string          `json:"ganjiKo"`
+	GanjiHanja string          `json:"ganjiHanja"`
+	StemEl     TtycFiveElement `json:"stemEl"`
+	BranchEl   TtycFiveElement `json:"branchEl"`
+}
+
+type TtycSolarTerm struct {
+	Index        int                    `json:"index"` // 황경/15 (0=춘분)
+	NameKo       string                 `json:"nameKo"`
+	NameHanja    string                 `json:"nameHanja"`
+	Longitude    int                    `json:"longitude"`
+	IsMonthStart bool                   `json:"isMonthStart"` // 절(節): 월주가 바뀌는 절기
+	Ts           int64                  `json:"ts"`
+	Local        TtycLocalDateTimeParts `json:"local"`
+}
+
+type TtycAlmanacDay struct {
+	Date       TtycSolarDate  `json:"date"`
+	Weekday    int            `json:"weekday"` // 0=일요일
+	DayStartTs int64          `json:"dayStartTs"`
+	Year       TtycGanji      `json:"year"`
+	Month      TtycGanji      `json:"month"`
+	Day        TtycGanji      `json:"day"`
+	GongMang   []int          `json:"gongMang"` // 일진 기준 공망 지지 2개
+	SolarTerm  *TtycSolarTerm `json:"solarTerm,omitempty"`
+	Lunar      *TtycLunarDate `json:"lunar,omitempty"` // 음력 지원 범위(1900~2100) 밖이면 nil
+}
+
+func ttycToGanji(meta TtycGanjiMeta) TtycGanji {
+	return TtycGanji{
+		Stem:       meta.Stem,
+		Branch:     meta.Branch,
+		GanjiKo:    meta.GanjiKo,
+		GanjiHanja: meta.GanjiHanja,
+		StemEl:     meta.StemEl,
+		BranchEl:   meta.BranchEl,
+	}
+}
+
+// GongMangBranches returns the two 공망 branches of the 순(旬) that stem/branch belongs to.
+func GongMangBranches(stem, branch int) ([]int, error) {
+	if err := ttycValidateStem(stem); err != nil {
+		return nil, err
+	}
+	if err := ttycValidateBranch(branch); err != nil {
+		return nil, err
+	}
+	if stem%2 != branch%2 {
+		return nil, fmt.Errorf("invalid ganji: stem=%d branch=%d", stem, branch)
+	}
+	start := ttycMod(branch-stem+10, 12)
+	return []int{start, (start + 1) % 12}, nil
+}
+
+// ttycSolarTermIn returns the 절기 whose instant falls in [fromTs, toTs), or nil.
+func ttycSolarTermIn(fromTs, toTs int64, tzOffsetMinutes int) *TtycSolarTerm {
+	from := int(math.Floor(ttycSunLongitudeAt(fromTs) / 15))
+	to := int(math.Floor(ttycSunLongitudeAt(toTs) / 15))
+	if from == to {
+		return nil
+	}
+	index := (from + 1) % 24
+	ts := ttycSolarLongitudeInstant(float64(index*15), fromTs)
+	local, _ := ToLocalDateTimeParts(ts, &tzOffsetMinutes)
+	return &TtycSolarTerm{
+		Index:        index,
+		NameKo:       ttycSolarTermKo[index],
+		NameHanja:    ttycSolarTermHanja[index],
+		Longitude:    index * 15,
+		IsMonthStart: index%2 == 1,
+		Ts:           ts,
+		Local:        local,
+	}
+}
+
+// CalculateAlmanac returns one entry per local date in [From, To] (최대 TtycAlmanacMaxDays 일).
+func CalculateAlmanac(input TtycAlmanacInput) ([]TtycAlmanacDay, error) {
+	tzOffsetMinutes, err := ttycNormalizeTzOffsetMinutes(input.TzOffsetMinutes)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range []TtycSolarDate{input.From, input.To} {
+		if err := CheckYearRange(d.Year); err != nil {
+			return nil, err
+		}
+		if d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > DaysInMonth(d.Year, d.Month) {
+			return nil, fmt.Errorf("invalid date: %04d-%02d-%02d", d.Year, d.Month, d.Day)
+		}
+	}
+	fromDay := ttycCivilDayNumber(input.From.Year, input.From.Month, input.From.Day)
+	toDay := ttycCivilDayNumber(input.To.Year, input.To.Month, input.To.Day)
+	if toDay < fromDay {
+		return nil, fmt.Errorf("invalid almanac range: %04d-%02d-%02d > %04d-%02d-%02d",
+			input.From.Year, input.From.Month, input.From.Day, input.To.Year, input.To.Month, input.To.Day)
+	}
+	if toDay-fromDay+1 > TtycAlmanacMaxDays {
+		return nil, fmt.Errorf("almanac range exceeds %d days", TtycAlmanacMaxDays)
+	}
+
+	offsetMs := int64(tzOffsetMinutes) * MINUTE_MS
+	out := make([]TtycAlmanacDay, 0, toDay-fromDay+1)
+	for day := fromDay; day <= toDay; day++ {
+		date := ttycSolarDateOfDayNumber(day)
+		startTs := day*DAY_MS - offsetMs
+		calc, err := CalculatePillars(TtycPillarCalcInput{
+			Ts:              startTs,
+			TzOffsetMinutes: &tzOffsetMinutes,
+			TimePrecision:   TtycTimePrecisionUnknown,
+		})
+		if err != nil {
+			return nil, err
+		}
+		gongMang, err := GongMangBranches(calc.Pillars.Day.Stem, calc.Pillars.Day.Branch)
+		if err != nil {
+			return nil, err
+		}
+		entry := TtycAlmanacDay{
+			Date:       date,
+			Weekday:    ttycMod(int(day%7)+4, 7), // 1970-01-01 = 목요일
+			DayStartTs: startTs,
+			Year:       ttycToGanji(calc.Pillars.Year),
+			Month:      ttycToGanji(calc.Pillars.Month),
+			Day:        ttycToGanji(calc.Pillars.Day),
+			GongMang:   gongMang,
+			SolarTerm:  ttycSolarTermIn(startTs, startTs+DAY_MS, tzOffsetMinutes),
+		}
+		if lunar, err := SolarToLunar(date.Year, date.Month, date.Day); err == nil {
+			entry.Lunar = &lunar
+		}
+		out = append(out, entry)
+	}
+	return out, nil
+}
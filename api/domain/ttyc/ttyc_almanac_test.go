@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCalculateAlmanac(t *testing.T) {
+	days, err := CalculateAlmanac(TtycAlmanacInput{
+		From: TtycSolarDate{Year: 2024, Month: 1, Day: 1},
+		To:   TtycSolarDate{Year: 2024, Month: 2, Day: 29},
+	})
+	if err != nil {
+		t.Fatalf("CalculateAlmanac() error = %v", err)
+	}
+	if len(days) != 60 {
+		t.Fatalf("len(days) = %d, want 60", len(days))
+	}
+
+	first := days[0]
+	if first.Day.GanjiHanja != "甲子" || first.Month.GanjiHanja != "甲子" || first.Year.GanjiHanja != "癸卯" {
+		t.Fatalf("2024-01-01 pillars = %s %s %s", first.Year.GanjiHanja, first.Month.GanjiHanja, first.Day.GanjiHanja)
+	}
+	if first.Weekday != 1 {
+		t.Fatalf("2024-01-01 weekday = %d, want 1 (월)", first.Weekday)
+	}
+	if len(first.GongMang) != 2 || first.GongMang[0] != 10 || first.GongMang[1] != 11 {
+		t.Fatalf("甲子 공망 = %v, want [10 11] (戌亥)", first.GongMang)
+	}
+	if first.Lunar == nil || first.Lunar.Year != 2023 || first.Lunar.Month != 11 || first.Lunar.Day != 20 {
+		t.Fatalf("2024-01-01 lunar = %+v, want 2023-11-20", first.Lunar)
+	}
+
+	seollal := days[40] // 2024-02-10
+	if seollal.Day.GanjiHanja != "甲辰" || seollal.Lunar == nil || seollal.Lunar.Month != 1 || seollal.Lunar.Day != 1 {
+		t.Fatalf("2024-02-10 = %s lunar %+v", seollal.Day.GanjiHanja, seollal.Lunar)
+	}
+
+	var terms []TtycSolarTerm
+	for _, d := range days {
+		if d.SolarTerm != nil {
+			terms = append(terms, *d.SolarTerm)
+		}
+	}
+	wantTerms := []struct {
+		name       string
+		month, day int
+		monthStart bool
+	}{
+		{"소한", 1, 6, true}, {"대한", 1, 20, false}, {"입춘", 2, 4, true}, {"우수", 2, 19, false},
+	}
+	if len(terms) != len(wantTerms) {
+		t.Fatalf("terms = %+v", terms)
+	}
+	for i, w := range wantTerms {
+		got := terms[i]
+		if got.NameKo != w.name || got.Local.Month != w.month || got.Local.Day != w.day || got.IsMonthStart != w.monthStart {
+			t.Fatalf("term[%d] = %+v, want %+v", i, got, w)
+		}
+	}
+	// 입춘 2024-02-04 17:27 KST: 그날 00:00 기준 월주는 아직 乙丑, 다음 날은 丙寅
+	lichun := days[34]
+	if lichun.SolarTerm.Local.Hour != 17 || lichun.SolarTerm.Local.Minute != 27 {
+		t.Fatalf("입춘 = %+v", lichun.SolarTerm.Local)
+	}
+	if lichun.Month.GanjiHanja != "乙丑" || days[35].Month.GanjiHanja != "丙寅" || days[35].Year.GanjiHanja != "甲辰" {
+		t.Fatalf("입춘 전후 월주 = %s → %s", lichun.Month.GanjiHanja, days[35].Month.GanjiHanja)
+	}
+}
+
+func TestCalculateAlmanac_InvalidRange(t *testing.T) {
+	if _, err := CalculateAlmanac(TtycAlmanacInput{
+		From: TtycSolarDate{Year: 2024, Month: 2, Day: 1},
+		To:   TtycSolarDate{Year: 2024, Month: 1, Day: 1},
+	}); err == nil {
+		t.Fatal("expected error for reversed range")
+	}
+	if _, err := CalculateAlmanac(TtycAlmanacInput{
+		From: TtycSolarDate{Year: 2020, Month: 1, Day: 1},
+		To:   TtycSolarDate{Year: 2024, Month: 1, Day: 1},
+	}); err == nil {
+		t.Fatal("expected error for range over TtycAlmanacMaxDays")
+	}
+	if _, err := CalculateAlmanac(TtycAlmanacInput{
+		From: TtycSolarDate{Year: 10000, Month: 1, Day: 1},
+		To:   TtycSolarDate{Year: 10000, Month: 1, Day: 2},
+	}); !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("err = %v, want ErrOutOfRange", err)
+	}
+}
+
+func TestGongMangBranches(t *testing.T) {
+	for _, tt := range []struct{ stem, branch, want0 int }{
+		{0, 0, 10}, // 甲子순 → 戌亥
+		{0, 10, 8}, // 甲戌순 → 申酉
+		{9, 9, 10}, // 癸酉 (甲子순)
+		{0, 2, 0},  // 甲寅순 → 子丑
+	} {
+		got, err := GongMangBranches(tt.stem, tt.branch)
+		if err != nil || got[0] != tt.want0 || got[1] != (tt.want0+1)%12 {
+			t.Fatalf("GongMangBranches(%d,%d) = %v, %v; want start %d", tt.stem, tt.branch, got, err, tt.want0)
+		}
+	}
+	if _, err := GongMangBranches(0, 1); err == nil {
+		t.Fatal("expected parity error")
+	}
+}
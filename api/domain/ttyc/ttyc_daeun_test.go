@@ -0,0 +1,89 @@
+package domain
+
+import "testing"
+
+func TestCalculateDaeunStart(t *testing.T) {
+	tz := 9 * 60
+	// 2024 입춘(02-04 17:27 KST) 1시간 뒤 출생 - 甲辰년(양년)
+	birthTs := ttycMonthTermStartTs(2024, 2) + 60*MINUTE_MS
+	birth, err := CalculatePillars(TtycPillarCalcInput{Ts: birthTs, TzOffsetMinutes: &tz})
+	if err != nil {
+		t.Fatalf("CalculatePillars() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		sex        TtycSex
+		convention TtycDaeunConvention
+		forward    bool
+		termTs     int64
+		years      int
+		months     int
+		startTs    int64
+	}{
+		// 순행: 경칩(03-05 11:23)까지 29일 16시간여 → 9년 10개월
+		{name: "남 순행 EXACT", sex: TtycSexM, forward: true, termTs: ttycMonthTermStartTs(2024, 3), years: 9, months: 10},
+		{name: "남 순행 ROUNDED", sex: TtycSexM, convention: TtycDaeunConventionRounded, forward: true, termTs: ttycMonthTermStartTs(2024, 3), years: 10},
+		// 역행: 입춘까지 1시간 → 120시간(5일) 뒤 시작
+		{name: "여 역행 EXACT", sex: TtycSexF, forward: false, termTs: ttycMonthTermStartTs(2024, 2), startTs: birthTs + 5*DAY_MS},
+		{name: "여 역행 ROUNDED 최소 1년", sex: TtycSexF, convention: TtycDaeunConventionRounded, forward: false, termTs: ttycMonthTermStartTs(2024, 2), years: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CalculateDaeunStart(birth, tt.sex, tt.convention)
+			if err != nil {
+				t.Fatalf("CalculateDaeunStart() error = %v", err)
+			}
+			if got.Forward != tt.forward || got.TermTs != tt.termTs || got.Years != tt.years || got.Months != tt.months {
+				t.Fatalf("got %+v, want forward=%v term=%d %d년 %d개월", got, tt.forward, tt.termTs, tt.years, tt.months)
+			}
+			if tt.startTs != 0 && got.StartTs != tt.startTs {
+				t.Fatalf("StartTs = %d, want %d", got.StartTs, tt.startTs)
+			}
+		})
+	}
+
+	if _, err := CalculateDaeunStart(birth, TtycSexM, "YEARLY"); err == nil {
+		t.Fatalf("expected error for invalid convention")
+	}
+}
+
+func TestCalculateFortuneFlow_DaeunByStartTs(t *testing.T) {
+	tz := 9 * 60
+	birthTs := ttycMonthTermStartTs(2024, 2) + 60*MINUTE_MS
+	birth, err := CalculatePillars(TtycPillarCalcInput{Ts: birthTs, TzOffsetMinutes: &tz})
+	if err != nil {
+		t.Fatalf("CalculatePillars() error = %v", err)
+	}
+	flow, err := CalculateFortuneFlow(birth, TtycSexM, nil)
+	if err != nil {
+		t.Fatalf("CalculateFortuneFlow() error = %v", err)
+	}
+	first := flow.DaeunList[0]
+	if first.AgeFrom != 9 || first.StartAgeYears != 9 || first.StartAgeMonths != 10 || first.Convention != TtycDaeunConventionExact {
+		t.Fatalf("first daeun = %+v", first)
+	}
+	second := flow.DaeunList[1]
+	if second.AgeFrom != 19 || second.StartTs != first.EndTs || second.StartYear != first.StartYear+10 {
+		t.Fatalf("second daeun = %+v (first end %d)", second, first.EndTs)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		ts    int64
+		order int
+	}{
+		{name: "1대운 시작 전", ts: first.StartTs - MINUTE_MS, order: 1},
+		{name: "2대운 시작 직전", ts: second.StartTs - MINUTE_MS, order: 1},
+		{name: "2대운 시작", ts: second.StartTs, order: 2},
+	} {
+		baseTs := tt.ts
+		flow, err := CalculateFortuneFlow(birth, TtycSexM, &TtycFortuneRequest{BaseTs: &baseTs})
+		if err != nil {
+			t.Fatalf("%s: CalculateFortuneFlow() error = %v", tt.name, err)
+		}
+		if flow.Daeun == nil || flow.Daeun.Order != tt.order {
+			t.Fatalf("%s: daeun = %+v, want order %d", tt.name, flow.Daeun, tt.order)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// 음력(한국 태음태양력) ↔ 양력 변환: 1900~2100 음력년.
+// 매월 초하루는 한국 표준시 기준 합삭(new moon)일, 동지가 든 달이 11월, 두 동지 사이 13개월이면
+// 첫 번째 중기(中氣, 태양 황경 30° 배수) 없는 달이 윤달.
+
+const (
+	TtycLunarMinYear = 1900
+	TtycLunarMaxYear = 2100
+
+	ttycSynodicMonth = 29.530588861
+)
+
+var (
+	ErrLunarOutOfRange  = fmt.Errorf("%w: lunar calendar supports years 1900-2100", ErrOutOfRange)
+	ErrInvalidLunarDate = errors.New("invalid lunar date")
+)
+
+type TtycLunarDate struct {
+	Year        int  `json:"year"`
+	Month       int  `json:"month"`
+	Day         int  `json:"day"`
+	IsLeapMonth bool `json:"isLeapMonth"`
+}
+
+type TtycSolarDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+type ttycLunarMonth struct {
+	Month    int
+	Leap     bool
+	StartDay int64 // 초하루 (1970-01-01 기준 일수)
+	Days     int
+}
+
+// ttycKoreaStandardOffsetMinutes: 한국 표준시 변천 - 1912 이전·1954-03-21~1961-08-09 는 UTC+8:30, 그 외 UTC+9
+func ttycKoreaStandardOffsetMinutes(ts int64) int {
+	switch {
+	case ts < ttycKoreaTs(1912, 1, 1):
+		return 510
+	case ts >= ttycKoreaTs(1954, 3, 21) && ts < ttycKoreaTs(1961, 8, 10):
+		return 510
+	default:
+		return KST_OFFSET_MINUTES
+	}
+}
+
+func ttycKoreaTs(year, month, day int) int64 {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.FixedZone("KST", KST_OFFSET_MINUTES*60)).UnixMilli()
+}
+
+func ttycKoreaDayNumber(ts int64) int64 {
+	return ttycFloorDiv(ts+int64(ttycKoreaStandardOffsetMinutes(ts))*MINUTE_MS, DAY_MS)
+}
+
+func ttycCivilDayNumber(year, month, day int) int64 {
+	return ttycFloorDiv(time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).UnixMilli(), DAY_MS)
+}
+
+func ttycSolarDateOfDayNumber(day int64) TtycSolarDate {
+	t := time.UnixMilli(day * DAY_MS).UTC()
+	return TtycSolarDate{Year: t.Year(), Month: int(t.Month()), Day: t.Day()}
+}
+
+// ttycNewMoonJDE: k 번째 합삭의 JDE (k=0 → 2000-01-06), Meeus 49장
+func ttycNewMoonJDE(k float64) float64 {
+	const rad = math.Pi / 180
+	t := k / 1236.85
+	jde := 2451550.09766 + ttycSynodicMonth*k + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := (2.5534 + 29.10535670*k - 0.0000014*t*t - 0.00000011*t*t*t) * rad
+	mp := (201.5643 + 385.81693528*k + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t) * rad
+	f := (160.7108 + 390.67050284*k - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t) * rad
+	omega := (124.7746 - 1.56375588*k + 0.0020672*t*t + 0.00000215*t*t*t) * rad
+
+	jde += -0.40720*math.Sin(mp) +
+		0.17241*e*math.Sin(m) +
+		0.01608*math.Sin(2*mp) +
+		0.01039*math.Sin(2*f) +
+		0.00739*e*math.Sin(mp-m) -
+		0.00514*e*math.Sin(mp+m) +
+		0.00208*e*e*math.Sin(2*m) -
+		0.00111*math.Sin(mp-2*f) -
+		0.00057*math.Sin(mp+2*f) +
+		0.00056*e*math.Sin(2*mp+m) -
+		0.00042*math.Sin(3*mp) +
+		0.00042*e*math.Sin(m+2*f) +
+		0.00038*e*math.Sin(m-2*f) -
+		0.00024*e*math.Sin(2*mp-m) -
+		0.00017*math.Sin(omega) -
+		0.00007*math.Sin(mp+2*m) +
+		0.00004*math.Sin(2*mp-2*f) +
+		0.00004*math.Sin(3*m) +
+		0.00003*math.Sin(mp+m-2*f) +
+		0.00003*math.Sin(2*mp+2*f) -
+		0.00003*math.Sin(mp+m+2*f) +
+		0.00003*math.Sin(mp-m+2*f) -
+		0.00002*math.Sin(mp-m-2*f) -
+		0.00002*math.Sin(3*mp+m) +
+		0.00002*math.Sin(4*mp)
+
+	// 행성 섭동 보정
+	planetary := [...][3]float64{
+		{0.000325, 299.77, 0.107408}, {0.000165, 251.88, 0.016321}, {0.000164, 251.83, 26.651886},
+		{0.000126, 349.42, 36.412478}, {0.000110, 84.66, 18.206239}, {0.000062, 141.74, 53.303771},
+		{0.000060, 207.14, 2.453732}, {0.000056, 154.84, 7.306860}, {0.000047, 34.52, 27.261239},
+		{0.000042, 207.19, 0.121824}, {0.000040, 291.34, 1.844379}, {0.000037, 161.72, 24.198154},
+		{0.000035, 239.56, 25.513099}, {0.000023, 331.55, 3.592518},
+	}
+	for i, p := range planetary {
+		arg := p[1] + p[2]*k
+		if i == 0 {
+			arg -= 0.009173 * t * t
+		}
+		jde += p[0] * math.Sin(arg*rad)
+	}
+	return jde
+}
+
+// ttycNewMoonTs returns the UTC instant (ms) of new moon k.
+func ttycNewMoonTs(k int) int64 {
+	jde := ttycNewMoonJDE(float64(k))
+	decimalYear := 2000 + (jde-ttycJ2000JD)/365.25
+	return ttycJDToUnixMs(jde - ttycDeltaTSeconds(decimalYear)/86400)
+}
+
+func ttycNewMoonDay(k int) int64 {
+	return ttycKoreaDayNumber(ttycNewMoonTs(k))
+}
+
+// ttycNewMoonOnOrBefore returns k of the lunar month containing Korean local day.
+func ttycNewMoonOnOrBefore(day int64) int {
+	jd := float64(day) + ttycUnixEpochJD
+	k := int(math.Floor((jd - 2451550.09766) / ttycSynodicMonth))
+	for ttycNewMoonDay(k+1) <= day {
+		k++
+	}
+	for ttycNewMoonDay(k) > day {
+		k--
+	}
+	return k
+}
+
+func ttycSunLongitudeAt(ts int64) float64 {
+	jd := ttycUnixMsToJD(ts)
+	decimalYear := 2000 + (jd-ttycJ2000JD)/365.25
+	return ttycApparentSunLongitude(jd + ttycDeltaTSeconds(decimalYear)/86400)
+}
+
+func ttycKoreaDayStartTs(day int64) int64 {
+	ts := day * DAY_MS
+	return ts - int64(ttycKoreaStandardOffsetMinutes(ts))*MINUTE_MS
+}
+
+// ttycHasPrincipalTerm: [startDay, endDay) 에 중기(황경 30° 배수)가 드는지
+func ttycHasPrincipalTerm(startDay, endDay int64) bool {
+	from := int(ttycSunLongitudeAt(ttycKoreaDayStartTs(startDay)) / 30)
+	to := int(ttycSunLongitudeAt(ttycKoreaDayStartTs(endDay)) / 30)
+	return from != to
+}
+
+// ttycLunarSuiMonths: 동지(year-1) 가 든 11월부터 동지(year) 가 든 11월 직전까지의 달 (12 또는 13개)
+func ttycLunarSuiMonths(year int) []ttycLunarMonth {
+	solsticeDay := func(y int) int64 {
+		guess := time.Date(y, time.December, 21, 0, 0, 0, 0, time.UTC).UnixMilli()
+		return ttycKoreaDayNumber(ttycSolarLongitudeInstant(270, guess))
+	}
+	kFrom := ttycNewMoonOnOrBefore(solsticeDay(year - 1))
+	kTo := ttycNewMoonOnOrBefore(solsticeDay(year))
+
+	leapIdx := -1
+	if kTo-kFrom == 13 {
+		for i := 1; i < 13; i++ {
+			if !ttycHasPrincipalTerm(ttycNewMoonDay(kFrom+i), ttycNewMoonDay(kFrom+i+1)) {
+				leapIdx = i
+				break
+			}
+		}
+	}
+
+	out := make([]ttycLunarMonth, 0, kTo-kFrom)
+	num := 11
+	for i := 0; i < kTo-kFrom; i++ {
+		leap := i == leapIdx
+		if i > 0 && !leap {
+			num = num%12 + 1
+		}
+		start := ttycNewMoonDay(kFrom + i)
+		out = append(out, ttycLunarMonth{
+			Month:    num,
+			Leap:     leap,
+			StartDay: start,
+			Days:     int(ttycNewMoonDay(kFrom+i+1) - start),
+		})
+	}
+	return out
+}
+
+var ttycLunarYearCache sync.Map // year → []ttycLunarMonth
+
+// ttycLunarYearMonths returns months 1..12 (and the leap month) of lunar year, in order.
+func ttycLunarYearMonths(year int) []ttycLunarMonth {
+	if v, ok := ttycLunarYearCache.Load(year); ok {
+		return v.([]ttycLunarMonth)
+	}
+	firstMonthIdx := func(months []ttycLunarMonth) int {
+		for i, m := range months {
+			if m.Month == 1 && !m.Leap {
+				return i
+			}
+		}
+		return len(months)
+	}
+	cur := ttycLunarSuiMonths(year)
+	next := ttycLunarSuiMonths(year + 1)
+	out := make([]ttycLunarMonth, 0, 13)
+	out = append(out, cur[firstMonthIdx(cur):]...)
+	out = append(out, next[:firstMonthIdx(next)]...)
+	ttycLunarYearCache.Store(year, out)
+	return out
+}
+
+// LunarToSolar converts a Korean lunar date (isLeapMonth for 윤달) to the solar date.
+func LunarToSolar(year, month, day int, isLeapMonth bool) (TtycSolarDate, error) {
+	if year < TtycLunarMinYear || year > TtycLunarMaxYear {
+		return TtycSolarDate{}, fmt.Errorf("%w: %d", ErrLunarOutOfRange, year)
+	}
+	if month < 1 || month > 12 || day < 1 || day > 30 {
+		return TtycSolarDate{}, fmt.Errorf("%w: %d-%02d-%02d", ErrInvalidLunarDate, year, month, day)
+	}
+	for _, m := range ttycLunarYearMonths(year) {
+		if m.Month != month || m.Leap != isLeapMonth {
+			continue
+		}
+		if day > m.Days {
+			return TtycSolarDate{}, fmt.Errorf("%w: %d-%02d has %d days", ErrInvalidLunarDate, year, month, m.Days)
+		}
+		return ttycSolarDateOfDayNumber(m.StartDay + int64(day-1)), nil
+	}
+	if isLeapMonth {
+		return TtycSolarDate{}, fmt.Errorf("%w: no leap month %d in %d", ErrInvalidLunarDate, month, year)
+	}
+	return TtycSolarDate{}, fmt.Errorf("%w: %d-%02d", ErrInvalidLunarDate, year, month)
+}
+
+// SolarToLunar converts a solar date to the Korean lunar date.
+func SolarToLunar(year, month, day int) (TtycLunarDate, error) {
+	if month < 1 || month > 12 || day < 1 || day > DaysInMonth(year, month) {
+		return TtycLunarDate{}, fmt.Errorf("invalid solar date: %d-%02d-%02d", year, month, day)
+	}
+	target := ttycCivilDayNumber(year, month, day)
+	for _, lunarYear := range []int{year, year - 1} {
+		if lunarYear < TtycLunarMinYear || lunarYear > TtycLunarMaxYear {
+			continue
+		}
+		months := ttycLunarYearMonths(lunarYear)
+		if target < months[0].StartDay {
+			continue
+		}
+		for _, m := range months {
+			if target < m.StartDay+int64(m.Days) {
+				return TtycLunarDate{
+					Year:        lunarYear,
+					Month:       m.Month,
+					Day:         int(target-m.StartDay) + 1,
+					IsLeapMonth: m.Leap,
+				}, nil
+			}
+		}
+	}
+	return TtycLunarDate{}, fmt.Errorf("%w: %d-%02d-%02d", ErrLunarOutOfRange, year, month, day)
+}
+
+// LeapMonthOf returns the 윤달 number of lunar year (0 when none).
+func LeapMonthOf(year int) (int, error) {
+	if year < TtycLunarMinYear || year > TtycLunarMaxYear {
+		return 0, fmt.Errorf("%w: %d", ErrLunarOutOfRange, year)
+	}
+	for _, m := range ttycLunarYearMonths(year) {
+		if m.Leap {
+			return m.Month, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLunarToSolar_KnownDates(t *testing.T) {
+	tests := []struct {
+		name                string
+		year, month, day    int
+		leap                bool
+		wantY, wantM, wantD int
+	}{
+		{name: "1900 설날", year: 1900, month: 1, day: 1, wantY: 1900, wantM: 1, wantD: 31},
+		{name: "1990 설날", year: 1990, month: 1, day: 1, wantY: 1990, wantM: 1, wantD: 27},
+		{name: "2000 설날", year: 2000, month: 1, day: 1, wantY: 2000, wantM: 2, wantD: 5},
+		{name: "2017 설날", year: 2017, month: 1, day: 1, wantY: 2017, wantM: 1, wantD: 28},
+		{name: "2020 설날", year: 2020, month: 1, day: 1, wantY: 2020, wantM: 1, wantD: 25},
+		{name: "2023 설날", year: 2023, month: 1, day: 1, wantY: 2023, wantM: 1, wantD: 22},
+		{name: "2024 설날", year: 2024, month: 1, day: 1, wantY: 2024, wantM: 2, wantD: 10},
+		{name: "2024 추석", year: 2024, month: 8, day: 15, wantY: 2024, wantM: 9, wantD: 17},
+		{name: "2023 윤2월 초하루", year: 2023, month: 2, day: 1, leap: true, wantY: 2023, wantM: 3, wantD: 22},
+		{name: "2020 윤4월 초하루", year: 2020, month: 4, day: 1, leap: true, wantY: 2020, wantM: 5, wantD: 23},
+		{name: "2020 평4월 초하루", year: 2020, month: 4, day: 1, wantY: 2020, wantM: 4, wantD: 23},
+		{name: "2100 설날", year: 2100, month: 1, day: 1, wantY: 2100, wantM: 2, wantD: 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LunarToSolar(tt.year, tt.month, tt.day, tt.leap)
+			if err != nil {
+				t.Fatalf("LunarToSolar() error = %v", err)
+			}
+			if got.Year != tt.wantY || got.Month != tt.wantM || got.Day != tt.wantD {
+				t.Fatalf("LunarToSolar() = %+v, want %04d-%02d-%02d", got, tt.wantY, tt.wantM, tt.wantD)
+			}
+		})
+	}
+}
+
+func TestLeapMonthOf(t *testing.T) {
+	for year, want := range map[int]int{2014: 9, 2017: 5, 2020: 4, 2023: 2, 2024: 0, 2025: 6, 2033: 11} {
+		got, err := LeapMonthOf(year)
+		if err != nil {
+			t.Fatalf("LeapMonthOf(%d) error = %v", year, err)
+		}
+		if got != want {
+			t.Fatalf("LeapMonthOf(%d) = %d, want %d", year, got, want)
+		}
+	}
+}
+
+func TestSolarToLunar_RoundTrip(t *testing.T) {
+	start := ttycCivilDayNumber(1900, 1, 31)
+	end := ttycCivilDayNumber(2101, 1, 28)
+	for day := start; day <= end; day += 7 {
+		solar := ttycSolarDateOfDayNumber(day)
+		lunar, err := SolarToLunar(solar.Year, solar.Month, solar.Day)
+		if err != nil {
+			t.Fatalf("SolarToLunar(%+v) error = %v", solar, err)
+		}
+		back, err := LunarToSolar(lunar.Year, lunar.Month, lunar.Day, lunar.IsLeapMonth)
+		if err != nil {
+			t.Fatalf("LunarToSolar(%+v) error = %v", lunar, err)
+		}
+		if back != solar {
+			t.Fatalf("round trip %+v → %+v → %+v", solar, lunar, back)
+		}
+	}
+}
+
+func TestLunarToSolar_Errors(t *testing.T) {
+	if _, err := LunarToSolar(1899, 12, 1, false); !errors.Is(err, ErrLunarOutOfRange) {
+		t.Fatalf("1899: err = %v, want ErrLunarOutOfRange", err)
+	}
+	if _, err := LunarToSolar(2101, 1, 1, false); !errors.Is(err, ErrLunarOutOfRange) {
+		t.Fatalf("2101: err = %v, want ErrLunarOutOfRange", err)
+	}
+	if _, err := LunarToSolar(2024, 3, 1, true); !errors.Is(err, ErrInvalidLunarDate) {
+		t.Fatalf("2024 윤3월: err = %v, want ErrInvalidLunarDate", err)
+	}
+	if _, err := LunarToSolar(2024, 13, 1, false); !errors.Is(err, ErrInvalidLunarDate) {
+		t.Fatalf("13월: err = %v, want ErrInvalidLunarDate", err)
+	}
+	if _, err := SolarToLunar(1900, 1, 30); !errors.Is(err, ErrLunarOutOfRange) {
+		t.Fatalf("1900-01-30: err = %v, want ErrLunarOutOfRange", err)
+	}
+}
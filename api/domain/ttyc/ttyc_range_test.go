@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckYearRange(t *testing.T) {
+	for _, year := range []int{TtycMinYear, 1800, 2200, TtycMaxYear} {
+		if err := CheckYearRange(year); err != nil {
+			t.Fatalf("CheckYearRange(%d) error = %v", year, err)
+		}
+	}
+	for _, year := range []int{-1, 0, 10000} {
+		if err := CheckYearRange(year); !errors.Is(err, ErrOutOfRange) {
+			t.Fatalf("CheckYearRange(%d) = %v, want ErrOutOfRange", year, err)
+		}
+	}
+	if !errors.Is(ErrLunarOutOfRange, ErrOutOfRange) {
+		t.Fatalf("ErrLunarOutOfRange should wrap ErrOutOfRange")
+	}
+}
+
+func TestCalculatePillars_ExtendedRange(t *testing.T) {
+	tz := 9 * 60
+	tests := []struct {
+		name      string
+		parts     TtycTimestampParts
+		yearGanji string
+	}{
+		{name: "서기 1년 辛酉", parts: TtycTimestampParts{Year: 1, Month: 3, Day: 1, Hour: 12}, yearGanji: "신유"},
+		{name: "서기 1000년 庚子", parts: TtycTimestampParts{Year: 1000, Month: 3, Day: 1, Hour: 12}, yearGanji: "경자"},
+		{name: "2500년 庚子", parts: TtycTimestampParts{Year: 2500, Month: 3, Day: 1, Hour: 12}, yearGanji: "경자"},
+		{name: "9999년 己亥", parts: TtycTimestampParts{Year: 9999, Month: 3, Day: 1, Hour: 12}, yearGanji: "기해"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := ToUnixTimestamp(tt.parts, &tz)
+			if err != nil {
+				t.Fatalf("ToUnixTimestamp() error = %v", err)
+			}
+			got, err := CalculatePillars(TtycPillarCalcInput{Ts: ts, TzOffsetMinutes: &tz})
+			if err != nil {
+				t.Fatalf("CalculatePillars() error = %v", err)
+			}
+			if got.Pillars.Year.GanjiKo != tt.yearGanji {
+				t.Fatalf("year = %s, want %s", got.Pillars.Year.GanjiKo, tt.yearGanji)
+			}
+			if got.Pillars.Month.Branch != 2 {
+				t.Fatalf("month branch = %d, want 寅(2)", got.Pillars.Month.Branch)
+			}
+			if got.Boundaries.LichunStartTs >= ts || ts-got.Boundaries.LichunStartTs > 40*DAY_MS {
+				t.Fatalf("입춘 %d not within 40 days before %d", got.Boundaries.LichunStartTs, ts)
+			}
+		})
+	}
+
+	// 다음날 일주는 60갑자 한 칸씩 진행 (범위 양 끝)
+	for _, parts := range []TtycTimestampParts{{Year: 1, Month: 1, Day: 1, Hour: 12}, {Year: 9999, Month: 12, Day: 30, Hour: 12}} {
+		ts, _ := ToUnixTimestamp(parts, &tz)
+		a, err := CalculatePillars(TtycPillarCalcInput{Ts: ts, TzOffsetMinutes: &tz})
+		if err != nil {
+			t.Fatalf("CalculatePillars(%+v) error = %v", parts, err)
+		}
+		b, err := CalculatePillars(TtycPillarCalcInput{Ts: ts + DAY_MS, TzOffsetMinutes: &tz})
+		if err != nil {
+			t.Fatalf("CalculatePillars(%+v +1d) error = %v", parts, err)
+		}
+		if ttycMod(b.Pillars.Day.Stem-a.Pillars.Day.Stem, 10) != 1 || ttycMod(b.Pillars.Day.Branch-a.Pillars.Day.Branch, 12) != 1 {
+			t.Fatalf("day pillars %s -> %s", a.Pillars.Day.GanjiKo, b.Pillars.Day.GanjiKo)
+		}
+	}
+}
+
+func TestCalculatePillars_OutOfRange(t *testing.T) {
+	tz := 9 * 60
+	for _, parts := range []TtycTimestampParts{
+		{Year: 0, Month: 12, Day: 31, Hour: 12},
+		{Year: 10000, Month: 1, Day: 1, Hour: 12},
+	} {
+		ts, err := ToUnixTimestamp(parts, &tz)
+		if err != nil {
+			t.Fatalf("ToUnixTimestamp() error = %v", err)
+		}
+		if _, err := CalculatePillars(TtycPillarCalcInput{Ts: ts, TzOffsetMinutes: &tz}); !errors.Is(err, ErrOutOfRange) {
+			t.Fatalf("CalculatePillars(%d) error = %v, want ErrOutOfRange", parts.Year, err)
+		}
+	}
+}
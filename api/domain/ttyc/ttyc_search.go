@@ -0,0 +1,317 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 역방향 조회: 사주 패턴(부분 허용) → 해당 명식이 성립하는 정확한 시간 구간.
+// 연·월주는 절입 시각, 일주는 일 경계(자시 유파), 시주는 홀수 정시에서 바뀌므로 그 경계들로 구간을 자른다.
+
+const (
+	TtycSearchDefaultLimit  = 1000
+	TtycSearchMaxLimit      = 10000
+	TtycSearchMaxRangeYears = 200 // 1회 조회 최대 범위 (일 단위 스캔 상한)
+)
+
+// TtycGanjiPattern: nil 필드는 임의 값. Stem 만 두면 "천간만 일치" 조건.
+type TtycGanjiPattern struct {
+	Stem   *int `json:"stem,omitempty"`
+	Branch *int `json:"branch,omitempty"`
+}
+
+// TtycPillarPattern: nil 기둥은 조건 없음. Hour 가 nil 이면 일 단위 구간을 돌려준다.
+type TtycPillarPattern struct {
+	Year  *TtycGanjiPattern `json:"year,omitempty"`
+	Month *TtycGanjiPattern `json:"month,omitempty"`
+	Day   *TtycGanjiPattern `json:"day,omitempty"`
+	Hour  *TtycGanjiPattern `json:"hour,omitempty"`
+}
+
+type TtycSearchRange struct {
+	FromTs          int64               `json:"fromTs"`
+	ToTs            int64               `json:"toTs"` // exclusive
+	TzOffsetMinutes *int                `json:"tzOffsetMinutes,omitempty"`
+	ZishiConvention TtycZishiConvention `json:"zishiConvention,omitempty"`
+	Limit           int                 `json:"limit,omitempty"` // 기본 1000, 최대 10000
+}
+
+type TtycTimeWindow struct {
+	StartTs int64                  `json:"startTs"`
+	EndTs   int64                  `json:"endTs"` // exclusive
+	Start   TtycLocalDateTimeParts `json:"start"`
+	End     TtycLocalDateTimeParts `json:"end"`
+	Pillars TtycPillars            `json:"pillars"`
+}
+
+type TtycSearchResult struct {
+	Windows   []TtycTimeWindow `json:"windows"`
+	Truncated bool             `json:"truncated"`
+}
+
+// ParseGanjiPattern parses one pillar pattern: "甲子", "갑자", "甲*", "*子", "*" (또는 빈 문자열 → nil).
+func ParseGanjiPattern(v string) (*TtycGanjiPattern, error) {
+	runes := []rune(strings.TrimSpace(v))
+	if len(runes) == 0 || (len(runes) == 1 && runes[0] == '*') {
+		return nil, nil
+	}
+	if len(runes) != 2 {
+		return nil, fmt.Errorf("invalid ganji pattern: %q", v)
+	}
+	out := &TtycGanjiPattern{}
+	if runes[0] != '*' {
+		stem := ttycIndexOf(string(runes[0]), ttycStemHanja[:], ttycStemKo[:])
+		if stem < 0 {
+			return nil, fmt.Errorf("invalid stem in pattern: %q", v)
+		}
+		out.Stem = &stem
+	}
+	if runes[1] != '*' {
+		branch := ttycIndexOf(string(runes[1]), ttycBranchHanja[:], ttycBranchKo[:])
+		if branch < 0 {
+			return nil, fmt.Errorf("invalid branch in pattern: %q", v)
+		}
+		out.Branch = &branch
+	}
+	if out.Stem != nil && out.Branch != nil && *out.Stem%2 != *out.Branch%2 {
+		return nil, fmt.Errorf("invalid ganji pattern (parity mismatch): %q", v)
+	}
+	return out, nil
+}
+
+// ParsePillarPattern parses "연/월/일[/시]" (공백 구분도 허용), 예: "甲子/丙寅/戊辰/庚午", "*/*/壬戌".
+func ParsePillarPattern(v string) (TtycPillarPattern, error) {
+	parts := strings.FieldsFunc(v, func(r rune) bool { return r == '/' || r == ' ' || r == ',' })
+	if len(parts) < 3 || len(parts) > 4 {
+		return TtycPillarPattern{}, fmt.Errorf("pillar pattern needs 3 or 4 pillars: %q", v)
+	}
+	var out TtycPillarPattern
+	targets := []**TtycGanjiPattern{&out.Year, &out.Month, &out.Day, &out.Hour}
+	for i, p := range parts {
+		g, err := ParseGanjiPattern(p)
+		if err != nil {
+			return TtycPillarPattern{}, err
+		}
+		*targets[i] = g
+	}
+	return out, nil
+}
+
+func ttycIndexOf(v string, tables ...[]string) int {
+	for _, table := range tables {
+		for i, s := range table {
+			if s == v {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (p *TtycGanjiPattern) matches(meta TtycGanjiMeta) bool {
+	if p == nil {
+		return true
+	}
+	return (p.Stem == nil || *p.Stem == meta.Stem) && (p.Branch == nil || *p.Branch == meta.Branch)
+}
+
+func (p *TtycGanjiPattern) validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Stem != nil {
+		if err := ttycValidateStem(*p.Stem); err != nil {
+			return err
+		}
+	}
+	if p.Branch != nil {
+		if err := ttycValidateBranch(*p.Branch); err != nil {
+			return err
+		}
+	}
+	if p.Stem != nil && p.Branch != nil && *p.Stem%2 != *p.Branch%2 {
+		return fmt.Errorf("invalid ganji pattern (parity mismatch): %d/%d", *p.Stem, *p.Branch)
+	}
+	return nil
+}
+
+// FindDatetimes returns the time windows in [FromTs, ToTs) whose chart matches pattern, in order.
+// Windows split wherever any computed pillar changes; Truncated is set when Limit was reached.
+// The range is capped at TtycSearchMaxRangeYears and the scan stops with ctx's error once ctx is done.
+func FindDatetimes(ctx context.Context, pattern TtycPillarPattern, rng TtycSearchRange) (TtycSearchResult, error) {
+	for _, p := range []*TtycGanjiPattern{pattern.Year, pattern.Month, pattern.Day, pattern.Hour} {
+		if err := p.validate(); err != nil {
+			return TtycSearchResult{}, err
+		}
+	}
+	tzOffsetMinutes, err := ttycNormalizeTzOffsetMinutes(rng.TzOffsetMinutes)
+	if err != nil {
+		return TtycSearchResult{}, err
+	}
+	zishi, err := ttycNormalizeZishiConvention(rng.ZishiConvention)
+	if err != nil {
+		return TtycSearchResult{}, err
+	}
+	if rng.ToTs <= rng.FromTs {
+		return TtycSearchResult{}, fmt.Errorf("search range is empty: %d..%d", rng.FromTs, rng.ToTs)
+	}
+	if rng.ToTs-rng.FromTs > int64(TtycSearchMaxRangeYears)*366*DAY_MS {
+		return TtycSearchResult{}, fmt.Errorf("search range exceeds %d years", TtycSearchMaxRangeYears)
+	}
+	limit := rng.Limit
+	if limit <= 0 {
+		limit = TtycSearchDefaultLimit
+	}
+	if limit > TtycSearchMaxLimit {
+		limit = TtycSearchMaxLimit
+	}
+	precision := TtycTimePrecisionUnknown
+	if pattern.Hour != nil {
+		precision = TtycTimePrecisionMinute
+	}
+	calcAt := func(ts int64) (TtycPillarCalcResult, error) {
+		return CalculatePillars(TtycPillarCalcInput{
+			Ts:              ts,
+			TzOffsetMinutes: &tzOffsetMinutes,
+			TimePrecision:   precision,
+			ZishiConvention: zishi,
+		})
+	}
+
+	out := TtycSearchResult{Windows: []TtycTimeWindow{}}
+	var open *TtycTimeWindow
+	// emit 은 인접·동일 명식 구간을 이어 붙이고, 불일치(nil)면 열린 구간을 닫는다. limit 도달 시 false.
+	emit := func(seg [2]int64, pillars *TtycPillars) bool {
+		if pillars != nil && open != nil && open.EndTs == seg[0] && ttycSamePillars(open.Pillars, *pillars) {
+			open.EndTs = seg[1]
+			return true
+		}
+		if open != nil {
+			if len(out.Windows) >= limit {
+				out.Truncated = true
+				return false
+			}
+			out.Windows = append(out.Windows, *open)
+			open = nil
+		}
+		if pillars != nil {
+			open = &TtycTimeWindow{StartTs: seg[0], EndTs: seg[1], Pillars: *pillars}
+		}
+		return true
+	}
+
+	// 일주 단위로 진행: 연·월·일이 맞는 구간만 시주 경계로 다시 나눈다.
+	cursor := rng.FromTs
+	for cursor < rng.ToTs {
+		if err := ctx.Err(); err != nil {
+			return TtycSearchResult{}, err
+		}
+		head, err := calcAt(cursor)
+		if err != nil {
+			return TtycSearchResult{}, err
+		}
+		dayEnd := head.Boundaries.DayStartTs + DAY_MS
+		if dayEnd > rng.ToTs {
+			dayEnd = rng.ToTs
+		}
+		if !pattern.Day.matches(head.Pillars.Day) {
+			if !emit([2]int64{cursor, dayEnd}, nil) {
+				return out, nil
+			}
+			cursor = dayEnd
+			continue
+		}
+		for _, seg := range ttycSearchTermSegments(cursor, dayEnd, tzOffsetMinutes) {
+			calc := head
+			if seg[0] != cursor {
+				if calc, err = calcAt(seg[0]); err != nil {
+					return TtycSearchResult{}, err
+				}
+			}
+			if !pattern.Year.matches(calc.Pillars.Year) || !pattern.Month.matches(calc.Pillars.Month) {
+				if !emit(seg, nil) {
+					return out, nil
+				}
+				continue
+			}
+			if pattern.Hour == nil {
+				if !emit(seg, &calc.Pillars) {
+					return out, nil
+				}
+				continue
+			}
+			for _, hourSeg := range ttycSearchHourSegments(seg[0], seg[1], tzOffsetMinutes) {
+				hourCalc := calc
+				if hourSeg[0] != seg[0] {
+					if hourCalc, err = calcAt(hourSeg[0]); err != nil {
+						return TtycSearchResult{}, err
+					}
+				}
+				var pillars *TtycPillars
+				if pattern.Hour.matches(*hourCalc.Pillars.Hour) {
+					pillars = &hourCalc.Pillars
+				}
+				if !emit(hourSeg, pillars) {
+					return out, nil
+				}
+			}
+		}
+		cursor = dayEnd
+	}
+	if !emit([2]int64{rng.ToTs, rng.ToTs}, nil) {
+		return out, nil
+	}
+	for i := range out.Windows {
+		w := &out.Windows[i]
+		w.Start, _ = ToLocalDateTimeParts(w.StartTs, &tzOffsetMinutes)
+		w.End, _ = ToLocalDateTimeParts(w.EndTs, &tzOffsetMinutes)
+	}
+	return out, nil
+}
+
+func ttycSamePillars(a, b TtycPillars) bool {
+	if a.Year != b.Year || a.Month != b.Month || a.Day != b.Day || (a.Hour == nil) != (b.Hour == nil) {
+		return false
+	}
+	return a.Hour == nil || *a.Hour == *b.Hour
+}
+
+// ttycSearchTermSegments splits [from, to) (한 일주 구간 안) at 절입 시각 (연·월주 경계).
+func ttycSearchTermSegments(from, to int64, tzOffsetMinutes int) [][2]int64 {
+	cuts := []int64{from, to}
+	if local, err := ToLocalDateTimeParts(from, &tzOffsetMinutes); err == nil {
+		for _, b := range ttycBuildMonthBranchBoundaries(local.Year) {
+			if b.StartTs > from && b.StartTs < to {
+				cuts = append(cuts, b.StartTs)
+			}
+		}
+	}
+	return ttycCutsToSegments(cuts)
+}
+
+// ttycSearchHourSegments splits [from, to) at 시주 경계 (현지 홀수 정시).
+func ttycSearchHourSegments(from, to int64, tzOffsetMinutes int) [][2]int64 {
+	cuts := []int64{from, to}
+	offsetMs := int64(tzOffsetMinutes) * MINUTE_MS
+	// (ts + offset) mod 2h == 1h 인 첫 시각
+	first := from + ((HOUR_MS-(from+offsetMs))%(2*HOUR_MS)+2*HOUR_MS)%(2*HOUR_MS)
+	for ts := first; ts < to; ts += 2 * HOUR_MS {
+		if ts > from {
+			cuts = append(cuts, ts)
+		}
+	}
+	return ttycCutsToSegments(cuts)
+}
+
+func ttycCutsToSegments(cuts []int64) [][2]int64 {
+	sort.Slice(cuts, func(i, j int) bool { return cuts[i] < cuts[j] })
+	out := make([][2]int64, 0, len(cuts))
+	for i := 0; i+1 < len(cuts); i++ {
+		if cuts[i+1] > cuts[i] {
+			out = append(out, [2]int64{cuts[i], cuts[i+1]})
+		}
+	}
+	return out
+}
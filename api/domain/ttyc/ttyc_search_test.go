@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParsePillarPattern(t *testing.T) {
+	p, err := ParsePillarPattern("甲子/丙寅/戊辰/庚午")
+	if err != nil {
+		t.Fatalf("ParsePillarPattern() error = %v", err)
+	}
+	if *p.Year.Stem != 0 || *p.Year.Branch != 0 || *p.Month.Stem != 2 || *p.Day.Branch != 4 || *p.Hour.Branch != 6 {
+		t.Fatalf("pattern = %+v", p)
+	}
+	p, err = ParsePillarPattern("* * 임술")
+	if err != nil || p.Year != nil || p.Month != nil || p.Hour != nil || *p.Day.Stem != 8 || *p.Day.Branch != 10 {
+		t.Fatalf("pattern = %+v, %v", p, err)
+	}
+	p, err = ParsePillarPattern("*/*/壬*/*子")
+	if err != nil || p.Day.Branch != nil || *p.Day.Stem != 8 || p.Hour.Stem != nil || *p.Hour.Branch != 0 {
+		t.Fatalf("pattern = %+v, %v", p, err)
+	}
+	for _, bad := range []string{"甲子/丙寅", "甲丑/*/*", "X子/*/*", "甲子/*/*/*/*"} {
+		if _, err := ParsePillarPattern(bad); err == nil {
+			t.Fatalf("ParsePillarPattern(%q) expected error", bad)
+		}
+	}
+}
+
+func TestFindDatetimes(t *testing.T) {
+	tz := 9 * 60
+	at := func(y, m, d, h int) int64 {
+		ts, err := ToUnixTimestamp(TtycTimestampParts{Year: y, Month: m, Day: d, Hour: h}, &tz)
+		if err != nil {
+			t.Fatalf("ToUnixTimestamp() error = %v", err)
+		}
+		return ts
+	}
+
+	t.Run("일주만", func(t *testing.T) {
+		pattern, _ := ParsePillarPattern("*/*/壬戌")
+		res, err := FindDatetimes(context.Background(), pattern, TtycSearchRange{FromTs: at(2024, 1, 1, 0), ToTs: at(2025, 1, 1, 0), TzOffsetMinutes: &tz})
+		if err != nil {
+			t.Fatalf("FindDatetimes() error = %v", err)
+		}
+		if len(res.Windows) != 6 || res.Truncated {
+			t.Fatalf("windows = %d truncated=%v, want 6", len(res.Windows), res.Truncated)
+		}
+		for i, w := range res.Windows {
+			if w.Pillars.Day.GanjiHanja != "壬戌" || w.Pillars.Hour != nil || w.Start.Hour != 0 || w.EndTs-w.StartTs != DAY_MS {
+				t.Fatalf("window = %+v", w)
+			}
+			if i > 0 && w.StartTs-res.Windows[i-1].StartTs != 60*DAY_MS {
+				t.Fatalf("window %d starts %d ms after previous", i, w.StartTs-res.Windows[i-1].StartTs)
+			}
+		}
+	})
+
+	t.Run("절입 시각에서 시작", func(t *testing.T) {
+		pattern, _ := ParsePillarPattern("甲辰/丙寅/*")
+		res, err := FindDatetimes(context.Background(), pattern, TtycSearchRange{FromTs: at(2024, 1, 1, 0), ToTs: at(2024, 12, 31, 0), TzOffsetMinutes: &tz})
+		if err != nil {
+			t.Fatalf("FindDatetimes() error = %v", err)
+		}
+		first, last := res.Windows[0], res.Windows[len(res.Windows)-1]
+		if first.StartTs != ttycMonthTermStartTs(2024, 2) || last.EndTs != ttycMonthTermStartTs(2024, 3) {
+			t.Fatalf("寅월 = %d..%d, want 입춘..경칩", first.StartTs, last.EndTs)
+		}
+		if len(res.Windows) != 31 {
+			t.Fatalf("windows = %d, want 31 (days 02-04..03-05)", len(res.Windows))
+		}
+	})
+
+	t.Run("네 기둥 전체", func(t *testing.T) {
+		birth := at(1990, 5, 15, 10) + 24*MINUTE_MS
+		calc, err := CalculatePillars(TtycPillarCalcInput{Ts: birth, TzOffsetMinutes: &tz})
+		if err != nil {
+			t.Fatalf("CalculatePillars() error = %v", err)
+		}
+		ps := calc.Pillars
+		pattern := TtycPillarPattern{
+			Year:  &TtycGanjiPattern{Stem: &ps.Year.Stem, Branch: &ps.Year.Branch},
+			Month: &TtycGanjiPattern{Stem: &ps.Month.Stem, Branch: &ps.Month.Branch},
+			Day:   &TtycGanjiPattern{Stem: &ps.Day.Stem, Branch: &ps.Day.Branch},
+			Hour:  &TtycGanjiPattern{Stem: &ps.Hour.Stem, Branch: &ps.Hour.Branch},
+		}
+		res, err := FindDatetimes(context.Background(), pattern, TtycSearchRange{FromTs: at(1950, 1, 1, 0), ToTs: at(2010, 1, 1, 0), TzOffsetMinutes: &tz})
+		if err != nil {
+			t.Fatalf("FindDatetimes() error = %v", err)
+		}
+		found := false
+		for _, w := range res.Windows {
+			if !ttycSamePillars(w.Pillars, ps) {
+				t.Fatalf("window pillars %+v differ", w)
+			}
+			if w.EndTs-w.StartTs > 2*60*MINUTE_MS {
+				t.Fatalf("window longer than 2h: %+v", w)
+			}
+			if w.StartTs <= birth && birth < w.EndTs {
+				found = w.Start.Hour == 9 && w.End.Hour == 11
+			}
+		}
+		if !found {
+			t.Fatalf("birth window 09:00-11:00 not found in %d windows", len(res.Windows))
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		pattern, _ := ParsePillarPattern("*/*/*/*子")
+		res, err := FindDatetimes(context.Background(), pattern, TtycSearchRange{FromTs: at(2024, 1, 1, 0), ToTs: at(2024, 2, 1, 0), TzOffsetMinutes: &tz, Limit: 5})
+		if err != nil {
+			t.Fatalf("FindDatetimes() error = %v", err)
+		}
+		if len(res.Windows) != 5 || !res.Truncated {
+			t.Fatalf("windows = %d truncated=%v", len(res.Windows), res.Truncated)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		pattern, _ := ParsePillarPattern("*/*/*/*子")
+		if _, err := FindDatetimes(ctx, pattern, TtycSearchRange{FromTs: at(2024, 1, 1, 0), ToTs: at(2025, 1, 1, 0), TzOffsetMinutes: &tz}); !errors.Is(err, context.Canceled) {
+			t.Fatalf("FindDatetimes() error = %v, want context.Canceled", err)
+		}
+	})
+
+	if _, err := FindDatetimes(context.Background(), TtycPillarPattern{}, TtycSearchRange{FromTs: 10, ToTs: 10}); err == nil {
+		t.Fatalf("expected error for empty range")
+	}
+	if _, err := FindDatetimes(context.Background(), TtycPillarPattern{}, TtycSearchRange{FromTs: at(1800, 1, 1, 0), ToTs: at(2025, 1, 1, 0), TzOffsetMinutes: &tz}); err == nil {
+		t.Fatalf("expected error for range over %d years", TtycSearchMaxRangeYears)
+	}
+}
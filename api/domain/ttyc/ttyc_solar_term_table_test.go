@@ -1,7 +1,7 @@
 package domain
 
-// ttycSolarTermStartDayTable stores month-term boundary day (local calendar date)
-// derived from sxtwl day-level month transitions for exact SxtwlExtDao compatibility.
+// ttycSolarTermStartDayTable stores month-term boundary day (Beijing calendar date)
+// derived from sxtwl day-level month transitions; kept as a cross-check for ttycMonthTermStartTs.
 const ttycSolarTermStartYear = 1800
 const ttycSolarTermEndYear = 2200
 
@@ -410,3 +410,17 @@ var ttycSolarTermStartDayTable = [...][12]uint8{
 	{5, 4, 5, 4, 5, 5, 7, 7, 7, 8, 7, 7},
 	{5, 4, 6, 5, 5, 6, 7, 7, 8, 8, 7, 7},
 }
+
+func ttycLookupSolarTermStartDay(year, month int) (int, bool) {
+	if month < 1 || month > 12 {
+		return 0, false
+	}
+	if year < ttycSolarTermStartYear || year > ttycSolarTermEndYear {
+		return 0, false
+	}
+	day := ttycSolarTermStartDayTable[year-ttycSolarTermStartYear][month-1]
+	if day == 0 {
+		return 0, false
+	}
+	return int(day), true
+}
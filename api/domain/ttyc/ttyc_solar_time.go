@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+// 진태양시 보정: 표준시(tz offset) → 지방평균태양시(MEAN, 경도차 1°=4분) → 지방시태양시(APPARENT, + 균시차).
+// tz offset 은 호출 측이 해당 시점의 실제 오프셋(1954~61 UTC+8:30, 1948~88 서머타임 등 IANA 이력)을 넣는다.
+
+type TtycSolarTimeMode string
+
+const (
+	TtycSolarTimeModeNone     TtycSolarTimeMode = "NONE"     // 보정 없음 (표준시 그대로)
+	TtycSolarTimeModeMean     TtycSolarTimeMode = "MEAN"     // 경도 보정만 (지방평균태양시)
+	TtycSolarTimeModeApparent TtycSolarTimeMode = "APPARENT" // 경도 + 균시차 (지방시태양시)
+)
+
+type TtycSolarTimeCorrection struct {
+	Mode                  TtycSolarTimeMode `json:"mode"`
+	Longitude             float64           `json:"longitude"`
+	TzOffsetMinutes       int               `json:"tzOffsetMinutes"`
+	LongitudeMinutes      float64           `json:"longitudeMinutes"`      // 경도 - 표준자오선 차이 (분)
+	EquationOfTimeMinutes float64           `json:"equationOfTimeMinutes"` // 균시차 (APPARENT 일 때만)
+	CorrectionMinutes     int               `json:"correctionMinutes"`     // 적용 보정량 (분, 반올림)
+}
+
+func ttycNormalizeSolarTimeMode(v TtycSolarTimeMode) (TtycSolarTimeMode, error) {
+	switch v {
+	case "":
+		return TtycSolarTimeModeNone, nil
+	case TtycSolarTimeModeNone, TtycSolarTimeModeMean, TtycSolarTimeModeApparent:
+		return v, nil
+	default:
+		return "", fmt.Errorf("invalid solar time mode: %q", v)
+	}
+}
+
+// NormalizeSolarTimeMode returns the solar time mode (empty → NONE) or an error for unknown values.
+func NormalizeSolarTimeMode(v TtycSolarTimeMode) (TtycSolarTimeMode, error) {
+	return ttycNormalizeSolarTimeMode(v)
+}
+
+// ttycEquationOfTimeMinutes returns apparent minus mean solar time (minutes, about -14..+16) at ts.
+// Meeus, Astronomical Algorithms 28.3 (Smart 급수) - 오차 수 초.
+func ttycEquationOfTimeMinutes(ts int64) float64 {
+	t := (ttycUnixMsToJD(ts) - ttycJ2000JD) / 36525
+	l0 := ttycNormalizeDeg(280.46646+36000.76983*t+0.0003032*t*t) / ttycDegPerRad
+	m := ttycNormalizeDeg(357.52911+35999.05029*t-0.0001537*t*t) / ttycDegPerRad
+	e := 0.016708634 - 0.000042037*t - 0.0000001267*t*t
+	eps := (23.439291 - 0.0130042*t) / ttycDegPerRad
+	y := math.Tan(eps / 2)
+	y *= y
+
+	eot := y*math.Sin(2*l0) -
+		2*e*math.Sin(m) +
+		4*e*y*math.Sin(m)*math.Cos(2*l0) -
+		0.5*y*y*math.Sin(4*l0) -
+		1.25*e*e*math.Sin(2*m)
+	return eot * ttycDegPerRad * 4
+}
+
+// SolarTimeCorrection returns the minutes to add to standard local time at ts to get local solar time.
+func SolarTimeCorrection(ts int64, tzOffsetMinutes int, longitude float64, mode TtycSolarTimeMode) (TtycSolarTimeCorrection, error) {
+	mode, err := ttycNormalizeSolarTimeMode(mode)
+	if err != nil {
+		return TtycSolarTimeCorrection{}, err
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return TtycSolarTimeCorrection{}, fmt.Errorf("invalid longitude: %v", longitude)
+	}
+	out := TtycSolarTimeCorrection{Mode: mode, Longitude: longitude, TzOffsetMinutes: tzOffsetMinutes}
+	if mode == TtycSolarTimeModeNone {
+		return out, nil
+	}
+	out.LongitudeMinutes = longitude*4 - float64(tzOffsetMinutes)
+	if mode == TtycSolarTimeModeApparent {
+		out.EquationOfTimeMinutes = ttycEquationOfTimeMinutes(ts)
+	}
+	out.CorrectionMinutes = int(math.Round(out.LongitudeMinutes + out.EquationOfTimeMinutes))
+	return out, nil
+}
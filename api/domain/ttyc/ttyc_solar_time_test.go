@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEquationOfTimeMinutes(t *testing.T) {
+	utc := 0
+	tests := []struct {
+		name  string
+		month int
+		day   int
+		want  float64
+	}{
+		{name: "2월 중순 최소", month: 2, day: 11, want: -14.2},
+		{name: "4월 중순 0 부근", month: 4, day: 15, want: 0.0},
+		{name: "7월 하순", month: 7, day: 26, want: -6.5},
+		{name: "11월 초 최대", month: 11, day: 3, want: 16.4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := ToUnixTimestamp(TtycTimestampParts{Year: 2024, Month: tt.month, Day: tt.day, Hour: 12}, &utc)
+			if err != nil {
+				t.Fatalf("ToUnixTimestamp() error = %v", err)
+			}
+			if got := ttycEquationOfTimeMinutes(ts); math.Abs(got-tt.want) > 0.3 {
+				t.Fatalf("EoT = %.2f, want %.1f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSolarTimeCorrection(t *testing.T) {
+	kst := 9 * 60
+	ts, err := ToUnixTimestamp(TtycTimestampParts{Year: 2024, Month: 11, Day: 3, Hour: 12}, &kst)
+	if err != nil {
+		t.Fatalf("ToUnixTimestamp() error = %v", err)
+	}
+	seoul := 126.98
+
+	none, err := SolarTimeCorrection(ts, kst, seoul, "")
+	if err != nil || none.Mode != TtycSolarTimeModeNone || none.CorrectionMinutes != 0 {
+		t.Fatalf("NONE = %+v, %v", none, err)
+	}
+	mean, err := SolarTimeCorrection(ts, kst, seoul, TtycSolarTimeModeMean)
+	if err != nil {
+		t.Fatalf("SolarTimeCorrection() error = %v", err)
+	}
+	if mean.CorrectionMinutes != -32 || mean.EquationOfTimeMinutes != 0 {
+		t.Fatalf("MEAN = %+v, want -32분", mean)
+	}
+	apparent, err := SolarTimeCorrection(ts, kst, seoul, TtycSolarTimeModeApparent)
+	if err != nil {
+		t.Fatalf("SolarTimeCorrection() error = %v", err)
+	}
+	if apparent.CorrectionMinutes != -16 {
+		t.Fatalf("APPARENT = %+v, want -16분 (경도 -32 + 균시차 +16)", apparent)
+	}
+	// 1955 서울 UTC+8:30 표준시: 경도 보정 -2분
+	kst830 := 8*60 + 30
+	old, err := SolarTimeCorrection(ts, kst830, seoul, TtycSolarTimeModeMean)
+	if err != nil || old.CorrectionMinutes != -2 {
+		t.Fatalf("UTC+8:30 MEAN = %+v, %v", old, err)
+	}
+
+	if _, err := SolarTimeCorrection(ts, kst, 200, TtycSolarTimeModeMean); err == nil {
+		t.Fatalf("expected error for invalid longitude")
+	}
+	if _, err := SolarTimeCorrection(ts, kst, seoul, "TRUE"); err == nil {
+		t.Fatalf("expected error for invalid mode")
+	}
+}
+
+func TestCalculatePillars_SolarTime(t *testing.T) {
+	kst := 9 * 60
+	seoul := 126.98
+	at := func(day, hour, minute int) int64 {
+		ts, err := ToUnixTimestamp(TtycTimestampParts{Year: 2024, Month: 2, Day: day, Hour: hour, Minute: minute}, &kst)
+		if err != nil {
+			t.Fatalf("ToUnixTimestamp() error = %v", err)
+		}
+		return ts
+	}
+	calc := func(ts int64, mode TtycSolarTimeMode) TtycPillarCalcResult {
+		res, err := CalculatePillars(TtycPillarCalcInput{Ts: ts, TzOffsetMinutes: &kst, SolarTimeMode: mode, Longitude: &seoul})
+		if err != nil {
+			t.Fatalf("CalculatePillars() error = %v", err)
+		}
+		return res
+	}
+
+	// 2/11 09:40 KST: MEAN -32분 → 09:08 (巳시), APPARENT 균시차 -14분 더해 → 08:54 (辰시)
+	plain := calc(at(11, 9, 40), "")
+	if plain.SolarTime != nil || plain.SolarLocal != nil || plain.Pillars.Hour.Branch != 5 {
+		t.Fatalf("NONE hour = %+v solar=%+v", plain.Pillars.Hour, plain.SolarTime)
+	}
+	mean := calc(at(11, 9, 40), TtycSolarTimeModeMean)
+	if mean.Pillars.Hour.Branch != 5 || mean.SolarLocal.Hour != 9 || mean.SolarLocal.Minute != 8 {
+		t.Fatalf("MEAN hour = %+v local=%+v", mean.Pillars.Hour, mean.SolarLocal)
+	}
+	apparent := calc(at(11, 9, 40), TtycSolarTimeModeApparent)
+	if apparent.Pillars.Hour.Branch != 4 || apparent.SolarLocal.Hour != 8 || apparent.SolarTime.CorrectionMinutes != -46 {
+		t.Fatalf("APPARENT hour = %+v local=%+v corr=%+v", apparent.Pillars.Hour, apparent.SolarLocal, apparent.SolarTime)
+	}
+	if apparent.Local != plain.Local {
+		t.Fatalf("civil local changed: %+v", apparent.Local)
+	}
+
+	// 2/12 00:20 KST 는 지방태양시로 전날 23시대 → 일주도 전날
+	prevDay := calc(at(11, 12, 0), "")
+	midnight := calc(at(12, 0, 20), TtycSolarTimeModeApparent)
+	if midnight.Pillars.Day != prevDay.Pillars.Day || midnight.Pillars.Hour.Branch != 0 {
+		t.Fatalf("day = %+v hour = %+v, want previous day 子시", midnight.Pillars.Day, midnight.Pillars.Hour)
+	}
+	if want := at(11, 0, 0) + 46*MINUTE_MS; midnight.Boundaries.DayStartTs != want {
+		t.Fatalf("DayStartTs = %d, want %d", midnight.Boundaries.DayStartTs, want)
+	}
+
+	if _, err := CalculatePillars(TtycPillarCalcInput{Ts: at(11, 9, 40), TzOffsetMinutes: &kst, SolarTimeMode: TtycSolarTimeModeMean}); err == nil {
+		t.Fatalf("expected error without longitude")
+	}
+}
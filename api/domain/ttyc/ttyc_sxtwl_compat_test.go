@@ -1,6 +1,9 @@
 package domain
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestCalculatePillars_SxtwlCompatCases(t *testing.T) {
 	tz := 9 * 60
@@ -22,16 +25,18 @@ func TestCalculatePillars_SxtwlCompatCases(t *testing.T) {
 		hStem int
 		hBr   int
 	}{
+		// 절입 당일이라도 절입 시각 이전 출생은 이전 월/년주 (1984 입춘 02-05 00:18, 2017 입춘 02-04 00:34,
+		// 2021 입춘 02-03 23:58, 2030 입동 11-07 18:08 KST)
 		{name: "1984-02-02", year: 1984, mon: 2, day: 2, hour: 12, min: 0, yStem: 9, yBr: 11, mStem: 1, mBr: 1, dStem: 2, dBr: 2, hStem: 0, hBr: 6},
-		{name: "1984-02-04", year: 1984, mon: 2, day: 4, hour: 12, min: 0, yStem: 0, yBr: 0, mStem: 2, mBr: 2, dStem: 4, dBr: 4, hStem: 4, hBr: 6},
-		{name: "2017-02-03", year: 2017, mon: 2, day: 3, hour: 12, min: 30, yStem: 3, yBr: 9, mStem: 8, mBr: 2, dStem: 7, dBr: 9, hStem: 0, hBr: 6},
+		{name: "1984-02-04", year: 1984, mon: 2, day: 4, hour: 12, min: 0, yStem: 9, yBr: 11, mStem: 1, mBr: 1, dStem: 4, dBr: 4, hStem: 4, hBr: 6},
+		{name: "2017-02-03", year: 2017, mon: 2, day: 3, hour: 12, min: 30, yStem: 2, yBr: 8, mStem: 7, mBr: 1, dStem: 7, dBr: 9, hStem: 0, hBr: 6},
 		{name: "2017-02-04", year: 2017, mon: 2, day: 4, hour: 12, min: 30, yStem: 3, yBr: 9, mStem: 8, mBr: 2, dStem: 8, dBr: 10, hStem: 2, hBr: 6},
-		{name: "2021-02-03", year: 2021, mon: 2, day: 3, hour: 12, min: 30, yStem: 7, yBr: 1, mStem: 6, mBr: 2, dStem: 8, dBr: 6, hStem: 2, hBr: 6},
+		{name: "2021-02-03", year: 2021, mon: 2, day: 3, hour: 12, min: 30, yStem: 6, yBr: 0, mStem: 5, mBr: 1, dStem: 8, dBr: 6, hStem: 2, hBr: 6},
 		{name: "2022-02-01", year: 2022, mon: 2, day: 1, hour: 12, min: 0, yStem: 7, yBr: 1, mStem: 7, mBr: 1, dStem: 1, dBr: 9, hStem: 8, hBr: 6},
 		{name: "1999-12-31", year: 1999, mon: 12, day: 31, hour: 23, min: 59, yStem: 5, yBr: 3, mStem: 2, mBr: 0, dStem: 3, dBr: 5, hStem: 6, hBr: 0},
 		{name: "2000-01-01", year: 2000, mon: 1, day: 1, hour: 0, min: 0, yStem: 5, yBr: 3, mStem: 2, mBr: 0, dStem: 4, dBr: 6, hStem: 8, hBr: 0},
 		{name: "2008-08-08", year: 2008, mon: 8, day: 8, hour: 8, min: 8, yStem: 4, yBr: 0, mStem: 6, mBr: 8, dStem: 6, dBr: 4, hStem: 6, hBr: 4},
-		{name: "2030-11-07", year: 2030, mon: 11, day: 7, hour: 12, min: 30, yStem: 6, yBr: 10, mStem: 3, mBr: 11, dStem: 2, dBr: 6, hStem: 0, hBr: 6},
+		{name: "2030-11-07", year: 2030, mon: 11, day: 7, hour: 12, min: 30, yStem: 6, yBr: 10, mStem: 2, mBr: 10, dStem: 2, dBr: 6, hStem: 0, hBr: 6},
 	}
 
 	for _, tt := range tests {
@@ -69,3 +74,71 @@ func TestCalculatePillars_SxtwlCompatCases(t *testing.T) {
 		})
 	}
 }
+
+func TestMonthTermStartTs_KnownInstants(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	tests := []struct {
+		year, month int
+		want        time.Time
+	}{
+		{2024, 2, time.Date(2024, 2, 4, 17, 27, 0, 0, kst)}, // 입춘
+		{2017, 2, time.Date(2017, 2, 4, 0, 34, 0, 0, kst)},  // 입춘
+		{2021, 2, time.Date(2021, 2, 3, 23, 58, 0, 0, kst)}, // 입춘
+		{2008, 8, time.Date(2008, 8, 7, 12, 16, 0, 0, kst)}, // 입추
+		{2020, 12, time.Date(2020, 12, 7, 1, 9, 0, 0, kst)}, // 대설
+		{2025, 1, time.Date(2025, 1, 5, 11, 32, 0, 0, kst)}, // 소한
+	}
+	for _, tt := range tests {
+		got := time.UnixMilli(ttycMonthTermStartTs(tt.year, tt.month)).In(kst).Truncate(time.Minute)
+		if !got.Equal(tt.want) {
+			t.Errorf("ttycMonthTermStartTs(%d, %d) = %s, want %s", tt.year, tt.month, got, tt.want)
+		}
+	}
+}
+
+func TestCalculatePillars_MonthTermInstant(t *testing.T) {
+	tz := 9 * 60
+	lichun := ttycMonthTermStartTs(2024, 2)
+	for _, tt := range []struct {
+		name  string
+		ts    int64
+		yStem int
+		mBr   int
+	}{
+		{name: "1분 전", ts: lichun - MINUTE_MS, yStem: 9, mBr: 1},
+		{name: "절입", ts: lichun, yStem: 0, mBr: 2},
+	} {
+		got, err := CalculatePillars(TtycPillarCalcInput{Ts: tt.ts, TzOffsetMinutes: &tz})
+		if err != nil {
+			t.Fatalf("%s: CalculatePillars() error = %v", tt.name, err)
+		}
+		if got.Pillars.Year.Stem != tt.yStem || got.Pillars.Month.Branch != tt.mBr {
+			t.Errorf("%s: got year stem %d month branch %d, want %d %d",
+				tt.name, got.Pillars.Year.Stem, got.Pillars.Month.Branch, tt.yStem, tt.mBr)
+		}
+		if got.Boundaries.LichunStartTs != lichun || (tt.mBr == 2 && got.Boundaries.MonthTermStartTs != lichun) {
+			t.Errorf("%s: boundaries = %+v, want lichun %d", tt.name, got.Boundaries, lichun)
+		}
+	}
+}
+
+// 기존 sxtwl 일 단위 테이블(베이징시 날짜)과 교차검증: 자정 부근(±1시간) 절입은 sxtwl 정밀도 차이로 날짜가 갈릴 수 있음
+func TestMonthTermStartTs_SxtwlDayTable(t *testing.T) {
+	cst := time.FixedZone("CST", 8*60*60)
+	for year := ttycSolarTermStartYear; year <= ttycSolarTermEndYear; year++ {
+		for month := 1; month <= 12; month++ {
+			day, ok := ttycLookupSolarTermStartDay(year, month)
+			if !ok {
+				t.Fatalf("no table day for %d-%02d", year, month)
+			}
+			got := time.UnixMilli(ttycMonthTermStartTs(year, month)).In(cst)
+			want := time.Date(year, time.Month(month), day, 0, 0, 0, 0, cst)
+			if got.Year() == year && got.Month() == time.Month(month) && got.Day() == day {
+				continue
+			}
+			if d := got.Sub(want); d < -time.Hour || d > 25*time.Hour {
+				t.Errorf("%d-%02d: term at %s, table day %d", year, month, got, day)
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package domain
+
+import "testing"
+
+func TestCalculatePillars_ZishiConvention(t *testing.T) {
+	tz := 9 * 60
+	at := func(day, hour, minute int) int64 {
+		ts, err := ToUnixTimestamp(TtycTimestampParts{Year: 2024, Month: 5, Day: day, Hour: hour, Minute: minute}, &tz)
+		if err != nil {
+			t.Fatalf("ToUnixTimestamp() error = %v", err)
+		}
+		return ts
+	}
+	calc := func(ts int64, zishi TtycZishiConvention) TtycPillarCalcResult {
+		res, err := CalculatePillars(TtycPillarCalcInput{Ts: ts, TzOffsetMinutes: &tz, ZishiConvention: zishi})
+		if err != nil {
+			t.Fatalf("CalculatePillars() error = %v", err)
+		}
+		return res
+	}
+
+	// 5/10(甲戌일) 22:30(亥시) / 5/10 23:30 / 5/11(乙亥일) 00:30 기준값 (기본 DAY_CHANGE_00)
+	hai := calc(at(10, 22, 30), "")
+	late := calc(at(10, 23, 30), "")
+	early := calc(at(11, 0, 30), "")
+	if hai.ZishiConvention != TtycZishiConventionDayChange00 {
+		t.Fatalf("default convention = %q", hai.ZishiConvention)
+	}
+	if late.Pillars.Day != hai.Pillars.Day || late.Pillars.Day.GanjiHanja != "甲戌" {
+		t.Fatalf("DAY_CHANGE_00 23:30 day = %+v, want same day 甲戌 %+v", late.Pillars.Day, hai.Pillars.Day)
+	}
+	if late.Pillars.Day == early.Pillars.Day {
+		t.Fatalf("DAY_CHANGE_00 23:30 and next 00:30 share day pillar %+v", late.Pillars.Day)
+	}
+	if late.Pillars.Hour.GanjiHanja != "甲子" || early.Pillars.Hour.GanjiHanja != "丙子" {
+		t.Fatalf("DAY_CHANGE_00 23:30/00:30 hour = %s/%s, want 甲子/丙子 (당일 일간 기준)", late.Pillars.Hour.GanjiHanja, early.Pillars.Hour.GanjiHanja)
+	}
+
+	// 야자시: 23시대는 일주 유지, 子시 천간은 다음날 일간 기준 (甲일 23:30 → 丙子); 그 외 시간은 DAY_CHANGE_00 과 동일
+	for _, tt := range []struct {
+		name     string
+		ts       int64
+		wantDay  TtycGanjiMeta
+		wantHour TtycGanjiMeta
+	}{
+		{name: "22:30", ts: at(10, 22, 30), wantDay: hai.Pillars.Day, wantHour: *hai.Pillars.Hour},
+		{name: "23:30 甲일 丙子시", ts: at(10, 23, 30), wantDay: late.Pillars.Day, wantHour: *early.Pillars.Hour},
+		{name: "00:30", ts: at(11, 0, 30), wantDay: early.Pillars.Day, wantHour: *early.Pillars.Hour},
+	} {
+		got := calc(tt.ts, TtycZishiConventionYajasi)
+		if got.ZishiConvention != TtycZishiConventionYajasi {
+			t.Fatalf("%s: ZishiConvention = %q", tt.name, got.ZishiConvention)
+		}
+		if got.Pillars.Day.GanjiHanja != tt.wantDay.GanjiHanja || got.Pillars.Hour.GanjiHanja != tt.wantHour.GanjiHanja {
+			t.Fatalf("%s: YAJASI = %s일 %s시, want %s일 %s시", tt.name,
+				got.Pillars.Day.GanjiHanja, got.Pillars.Hour.GanjiHanja, tt.wantDay.GanjiHanja, tt.wantHour.GanjiHanja)
+		}
+		if got.Boundaries.DayStartTs != calc(tt.ts, "").Boundaries.DayStartTs {
+			t.Fatalf("%s: YAJASI DayStartTs differs from DAY_CHANGE_00", tt.name)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		ts       int64
+		wantDay  TtycGanjiMeta
+		wantHour TtycGanjiMeta
+		dayStart int64
+	}{
+		{name: "22:30 는 당일", ts: at(10, 22, 30), wantDay: hai.Pillars.Day, wantHour: *hai.Pillars.Hour, dayStart: at(9, 23, 0)},
+		{name: "23:00 부터 다음날", ts: at(10, 23, 0), wantDay: early.Pillars.Day, wantHour: *early.Pillars.Hour, dayStart: at(10, 23, 0)},
+		{name: "23:30 다음날 子시", ts: at(10, 23, 30), wantDay: early.Pillars.Day, wantHour: *early.Pillars.Hour, dayStart: at(10, 23, 0)},
+		{name: "00:30 는 DAY_CHANGE_00 과 동일", ts: at(11, 0, 30), wantDay: early.Pillars.Day, wantHour: *early.Pillars.Hour, dayStart: at(10, 23, 0)},
+		{name: "00:59", ts: at(11, 0, 59), wantDay: early.Pillars.Day, wantHour: *early.Pillars.Hour, dayStart: at(10, 23, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := calc(tt.ts, TtycZishiConventionDayChange23)
+			if got.ZishiConvention != TtycZishiConventionDayChange23 {
+				t.Fatalf("ZishiConvention = %q", got.ZishiConvention)
+			}
+			if got.Pillars.Day != tt.wantDay {
+				t.Fatalf("day = %+v, want %+v", got.Pillars.Day, tt.wantDay)
+			}
+			if *got.Pillars.Hour != tt.wantHour {
+				t.Fatalf("hour = %+v, want %+v", *got.Pillars.Hour, tt.wantHour)
+			}
+			if got.Boundaries.DayStartTs != tt.dayStart {
+				t.Fatalf("DayStartTs = %d, want %d", got.Boundaries.DayStartTs, tt.dayStart)
+			}
+		})
+	}
+
+	if _, err := CalculatePillars(TtycPillarCalcInput{Ts: at(10, 23, 30), TzOffsetMinutes: &tz, ZishiConvention: "NOON"}); err == nil {
+		t.Fatalf("expected error for invalid convention")
+	}
+}
+
+func TestTtycCalculator_ZishiConvention(t *testing.T) {
+	tz := 9 * 60
+	ts, err := ToUnixTimestamp(TtycTimestampParts{Year: 1990, Month: 1, Day: 15, Hour: 23, Minute: 40}, &tz)
+	if err != nil {
+		t.Fatalf("ToUnixTimestamp() error = %v", err)
+	}
+	midnight, err := NewTtycCalculator(&TtycCalculatorOptions{TzOffsetMinutes: &tz})
+	if err != nil {
+		t.Fatalf("NewTtycCalculator() error = %v", err)
+	}
+	yajasi, err := NewTtycCalculator(&TtycCalculatorOptions{TzOffsetMinutes: &tz, ZishiConvention: TtycZishiConventionYajasi})
+	if err != nil {
+		t.Fatalf("NewTtycCalculator() error = %v", err)
+	}
+	dayChange, err := NewTtycCalculator(&TtycCalculatorOptions{TzOffsetMinutes: &tz, ZishiConvention: TtycZishiConventionDayChange23})
+	if err != nil {
+		t.Fatalf("NewTtycCalculator() error = %v", err)
+	}
+	a, err := midnight.Calculate(ts, nil)
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+	y, err := yajasi.Calculate(ts, nil)
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+	b, err := dayChange.Calculate(ts, nil)
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+	if ttycMod(b.Birth.Pillars.Day.Stem-a.Birth.Pillars.Day.Stem, 10) != 1 || ttycMod(b.Birth.Pillars.Day.Branch-a.Birth.Pillars.Day.Branch, 12) != 1 {
+		t.Fatalf("day pillars %+v -> %+v, want next day", a.Birth.Pillars.Day, b.Birth.Pillars.Day)
+	}
+	if b.Birth.Pillars.Hour.Stem != ttycMod(b.Birth.DayMasterStem*2, 10) {
+		t.Fatalf("hour stem %d not derived from day stem %d", b.Birth.Pillars.Hour.Stem, b.Birth.DayMasterStem)
+	}
+	if y.Birth.Pillars.Day != a.Birth.Pillars.Day || y.Birth.Pillars.Hour.GanjiHanja != b.Birth.Pillars.Hour.GanjiHanja {
+		t.Fatalf("YAJASI = %s일 %s시, want %s일 %s시", y.Birth.Pillars.Day.GanjiHanja, y.Birth.Pillars.Hour.GanjiHanja,
+			a.Birth.Pillars.Day.GanjiHanja, b.Birth.Pillars.Hour.GanjiHanja)
+	}
+	if _, err := NewTtycCalculator(&TtycCalculatorOptions{ZishiConvention: "NOON"}); err == nil {
+		t.Fatalf("expected error for invalid convention")
+	}
+}
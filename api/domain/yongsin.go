@@ -0,0 +1,299 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// 용신(用神) 판정: 억부·조후·통관·병약 네 방법으로 용신 후보를 내고 최고점 후보를 용신으로 잡는다.
+// 희신·기신·구신·한신은 용신과의 생극으로 정하며, 모두 SajuDoc.Evals 로 나간다 (궁합 usefulGodSupport 입력).
+
+const (
+	EvalYongsin EvalKind = "YONGSIN" // 용신
+	EvalHeesin  EvalKind = "HEESIN"  // 희신: 용신을 생
+	EvalGisin   EvalKind = "GISIN"   // 기신: 용신을 극
+	EvalGusin   EvalKind = "GUSIN"   // 구신: 기신을 생
+	EvalHansin  EvalKind = "HANSIN"  // 한신: 용신이 생
+)
+
+// 용신 방법
+const (
+	YongsinMethodEokbu     = "EOKBU"     // 억부: 강하면 누르고 약하면 돕는다
+	YongsinMethodJohu      = "JOHU"      // 조후: 한난조습
+	YongsinMethodTonggwan  = "TONGGWAN"  // 통관: 대치하는 두 오행을 잇는다
+	YongsinMethodByeongyak = "BYEONGYAK" // 병약: 지나친 오행(병)을 치는 약
+)
+
+const (
+	yongsinStrongLine   = 55.0 // 일간 세력 이상이면 신강 쪽 억부
+	yongsinWeakLine     = 45.0 // 이하면 신약 쪽 억부
+	yongsinTonggwanMin  = 0.25 // 통관: 대치 두 오행 각각의 비율 하한
+	yongsinByeongMin    = 0.40 // 병약: 병으로 보는 오행 비율 하한
+	yongsinJohuScarcity = 0.10 // 조후: 필요한 오행이 이 비율 미만이면 가산
+)
+
+// UsefulGods: 용신·희신·기신·구신·한신 오행
+type UsefulGods struct {
+	Yong FiveEl `json:"yong"`
+	Hee  FiveEl `json:"hee"`
+	Gi   FiveEl `json:"gi"`
+	Gu   FiveEl `json:"gu"`
+	Han  FiveEl `json:"han"`
+}
+
+type yongsinCandidate struct {
+	Method string
+	El     FiveEl
+	Score  float64
+	Note   string
+}
+
+// yongsinRoles: 용신 기준 오행 오프셋 (생: +4 → 희신, 극: +3 → 기신, +2 → 구신, +1 → 한신)
+var yongsinRoles = []struct {
+	K      EvalKind
+	ID     string
+	N      string
+	Offset int
+	Favor  float64 // 용신 점수 대비 유리도 (1 이면 용신과 같음, 음수면 100 − x)
+	Note   string
+}{
+	{EvalYongsin, "eval.yongsin", "용신", 0, 1.0, "억부·조후·통관·병약 후보 중 최고점"},
+	{EvalHeesin, "eval.heesin", "희신", 4, 0.8, "용신을 생하는 오행"},
+	{EvalHansin, "eval.hansin", "한신", 1, 0, "용신이 생하는 오행 (중립)"},
+	{EvalGusin, "eval.gusin", "구신", 2, -0.8, "기신을 생하는 오행"},
+	{EvalGisin, "eval.gisin", "기신", 3, -1.0, "용신을 극하는 오행"},
+}
+
+// buildYongsinEvals derives 용신 candidates from element distribution, day-master strength (0~100) and month season.
+func buildYongsinEvals(sys string, dayMaster StemId, monthBranch BranchId, nodes []Node, dist *ElDistribution, strength, confidence float64) []EvalItem {
+	ratios := elRatios(dist)
+	cands := []yongsinCandidate{yongsinEokbu(dayMaster, ratios, strength)}
+	if c, ok := yongsinJohu(monthBranch, ratios); ok {
+		cands = append(cands, c)
+	}
+	if c, ok := yongsinTonggwan(ratios); ok {
+		cands = append(cands, c)
+	}
+	if c, ok := yongsinByeongyak(ratios, cands[0].El); ok {
+		cands = append(cands, c)
+	}
+	sort.SliceStable(cands, func(i, j int) bool { return cands[i].Score > cands[j].Score })
+	chosen := cands[0]
+	if len(cands) > 1 && cands[1].El != chosen.El && chosen.Score-cands[1].Score < 10 {
+		confidence -= 0.10
+	}
+
+	candidates := make([]map[string]any, 0, len(cands))
+	parts := make([]ScorePart, 0, len(cands))
+	for _, c := range cands {
+		candidates = append(candidates, map[string]any{"method": c.Method, "element": string(c.El), "score": c.Score, "note": c.Note})
+		parts = append(parts, ScorePart{Label: c.Method, W: 1.0, Raw: c.Score, Refs: elementNodeRefs(nodes, c.El), Note: string(c.El) + " " + c.Note})
+	}
+	params := map[string]any{
+		"method":   chosen.Method,
+		"strength": strength,
+		"season":   seasonOf(monthBranch),
+	}
+
+	yongIdx := fiveElementIdx(chosen.El)
+	out := make([]EvalItem, 0, len(yongsinRoles))
+	for _, role := range yongsinRoles {
+		el := fiveElByIdx(yongIdx + role.Offset)
+		refs := elementNodeRefs(nodes, el)
+		v := map[string]any{"element": string(el), "method": chosen.Method}
+		var total float64
+		var rolePart []ScorePart
+		switch {
+		case role.Offset == 0:
+			v["candidates"] = candidates
+			total, rolePart = chosen.Score, parts
+		case role.Favor > 0:
+			total = chosen.Score * role.Favor
+		case role.Favor < 0:
+			total = 100 + chosen.Score*role.Favor
+		default:
+			total = 50
+		}
+		if rolePart == nil {
+			rolePart = []ScorePart{
+				{Label: "yongsin", W: role.Favor, Raw: chosen.Score, Refs: elementNodeRefs(nodes, chosen.El), Note: chosen.Method},
+				{Label: "element_ratio", W: 0, Raw: ratios[fiveElementIdx(el)] * 100, Refs: refs, Note: "참고: 원국 비율"},
+			}
+		}
+		out = append(out, EvalItem{
+			ID:   role.ID,
+			K:    role.K,
+			N:    role.N,
+			V:    v,
+			Refs: refs,
+			Evidence: Evidence{
+				RuleId:  "rule.eval.yongsin",
+				RuleVer: "v1",
+				Sys:     sys,
+				Inputs:  EvidenceInputs{Nodes: refs, Params: params},
+				Notes:   role.Note,
+			},
+			Score: newScore(total, 0, 100, confidence, rolePart),
+		})
+	}
+	return out
+}
+
+// yongsinEokbu: 신강이면 인성 과다는 재성, 비겁 과다는 관성(관성이 없으면 식상)으로 누르고,
+// 신약이면 재성 과다는 비겁, 식상·관성 과다는 인성으로 돕는다. 중화는 점수를 낮게 둔다.
+func yongsinEokbu(dayMaster StemId, v [5]float64, strength float64) yongsinCandidate {
+	self := fiveElementIdx(stemElement(dayMaster))
+	output, wealth, officer, resource := mod5(self+1), mod5(self+2), mod5(self+3), mod5(self+4)
+	score := clamp(0, 95, 60+math.Abs(strength-50)*0.8)
+	pick := func(idx int, note string) yongsinCandidate {
+		return yongsinCandidate{Method: YongsinMethodEokbu, El: fiveElByIdx(idx), Score: score, Note: note}
+	}
+	switch {
+	case strength >= yongsinStrongLine:
+		if v[resource] > v[self] {
+			return pick(wealth, "신강·인성 과다 → 재성")
+		}
+		if v[officer] < 0.05 && v[output] > 0 {
+			return pick(output, "신강·관성 부재 → 식상 설기")
+		}
+		return pick(officer, "신강·비겁 과다 → 관성")
+	case strength <= yongsinWeakLine:
+		if v[wealth] >= v[output] && v[wealth] >= v[officer] {
+			return pick(self, "신약·재성 과다 → 비겁")
+		}
+		return pick(resource, "신약·식상/관성 과다 → 인성")
+	}
+	// 중화: 부족한 쪽을 조금 보탠다
+	score = 50
+	if v[self]+v[resource] < v[output]+v[wealth]+v[officer] {
+		return yongsinCandidate{Method: YongsinMethodEokbu, El: fiveElByIdx(resource), Score: score, Note: "중화·약간 신약 → 인성"}
+	}
+	return yongsinCandidate{Method: YongsinMethodEokbu, El: fiveElByIdx(output), Score: score, Note: "중화·약간 신강 → 식상"}
+}
+
+// yongsinJohu: 여름(巳午未)은 수, 겨울(亥子丑)은 화로 기후를 맞춘다. 午·子 월이 가장 급하다.
+func yongsinJohu(monthBranch BranchId, v [5]float64) (yongsinCandidate, bool) {
+	var score float64
+	switch monthBranch {
+	case 6, 0:
+		score = 75
+	case 5, 7, 11, 1:
+		score = 62
+	default:
+		return yongsinCandidate{}, false
+	}
+	el, note := FiveEl("WATER"), "하절 → 수"
+	if seasonOf(monthBranch) == "WINTER" {
+		el, note = "FIRE", "동절 → 화"
+	}
+	if v[fiveElementIdx(el)] < yongsinJohuScarcity {
+		score += 12
+		note += " (부족)"
+	}
+	return yongsinCandidate{Method: YongsinMethodJohu, El: el, Score: score, Note: note}, true
+}
+
+// yongsinTonggwan: 서로 극하는 두 오행이 모두 강하면 그 사이(극하는 쪽이 생하는 오행)가 통관 용신.
+func yongsinTonggwan(v [5]float64) (yongsinCandidate, bool) {
+	best := yongsinCandidate{}
+	for a := 0; a < 5; a++ {
+		b := mod5(a + 2) // a 가 b 를 극
+		low := math.Min(v[a], v[b])
+		if low < yongsinTonggwanMin {
+			continue
+		}
+		score := clamp(0, 85, 60+(low-yongsinTonggwanMin)*200)
+		if score > best.Score {
+			mid := fiveElByIdx(a + 1)
+			best = yongsinCandidate{
+				Method: YongsinMethodTonggwan,
+				El:     mid,
+				Score:  score,
+				Note:   fmt.Sprintf("%s·%s 대치 → %s", fiveElByIdx(a).Ko(), fiveElByIdx(b).Ko(), mid.Ko()),
+			}
+		}
+	}
+	return best, best.Method != ""
+}
+
+// yongsinByeongyak: 한 오행이 지나치면(병) 그것을 극하는 오행이 약. 억부 용신과 같은 오행은 병으로 보지 않는다.
+func yongsinByeongyak(v [5]float64, eokbu FiveEl) (yongsinCandidate, bool) {
+	top := 0
+	for i := 1; i < 5; i++ {
+		if v[i] > v[top] {
+			top = i
+		}
+	}
+	if v[top] < yongsinByeongMin || fiveElByIdx(top) == eokbu {
+		return yongsinCandidate{}, false
+	}
+	cure := fiveElByIdx(top + 3)
+	return yongsinCandidate{
+		Method: YongsinMethodByeongyak,
+		El:     cure,
+		Score:  clamp(0, 85, 55+(v[top]-yongsinByeongMin)*150),
+		Note:   fmt.Sprintf("%s 과다 → %s", fiveElByIdx(top).Ko(), cure.Ko()),
+	}, true
+}
+
+// UsefulGodsOf reads 용신·희신·기신·구신·한신 from the doc evals (없으면 false).
+func UsefulGodsOf(doc *SajuDoc) (UsefulGods, bool) {
+	if doc == nil {
+		return UsefulGods{}, false
+	}
+	var out UsefulGods
+	found := 0
+	for _, e := range doc.Evals {
+		v, ok := e.V.(map[string]any)
+		if !ok {
+			continue
+		}
+		el, _ := v["element"].(string)
+		switch e.K {
+		case EvalYongsin:
+			out.Yong = FiveEl(el)
+		case EvalHeesin:
+			out.Hee = FiveEl(el)
+		case EvalGisin:
+			out.Gi = FiveEl(el)
+		case EvalGusin:
+			out.Gu = FiveEl(el)
+		case EvalHansin:
+			out.Han = FiveEl(el)
+		default:
+			continue
+		}
+		found++
+	}
+	return out, found == len(yongsinRoles) && out.Yong != ""
+}
+
+func seasonOf(branch BranchId) string {
+	switch branch {
+	case 2, 3, 4:
+		return "SPRING"
+	case 5, 6, 7:
+		return "SUMMER"
+	case 8, 9, 10:
+		return "AUTUMN"
+	}
+	return "WINTER"
+}
+
+func elRatios(dist *ElDistribution) [5]float64 {
+	if dist == nil {
+		return [5]float64{0.2, 0.2, 0.2, 0.2, 0.2}
+	}
+	return [5]float64{dist.Wood, dist.Fire, dist.Earth, dist.Metal, dist.Water}
+}
+
+func elementNodeRefs(nodes []Node, el FiveEl) []NodeId {
+	refs := make([]NodeId, 0)
+	for _, n := range nodes {
+		if n.El == el {
+			refs = append(refs, n.ID)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,84 @@
+package domain
+
+import "testing"
+
+func TestYongsinEvals_Roles(t *testing.T) {
+	doc := docOf(t, sinsalTestRaw)
+	gods, ok := UsefulGodsOf(doc)
+	if !ok {
+		t.Fatalf("UsefulGodsOf() not found in evals")
+	}
+	yong := fiveElementIdx(gods.Yong)
+	if gods.Hee != fiveElByIdx(yong+4) || gods.Han != fiveElByIdx(yong+1) || gods.Gu != fiveElByIdx(yong+2) || gods.Gi != fiveElByIdx(yong+3) {
+		t.Fatalf("roles = %+v", gods)
+	}
+
+	var yongEval, giEval *EvalItem
+	for i := range doc.Evals {
+		switch doc.Evals[i].K {
+		case EvalYongsin:
+			yongEval = &doc.Evals[i]
+		case EvalGisin:
+			giEval = &doc.Evals[i]
+		}
+	}
+	if yongEval == nil || giEval == nil {
+		t.Fatalf("missing %s / %s eval: %+v", EvalYongsin, EvalGisin, doc.Evals)
+	}
+	v := yongEval.V.(map[string]any)
+	cands := v["candidates"].([]map[string]any)
+	if len(yongEval.Score.Parts) != len(cands) || cands[0]["method"] != v["method"] || yongEval.Score.Total != cands[0]["score"] {
+		t.Fatalf("yongsin eval = %+v", yongEval)
+	}
+	if yongEval.Evidence.Inputs.Params["season"] != "WINTER" { // 丑월
+		t.Fatalf("season = %v", yongEval.Evidence.Inputs.Params["season"])
+	}
+	if giEval.Score.Total != 100-yongEval.Score.Total {
+		t.Fatalf("gisin score = %v, yongsin %v", giEval.Score.Total, yongEval.Score.Total)
+	}
+}
+
+func TestYongsinCandidates(t *testing.T) {
+	// 甲 일간 (목): 신강·비겁 과다 → 관성 금, 신약·재성 과다 → 비겁 목, 신약·관성 과다 → 인성 수
+	strong := [5]float64{0.5, 0.1, 0.1, 0.1, 0.2}
+	if c := yongsinEokbu(0, strong, 80); c.El != "METAL" {
+		t.Errorf("strong eokbu = %+v, want METAL", c)
+	}
+	if c := yongsinEokbu(0, [5]float64{0.1, 0.1, 0.5, 0.2, 0.1}, 20); c.El != "WOOD" {
+		t.Errorf("weak wealth eokbu = %+v, want WOOD", c)
+	}
+	if c := yongsinEokbu(0, [5]float64{0.1, 0.1, 0.2, 0.5, 0.1}, 20); c.El != "WATER" {
+		t.Errorf("weak officer eokbu = %+v, want WATER", c)
+	}
+
+	// 子월 화 부족 → 조후 화 가산, 寅월은 조후 없음
+	if c, ok := yongsinJohu(0, [5]float64{0.3, 0.05, 0.2, 0.2, 0.25}); !ok || c.El != "FIRE" || c.Score != 87 {
+		t.Errorf("johu = %+v %v", c, ok)
+	}
+	if _, ok := yongsinJohu(2, strong); ok {
+		t.Error("寅월 should have no johu candidate")
+	}
+
+	// 목·토 대치 → 화 통관
+	if c, ok := yongsinTonggwan([5]float64{0.35, 0.05, 0.35, 0.1, 0.15}); !ok || c.El != "FIRE" {
+		t.Errorf("tonggwan = %+v %v", c, ok)
+	}
+	// 수 과다 → 토 병약, 억부 용신이 수면 병약 없음
+	if c, ok := yongsinByeongyak([5]float64{0.1, 0.05, 0.1, 0.2, 0.55}, "WOOD"); !ok || c.El != "EARTH" {
+		t.Errorf("byeongyak = %+v %v", c, ok)
+	}
+	if _, ok := yongsinByeongyak([5]float64{0.1, 0.05, 0.1, 0.2, 0.55}, "WATER"); ok {
+		t.Error("byeongyak must skip the eokbu element")
+	}
+}
+
+func TestUsefulGodSupportFrom(t *testing.T) {
+	doc := docOf(t, sinsalTestRaw)
+	gods, _ := UsefulGodsOf(doc)
+	rich, poor := [5]float64{}, [5]float64{}
+	rich[fiveElementIdx(gods.Yong)], rich[fiveElementIdx(gods.Hee)] = 0.5, 0.5
+	poor[fiveElementIdx(gods.Gi)], poor[fiveElementIdx(gods.Gu)] = 0.5, 0.5
+	if hi, lo := usefulGodSupportFrom(doc, rich), usefulGodSupportFrom(doc, poor); hi <= 0.5 || lo >= 0.5 {
+		t.Fatalf("support rich=%v poor=%v", hi, lo)
+	}
+}
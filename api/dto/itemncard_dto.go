@@ -18,6 +18,8 @@ type BirthInput struct {
 	Date            string `json:"date"`             // YYYY-MM-DD
 	Time            string `json:"time"`             // HH:mm or "unknown"
 	TimePrecision   string `json:"time_precision"`   // minute | hour | unknown
+	Calendar        string `json:"calendar,omitempty"`   // solar | lunar (비면 요청의 calendar, 그것도 없으면 solar)
+	LeapMonth       bool   `json:"leap_month,omitempty"` // 음력 윤달 여부
 }
 
 // PairExtractTestRequest is the request body for pair extraction test.
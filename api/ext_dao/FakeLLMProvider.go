@@ -0,0 +1,243 @@
+// 오프라인 테스트용 LLMProvider: 요청 해시(fixture key)로 미리 기록된 응답을 재생
+package extdao
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Fixture kinds (LLMFixture.Kind). Streamed chat replays the chat fixture.
+const (
+	LLMFixtureChat      = "chat"
+	LLMFixtureVision    = "vision"
+	LLMFixtureEmbedding = "embedding"
+	LLMFixtureImage     = "image"
+)
+
+// ErrNoLLMFixture is returned (wrapped with kind and key) when a request has no recorded answer.
+var ErrNoLLMFixture = errors.New("no llm fixture for request")
+
+// LLMFixture is one recorded answer; Key is the *FixtureKey of the request it answers.
+type LLMFixture struct {
+	Kind        string    `json:"kind"`
+	Key         string    `json:"key"`
+	Text        string    `json:"text,omitempty"`         // chat / vision
+	Embedding   []float32 `json:"embedding,omitempty"`    // embedding
+	ImageBase64 string    `json:"image_base64,omitempty"` // image
+	Usage       *Usage    `json:"usage,omitempty"`
+	Error       string    `json:"error,omitempty"` // 설정시 해당 에러로 실패
+
+	Request *LLMFixtureRequest `json:"request,omitempty"` // 기록된 요청 (cassette, 참고용)
+}
+
+// LLMCall records one request served (or missed) by FakeLLMProvider.
+type LLMCall struct {
+	Kind string
+	Key  string
+	Hit  bool
+}
+
+// FakeLLMProvider is a deterministic LLMProvider for tests: every request is keyed by a hash of
+// its content and answered from fixtures, never from the network.
+type FakeLLMProvider struct {
+	// StreamChunkSize is the number of runes per chunk when replaying a streamed chat (default 8).
+	StreamChunkSize int
+
+	mu       sync.Mutex
+	fixtures map[string]LLMFixture
+	calls    []LLMCall
+}
+
+var _ LLMProvider = (*FakeLLMProvider)(nil)
+
+func NewFakeLLMProvider(fixtures ...LLMFixture) *FakeLLMProvider {
+	f := &FakeLLMProvider{fixtures: map[string]LLMFixture{}}
+	for _, fx := range fixtures {
+		f.Add(fx)
+	}
+	return f
+}
+
+// LoadFakeLLMProvider builds a fake from JSON files, each holding an array of LLMFixture.
+func LoadFakeLLMProvider(paths ...string) (*FakeLLMProvider, error) {
+	f := NewFakeLLMProvider()
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read llm fixtures: %w", err)
+		}
+		var fixtures []LLMFixture
+		if err := json.Unmarshal(data, &fixtures); err != nil {
+			return nil, fmt.Errorf("failed to parse llm fixtures %s: %w", path, err)
+		}
+		for _, fx := range fixtures {
+			f.Add(fx)
+		}
+	}
+	return f, nil
+}
+
+func (f *FakeLLMProvider) Add(fx LLMFixture) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fixtures[fx.Kind+":"+fx.Key] = fx
+}
+
+func (f *FakeLLMProvider) AddChat(req ChatCompletionRequest, text string, usage *Usage) {
+	f.Add(LLMFixture{Kind: LLMFixtureChat, Key: ChatFixtureKey(req), Text: text, Usage: usage})
+}
+
+func (f *FakeLLMProvider) AddVision(req VisionAnalysisRequest, text string, usage *Usage) {
+	f.Add(LLMFixture{Kind: LLMFixtureVision, Key: VisionFixtureKey(req), Text: text, Usage: usage})
+}
+
+func (f *FakeLLMProvider) AddEmbedding(model, input string, embedding []float32, usage *Usage) {
+	f.Add(LLMFixture{Kind: LLMFixtureEmbedding, Key: EmbeddingFixtureKey(model, input), Embedding: embedding, Usage: usage})
+}
+
+func (f *FakeLLMProvider) AddImage(req ImageGenerationRequest, image []byte, usage *Usage) {
+	f.Add(LLMFixture{Kind: LLMFixtureImage, Key: ImageFixtureKey(req), ImageBase64: base64.StdEncoding.EncodeToString(image), Usage: usage})
+}
+
+// Calls returns the requests seen so far, in order.
+func (f *FakeLLMProvider) Calls() []LLMCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]LLMCall(nil), f.calls...)
+}
+
+func (f *FakeLLMProvider) lookup(kind, key string) (LLMFixture, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fx, ok := f.fixtures[kind+":"+key]
+	f.calls = append(f.calls, LLMCall{Kind: kind, Key: key, Hit: ok})
+	if !ok {
+		return fx, fmt.Errorf("%w: kind=%s key=%s", ErrNoLLMFixture, kind, key)
+	}
+	if fx.Error != "" {
+		return fx, errors.New(fx.Error)
+	}
+	return fx, nil
+}
+
+func fixtureUsage(fx LLMFixture) *Usage {
+	if fx.Usage != nil {
+		u := *fx.Usage
+		return &u
+	}
+	return &Usage{}
+}
+
+func (f *FakeLLMProvider) ChatCompletion(ctx context.Context, req ChatCompletionRequest) (string, *Usage, error) {
+	if err := ctx.Err(); err != nil {
+		return "", nil, err
+	}
+	fx, err := f.lookup(LLMFixtureChat, ChatFixtureKey(req))
+	if err != nil {
+		return "", nil, err
+	}
+	return fx.Text, fixtureUsage(fx), nil
+}
+
+// StreamChatCompletion replays the chat fixture in StreamChunkSize-rune chunks.
+func (f *FakeLLMProvider) StreamChatCompletion(ctx context.Context, req ChatCompletionRequest, onChunk func(string) error) (*Usage, error) {
+	fx, err := f.lookup(LLMFixtureChat, ChatFixtureKey(req))
+	if err != nil {
+		return nil, err
+	}
+	size := f.StreamChunkSize
+	if size <= 0 {
+		size = 8
+	}
+	runes := []rune(fx.Text)
+	for i := 0; i < len(runes); i += size {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := onChunk(string(runes[i:min(i+size, len(runes))])); err != nil {
+			return nil, err
+		}
+	}
+	return fixtureUsage(fx), nil
+}
+
+func (f *FakeLLMProvider) VisionAnalysis(ctx context.Context, req VisionAnalysisRequest) (string, *Usage, error) {
+	if err := ctx.Err(); err != nil {
+		return "", nil, err
+	}
+	fx, err := f.lookup(LLMFixtureVision, VisionFixtureKey(req))
+	if err != nil {
+		return "", nil, err
+	}
+	return fx.Text, fixtureUsage(fx), nil
+}
+
+func (f *FakeLLMProvider) CreateEmbedding(ctx context.Context, model, input string) ([]float32, *Usage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	fx, err := f.lookup(LLMFixtureEmbedding, EmbeddingFixtureKey(model, input))
+	if err != nil {
+		return nil, nil, err
+	}
+	return append([]float32(nil), fx.Embedding...), fixtureUsage(fx), nil
+}
+
+func (f *FakeLLMProvider) GenerateImage(ctx context.Context, req ImageGenerationRequest) ([]byte, *Usage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	fx, err := f.lookup(LLMFixtureImage, ImageFixtureKey(req))
+	if err != nil {
+		return nil, nil, err
+	}
+	image, err := base64.StdEncoding.DecodeString(fx.ImageBase64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode fixture image: %w", err)
+	}
+	return image, fixtureUsage(fx), nil
+}
+
+// fixtureKey hashes the request parts; sampling options (temperature, max tokens) are not part of the key.
+func fixtureKey(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(strconv.Itoa(len(p))))
+		h.Write([]byte{':'})
+		h.Write([]byte(p))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+func ChatFixtureKey(req ChatCompletionRequest) string {
+	parts := []string{LLMFixtureChat, req.Model}
+	for _, m := range req.Messages {
+		parts = append(parts, m.Role, m.Content)
+	}
+	return fixtureKey(parts...)
+}
+
+func VisionFixtureKey(req VisionAnalysisRequest) string {
+	image := req.ImageURL
+	if len(req.ImageData) > 0 {
+		sum := sha256.Sum256(req.ImageData)
+		image = hex.EncodeToString(sum[:])
+	}
+	return fixtureKey(LLMFixtureVision, req.Model, req.Prompt, image)
+}
+
+func EmbeddingFixtureKey(model, input string) string {
+	return fixtureKey(LLMFixtureEmbedding, model, input)
+}
+
+func ImageFixtureKey(req ImageGenerationRequest) string {
+	return fixtureKey(LLMFixtureImage, req.Model, req.Prompt, req.Size)
+}
@@ -0,0 +1,116 @@
+package extdao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func fixturePath(t *testing.T, name string) string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "testdata", name)
+}
+
+func TestFakeLLMProvider_LoadFixtures(t *testing.T) {
+	f, err := LoadFakeLLMProvider(fixturePath(t, "llm_fixtures.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	text, usage, err := f.ChatCompletion(ctx, ChatCompletionRequest{
+		Model:       "gpt-4o-mini",
+		Messages:    []ChatMessage{{Role: "user", Content: "오늘의 운세를 한 문장으로"}},
+		Temperature: 0.9, // sampling options are not part of the key
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "새로운 인연이 가까이 다가오는 하루입니다." || usage.Total != 39 {
+		t.Fatalf("chat = %q %+v", text, usage)
+	}
+	emb, _, err := f.CreateEmbedding(ctx, "", "봄날의 햇살")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(emb) != 3 || emb[1] != -0.5 {
+		t.Fatalf("embedding = %v", emb)
+	}
+}
+
+func TestFakeLLMProvider_Miss(t *testing.T) {
+	f := NewFakeLLMProvider()
+	req := ChatCompletionRequest{Messages: []ChatMessage{{Role: "user", Content: "hi"}}}
+	_, _, err := f.ChatCompletion(context.Background(), req)
+	if !errors.Is(err, ErrNoLLMFixture) || !strings.Contains(err.Error(), ChatFixtureKey(req)) {
+		t.Fatalf("err = %v, want ErrNoLLMFixture with key", err)
+	}
+	calls := f.Calls()
+	if len(calls) != 1 || calls[0].Hit || calls[0].Kind != LLMFixtureChat {
+		t.Fatalf("calls = %+v", calls)
+	}
+}
+
+func TestFakeLLMProvider_KeysDistinguishContent(t *testing.T) {
+	a := ChatCompletionRequest{Messages: []ChatMessage{{Role: "system", Content: "ab"}, {Role: "user", Content: "c"}}}
+	b := ChatCompletionRequest{Messages: []ChatMessage{{Role: "system", Content: "a"}, {Role: "user", Content: "bc"}}}
+	if ChatFixtureKey(a) == ChatFixtureKey(b) {
+		t.Fatal("message boundaries must change the key")
+	}
+	v1 := VisionAnalysisRequest{Prompt: "p", ImageData: []byte{1, 2}}
+	v2 := VisionAnalysisRequest{Prompt: "p", ImageData: []byte{1, 3}}
+	if VisionFixtureKey(v1) == VisionFixtureKey(v2) {
+		t.Fatal("image bytes must change the key")
+	}
+}
+
+func TestFakeLLMProvider_VisionImageAndStream(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeLLMProvider()
+	f.StreamChunkSize = 3
+
+	vreq := VisionAnalysisRequest{Model: "gpt-4o-mini", Prompt: "face", ImageData: []byte("jpeg")}
+	f.AddVision(vreq, `{"eyes":"round"}`, nil)
+	if text, usage, err := f.VisionAnalysis(ctx, vreq); err != nil || text != `{"eyes":"round"}` || usage == nil {
+		t.Fatalf("vision = %q %v %v", text, usage, err)
+	}
+
+	ireq := ImageGenerationRequest{Model: "gpt-image-1-mini", Prompt: "partner", Size: "1024x1024"}
+	f.AddImage(ireq, []byte{0x89, 'P', 'N', 'G'}, &Usage{Total: 7})
+	if img, usage, err := f.GenerateImage(ctx, ireq); err != nil || !bytes.Equal(img, []byte{0x89, 'P', 'N', 'G'}) || usage.Total != 7 {
+		t.Fatalf("image = %v %v %v", img, usage, err)
+	}
+
+	creq := ChatCompletionRequest{Messages: []ChatMessage{{Role: "user", Content: "stream"}}}
+	f.AddChat(creq, `{"summary": "목(木) 기운"}`, nil)
+	var chunks []string
+	if _, err := f.StreamChatCompletion(ctx, creq, func(c string) error {
+		chunks = append(chunks, c)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(chunks, "") != `{"summary": "목(木) 기운"}` || len(chunks) != 8 {
+		t.Fatalf("chunks = %q", chunks)
+	}
+
+	got, usage, err := StreamSajuAnalysis(ctx, f, creq, func(FieldDelta) error { return nil })
+	if err != nil || got.Summary != "목(木) 기운" || usage == nil {
+		t.Fatalf("StreamSajuAnalysis = %+v %v %v", got, usage, err)
+	}
+}
+
+func TestQueryLLM(t *testing.T) {
+	if _, _, err := QueryLLM(context.Background(), nil, "text", "", "p", 0, 0, "", nil); !errors.Is(err, ErrLLMNotConfigured) {
+		t.Fatalf("nil provider err = %v", err)
+	}
+	f := NewFakeLLMProvider()
+	f.AddImage(ImageGenerationRequest{Model: "dall-e-3", Prompt: "p", Size: "512x512"}, []byte("img"), nil)
+	out, _, err := QueryLLM(context.Background(), f, "image", "dall-e-3", "p", 0, 0, "512x512", nil)
+	if err != nil || out != "aW1n" {
+		t.Fatalf("image query = %q %v", out, err)
+	}
+}
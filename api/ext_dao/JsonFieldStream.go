@@ -0,0 +1,215 @@
+// 스트리밍 LLM 응답(JSON)에서 최상위 문자열 필드의 증분 텍스트 추출
+package extdao
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// FieldDelta is text appended to a top-level string field since the previous Feed.
+type FieldDelta struct {
+	Field string
+	Delta string
+}
+
+// JSONFieldStream incrementally scans a streamed JSON object and reports the decoded text of
+// watched top-level string fields as it arrives. Text before the first '{' (e.g. ```json) is
+// skipped, nested objects/arrays are skipped, and escapes/UTF-8 split across chunks are handled.
+type JSONFieldStream struct {
+	fields map[string]bool
+
+	started, finished bool
+	depth             int
+	inString, escape  bool
+	hex               []byte // \uXXXX 수집중인 hex (nil 이면 아님)
+	highSurrogate     rune
+
+	expectKey, afterColon bool
+	isKey                 bool
+	key, lastKey          []byte
+	capture               string // 현재 수집중인 필드 ("" 이면 버림)
+
+	out    []FieldDelta
+	buf    []byte // capture 필드의 미방출 바이트 (UTF-8 미완성 꼬리 포함)
+	bufFor string
+}
+
+func NewJSONFieldStream(fields ...string) *JSONFieldStream {
+	m := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		m[f] = true
+	}
+	return &JSONFieldStream{fields: m}
+}
+
+// Feed consumes the next chunk and returns the deltas it completed, in order.
+func (p *JSONFieldStream) Feed(chunk string) []FieldDelta {
+	p.out = nil
+	for i := 0; i < len(chunk); i++ {
+		p.step(chunk[i])
+	}
+	p.flush(false)
+	return p.out
+}
+
+// Done reports whether the top-level object has been closed.
+func (p *JSONFieldStream) Done() bool {
+	return p.finished
+}
+
+func (p *JSONFieldStream) step(c byte) {
+	if p.finished {
+		return
+	}
+	if !p.started {
+		if c == '{' {
+			p.started, p.depth, p.expectKey = true, 1, true
+		}
+		return
+	}
+	if p.inString {
+		p.stringByte(c)
+		return
+	}
+	switch c {
+	case '"':
+		p.inString = true
+		if p.depth == 1 && p.expectKey {
+			p.isKey, p.expectKey = true, false
+			p.key = p.key[:0]
+		} else if p.depth == 1 && p.afterColon {
+			p.afterColon = false
+			if p.fields[string(p.lastKey)] {
+				p.capture = string(p.lastKey)
+			}
+		}
+	case ':':
+		if p.depth == 1 {
+			p.afterColon = true
+		}
+	case ',':
+		if p.depth == 1 {
+			p.expectKey, p.afterColon = true, false
+		}
+	case '{', '[':
+		if p.depth == 1 {
+			p.afterColon = false
+		}
+		p.depth++
+	case '}', ']':
+		p.depth--
+		if p.depth == 0 {
+			p.finished = true
+		}
+	case ' ', '\t', '\r', '\n':
+	default: // number / literal value
+		if p.depth == 1 {
+			p.afterColon = false
+		}
+	}
+}
+
+func (p *JSONFieldStream) stringByte(c byte) {
+	switch {
+	case p.hex != nil:
+		p.hex = append(p.hex, c)
+		if len(p.hex) < 4 {
+			return
+		}
+		n, err := strconv.ParseUint(string(p.hex), 16, 32)
+		p.hex = nil
+		p.escape = false
+		if err != nil {
+			return
+		}
+		r := rune(n)
+		switch {
+		case r >= 0xD800 && r < 0xDC00:
+			p.highSurrogate = r
+			return
+		case r >= 0xDC00 && r < 0xE000 && p.highSurrogate != 0:
+			r = (p.highSurrogate-0xD800)<<10 + (r - 0xDC00) + 0x10000
+		}
+		p.highSurrogate = 0
+		p.writeRune(r)
+	case p.escape:
+		if c == 'u' {
+			p.hex = make([]byte, 0, 4)
+			return
+		}
+		p.escape = false
+		switch c {
+		case 'n':
+			c = '\n'
+		case 't':
+			c = '\t'
+		case 'r':
+			c = '\r'
+		case 'b':
+			c = '\b'
+		case 'f':
+			c = '\f'
+		}
+		p.write(c)
+	case c == '\\':
+		p.escape = true
+	case c == '"':
+		p.inString = false
+		if p.isKey {
+			p.isKey = false
+			p.lastKey = append(p.lastKey[:0], p.key...)
+		} else if p.capture != "" {
+			p.flush(true)
+			p.capture = ""
+		}
+	default:
+		p.write(c)
+	}
+}
+
+func (p *JSONFieldStream) writeRune(r rune) {
+	var b [utf8.UTFMax]byte
+	n := utf8.EncodeRune(b[:], r)
+	for _, c := range b[:n] {
+		p.write(c)
+	}
+}
+
+func (p *JSONFieldStream) write(c byte) {
+	switch {
+	case p.isKey:
+		p.key = append(p.key, c)
+	case p.capture != "":
+		if p.bufFor != p.capture {
+			p.flush(true)
+			p.bufFor = p.capture
+		}
+		p.buf = append(p.buf, c)
+	}
+}
+
+// flush emits buffered text; unless final, an incomplete trailing UTF-8 sequence is kept.
+func (p *JSONFieldStream) flush(final bool) {
+	if len(p.buf) == 0 {
+		return
+	}
+	cut := len(p.buf)
+	if !final {
+		start := cut - 1
+		for start > 0 && cut-start < utf8.UTFMax && !utf8.RuneStart(p.buf[start]) {
+			start--
+		}
+		if !utf8.FullRune(p.buf[start:]) {
+			cut = start
+		}
+	}
+	if cut == 0 {
+		return
+	}
+	if n := len(p.out); n > 0 && p.out[n-1].Field == p.bufFor {
+		p.out[n-1].Delta += string(p.buf[:cut])
+	} else {
+		p.out = append(p.out, FieldDelta{Field: p.bufFor, Delta: string(p.buf[:cut])})
+	}
+	p.buf = append(p.buf[:0], p.buf[cut:]...)
+}
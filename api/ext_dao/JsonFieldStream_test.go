@@ -0,0 +1,61 @@
+package extdao
+
+import (
+	"testing"
+)
+
+const sampleSajuStream = "```json\n{\n  \"nickname\": \"봄날의 \\\"햇살\\\"\",\n  \"sex\": \"female\",\n  \"age\": 29,\n" +
+	"  \"summary\": \"따뜻한 목(木) 기운\\n두 번째 줄 caf\\u00e9 \\ud83c\\udf38\",\n" +
+	"  \"life_reading\": {\"summary\": \"nested - 무시\", \"growth_theme\": [\"a\", {\"b\": \"c\"}]},\n" +
+	"  \"content\": \"본문 \\\\ 백슬래시\\t탭\",\n  \"partner_tips\": \"\"\n}\n```"
+
+func TestJSONFieldStream_AllChunkSizes(t *testing.T) {
+	want := map[string]string{
+		"nickname":     `봄날의 "햇살"`,
+		"summary":      "따뜻한 목(木) 기운\n두 번째 줄 café 🌸",
+		"content":      "본문 \\ 백슬래시\t탭",
+		"partner_tips": "",
+	}
+	for size := 1; size <= len(sampleSajuStream); size++ {
+		p := NewJSONFieldStream("nickname", "summary", "content", "partner_tips")
+		got := map[string]string{}
+		order := []string{}
+		for i := 0; i < len(sampleSajuStream); i += size {
+			end := min(i+size, len(sampleSajuStream))
+			for _, d := range p.Feed(sampleSajuStream[i:end]) {
+				if _, seen := got[d.Field]; !seen {
+					order = append(order, d.Field)
+				}
+				got[d.Field] += d.Delta
+			}
+		}
+		if !p.Done() {
+			t.Fatalf("size %d: object not finished", size)
+		}
+		for f, w := range want {
+			if got[f] != w {
+				t.Fatalf("size %d: field %q = %q, want %q", size, f, got[f], w)
+			}
+		}
+		if _, ok := got["sex"]; ok {
+			t.Fatalf("size %d: unwatched field emitted", size)
+		}
+		if len(order) != 3 || order[0] != "nickname" || order[1] != "summary" || order[2] != "content" {
+			t.Fatalf("size %d: field order = %v", size, order)
+		}
+	}
+}
+
+func TestJSONFieldStream_DeltasAreValidUTF8(t *testing.T) {
+	p := NewJSONFieldStream("summary")
+	src := `{"summary": "한글 텍스트"}`
+	for i := 0; i < len(src); i++ {
+		for _, d := range p.Feed(src[i : i+1]) {
+			for _, r := range d.Delta {
+				if r == '�' {
+					t.Fatalf("delta %q contains a broken rune", d.Delta)
+				}
+			}
+		}
+	}
+}
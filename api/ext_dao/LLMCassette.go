@@ -0,0 +1,211 @@
+// LLM 호출 record/replay: record 모드는 실제 provider 응답을 요청 해시별 파일로 저장, replay 모드는 파일만으로 응답 (네트워크 없음)
+package extdao
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cassette modes (LLM_CASSETTE)
+const (
+	CassetteOff    = ""
+	CassetteRecord = "record"
+	CassetteReplay = "replay"
+)
+
+// LLMFixtureRequest describes the recorded request for humans reading a cassette; it is not used for matching.
+type LLMFixtureRequest struct {
+	Model       string        `json:"model,omitempty"`
+	Messages    []ChatMessage `json:"messages,omitempty"`
+	Prompt      string        `json:"prompt,omitempty"`
+	ImageURL    string        `json:"image_url,omitempty"`
+	ImageSHA256 string        `json:"image_sha256,omitempty"`
+	Input       string        `json:"input,omitempty"`
+	Size        string        `json:"size,omitempty"`
+}
+
+// CassetteLLMProvider records inner's answers to dir (record) or serves them back from dir (replay).
+// One file per request: <dir>/<kind>_<key>.json holding an LLMFixture.
+type CassetteLLMProvider struct {
+	mode   string
+	dir    string
+	inner  LLMProvider
+	replay *FakeLLMProvider
+}
+
+var _ LLMProvider = (*CassetteLLMProvider)(nil)
+
+// NewCassetteLLMProvider: record needs inner (the real provider); replay loads every cassette in dir.
+func NewCassetteLLMProvider(mode, dir string, inner LLMProvider) (*CassetteLLMProvider, error) {
+	c := &CassetteLLMProvider{mode: mode, dir: dir, inner: inner}
+	switch mode {
+	case CassetteRecord:
+		if inner == nil {
+			return nil, fmt.Errorf("cassette record mode needs a provider: %w", ErrLLMNotConfigured)
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create cassette dir: %w", err)
+		}
+	case CassetteReplay:
+		replay, err := loadCassetteDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		c.replay = replay
+	default:
+		return nil, fmt.Errorf("invalid cassette mode: %q", mode)
+	}
+	return c, nil
+}
+
+func loadCassetteDir(dir string) (*FakeLLMProvider, error) {
+	f := NewFakeLLMProvider()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		var fx LLMFixture
+		if err := json.Unmarshal(data, &fx); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+		f.Add(fx)
+	}
+	return f, nil
+}
+
+// Mode returns CassetteRecord or CassetteReplay.
+func (c *CassetteLLMProvider) Mode() string {
+	return c.mode
+}
+
+// Calls returns the replayed requests (replay mode only).
+func (c *CassetteLLMProvider) Calls() []LLMCall {
+	if c.replay == nil {
+		return nil
+	}
+	return c.replay.Calls()
+}
+
+// save writes a successful answer; failures are not recorded so a flaky call can simply be re-recorded.
+func (c *CassetteLLMProvider) save(fx LLMFixture) error {
+	data, err := json.MarshalIndent(fx, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(c.dir, fx.Kind+"_"+fx.Key+".json")
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+func (c *CassetteLLMProvider) ChatCompletion(ctx context.Context, req ChatCompletionRequest) (string, *Usage, error) {
+	if c.replay != nil {
+		return c.replay.ChatCompletion(ctx, req)
+	}
+	text, usage, err := c.inner.ChatCompletion(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+	err = c.save(LLMFixture{
+		Kind: LLMFixtureChat, Key: ChatFixtureKey(req), Text: text, Usage: usage,
+		Request: &LLMFixtureRequest{Model: req.Model, Messages: req.Messages},
+	})
+	return text, usage, err
+}
+
+// StreamChatCompletion records the concatenated stream as a chat cassette (replayed in chunks).
+func (c *CassetteLLMProvider) StreamChatCompletion(ctx context.Context, req ChatCompletionRequest, onChunk func(string) error) (*Usage, error) {
+	if c.replay != nil {
+		return c.replay.StreamChatCompletion(ctx, req, onChunk)
+	}
+	var full strings.Builder
+	usage, err := c.inner.StreamChatCompletion(ctx, req, func(chunk string) error {
+		full.WriteString(chunk)
+		return onChunk(chunk)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = c.save(LLMFixture{
+		Kind: LLMFixtureChat, Key: ChatFixtureKey(req), Text: full.String(), Usage: usage,
+		Request: &LLMFixtureRequest{Model: req.Model, Messages: req.Messages},
+	})
+	return usage, err
+}
+
+func (c *CassetteLLMProvider) VisionAnalysis(ctx context.Context, req VisionAnalysisRequest) (string, *Usage, error) {
+	if c.replay != nil {
+		return c.replay.VisionAnalysis(ctx, req)
+	}
+	text, usage, err := c.inner.VisionAnalysis(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+	request := &LLMFixtureRequest{Model: req.Model, Prompt: req.Prompt, ImageURL: req.ImageURL}
+	if len(req.ImageData) > 0 {
+		sum := sha256.Sum256(req.ImageData)
+		request.ImageSHA256 = hex.EncodeToString(sum[:])
+	}
+	err = c.save(LLMFixture{Kind: LLMFixtureVision, Key: VisionFixtureKey(req), Text: text, Usage: usage, Request: request})
+	return text, usage, err
+}
+
+func (c *CassetteLLMProvider) CreateEmbedding(ctx context.Context, model, input string) ([]float32, *Usage, error) {
+	if c.replay != nil {
+		return c.replay.CreateEmbedding(ctx, model, input)
+	}
+	embedding, usage, err := c.inner.CreateEmbedding(ctx, model, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.save(LLMFixture{
+		Kind: LLMFixtureEmbedding, Key: EmbeddingFixtureKey(model, input), Embedding: embedding, Usage: usage,
+		Request: &LLMFixtureRequest{Model: model, Input: input},
+	})
+	return embedding, usage, err
+}
+
+func (c *CassetteLLMProvider) GenerateImage(ctx context.Context, req ImageGenerationRequest) ([]byte, *Usage, error) {
+	if c.replay != nil {
+		return c.replay.GenerateImage(ctx, req)
+	}
+	image, usage, err := c.inner.GenerateImage(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = c.save(LLMFixture{
+		Kind: LLMFixtureImage, Key: ImageFixtureKey(req), ImageBase64: base64.StdEncoding.EncodeToString(image), Usage: usage,
+		Request: &LLMFixtureRequest{Model: req.Model, Prompt: req.Prompt, Size: req.Size},
+	})
+	return image, usage, err
+}
+
+// NewLLMProvider builds the provider for the given settings: OpenAI (nil without apiKey),
+// wrapped in a cassette when cassetteMode is record / replay (replay works without a key).
+func NewLLMProvider(apiKey, cassetteMode, cassetteDir string) (LLMProvider, error) {
+	var openaiProvider LLMProvider
+	if apiKey != "" {
+		openaiProvider = newOpenAIExtDao(apiKey)
+	}
+	if cassetteMode == CassetteOff {
+		return openaiProvider, nil
+	}
+	cassette, err := NewCassetteLLMProvider(cassetteMode, cassetteDir, openaiProvider)
+	if err != nil {
+		return nil, err
+	}
+	return cassette, nil
+}
@@ -0,0 +1,96 @@
+package extdao
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+)
+
+// testLLM returns the provider for ext_dao pipeline tests: replays testdata/cassettes by default;
+// LLM_CASSETTE=record OPENAI_API_KEY=... go test ./ext_dao re-records them against OpenAI.
+func testLLM(t *testing.T) *CassetteLLMProvider {
+	t.Helper()
+	mode := os.Getenv("LLM_CASSETTE")
+	if mode == CassetteOff {
+		mode = CassetteReplay
+	}
+	llm, err := NewLLMProvider(os.Getenv("OPENAI_API_KEY"), mode, fixturePath(t, "cassettes"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return llm.(*CassetteLLMProvider)
+}
+
+func TestCassette_RecordThenReplay(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	chatReq := ChatCompletionRequest{Model: "gpt-4o-mini", Messages: []ChatMessage{{Role: "user", Content: "사주"}}}
+	imageReq := ImageGenerationRequest{Model: "gpt-image-1-mini", Prompt: "partner", Size: "1024x1024"}
+	visionReq := VisionAnalysisRequest{Model: "gpt-4o-mini", Prompt: "face", ImageData: []byte{0xff, 0xd8, 0xff}}
+
+	upstream := NewFakeLLMProvider()
+	upstream.AddChat(chatReq, `{"summary":"목"}`, &Usage{Input: 3, Output: 4, Total: 7})
+	upstream.AddImage(imageReq, []byte{0x89, 'P', 'N', 'G'}, &Usage{Total: 9})
+	upstream.AddVision(visionReq, `{"face_shape":"oval"}`, nil)
+	upstream.AddEmbedding("", "text", []float32{0.5, 0.25}, &Usage{Input: 2, Total: 2})
+
+	rec, err := NewCassetteLLMProvider(CassetteRecord, dir, upstream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := rec.ChatCompletion(ctx, chatReq); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := rec.GenerateImage(ctx, imageReq); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := rec.VisionAnalysis(ctx, visionReq); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := rec.CreateEmbedding(ctx, "", "text"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := rec.ChatCompletion(ctx, ChatCompletionRequest{Messages: []ChatMessage{{Role: "user", Content: "x"}}}); err == nil {
+		t.Fatal("upstream miss should fail in record mode")
+	}
+
+	play, err := NewCassetteLLMProvider(CassetteReplay, dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, usage, err := play.ChatCompletion(ctx, chatReq)
+	if err != nil || text != `{"summary":"목"}` || usage.Total != 7 {
+		t.Fatalf("chat replay = %q %+v %v", text, usage, err)
+	}
+	img, usage, err := play.GenerateImage(ctx, imageReq)
+	if err != nil || string(img) != "\x89PNG" || usage.Total != 9 {
+		t.Fatalf("image replay = %v %+v %v", img, usage, err)
+	}
+	if text, _, err := play.VisionAnalysis(ctx, visionReq); err != nil || text != `{"face_shape":"oval"}` {
+		t.Fatalf("vision replay = %q %v", text, err)
+	}
+	if emb, usage, err := play.CreateEmbedding(ctx, "", "text"); err != nil || len(emb) != 2 || usage.Total != 2 {
+		t.Fatalf("embedding replay = %v %+v %v", emb, usage, err)
+	}
+	var streamed string
+	usage, err = play.StreamChatCompletion(ctx, chatReq, func(c string) error { streamed += c; return nil })
+	if err != nil || streamed != `{"summary":"목"}` || usage.Total != 7 {
+		t.Fatalf("stream replay = %q %+v %v", streamed, usage, err)
+	}
+	if _, _, err := play.ChatCompletion(ctx, ChatCompletionRequest{Messages: []ChatMessage{{Role: "user", Content: "x"}}}); !errors.Is(err, ErrNoLLMFixture) {
+		t.Fatalf("unrecorded request err = %v", err)
+	}
+}
+
+func TestNewLLMProvider(t *testing.T) {
+	if llm, err := NewLLMProvider("", CassetteOff, ""); err != nil || llm != nil {
+		t.Fatalf("no key = %v %v, want nil", llm, err)
+	}
+	if _, err := NewLLMProvider("", CassetteRecord, t.TempDir()); !errors.Is(err, ErrLLMNotConfigured) {
+		t.Fatalf("record without key err = %v", err)
+	}
+	if _, err := NewLLMProvider("", "rewind", ""); err == nil {
+		t.Fatal("invalid mode should fail")
+	}
+}
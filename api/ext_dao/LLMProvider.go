@@ -0,0 +1,130 @@
+// LLM 호출 추상화: 서비스/ext dao 는 OpenAIExtDao 대신 LLMProvider 를 주입받아 사용 (테스트는 FakeLLMProvider)
+package extdao
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"sajudating_api/api/config"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// LLMProvider covers every LLM capability the services use: chat (plain and streamed),
+// vision, embeddings and image generation. OpenAIExtDao is the production implementation.
+type LLMProvider interface {
+	ChatCompletion(ctx context.Context, req ChatCompletionRequest) (string, *Usage, error)
+	StreamChatCompletion(ctx context.Context, req ChatCompletionRequest, onChunk func(string) error) (*Usage, error)
+	VisionAnalysis(ctx context.Context, req VisionAnalysisRequest) (string, *Usage, error)
+	CreateEmbedding(ctx context.Context, model, input string) ([]float32, *Usage, error)
+	GenerateImage(ctx context.Context, req ImageGenerationRequest) ([]byte, *Usage, error)
+}
+
+var _ LLMProvider = (*OpenAIExtDao)(nil)
+
+var (
+	defaultLLMMu       sync.Mutex
+	defaultLLMProvider LLMProvider
+)
+
+// DefaultLLMProvider returns the process-wide provider: the one set by SetDefaultLLMProvider,
+// otherwise the configured one (OpenAI, wrapped in a cassette when LLM_CASSETTE is set), otherwise nil.
+func DefaultLLMProvider() LLMProvider {
+	defaultLLMMu.Lock()
+	defer defaultLLMMu.Unlock()
+	if defaultLLMProvider != nil {
+		return defaultLLMProvider
+	}
+	if config.AppConfig == nil {
+		return nil
+	}
+	cfg := config.AppConfig.OpenAI
+	llm, err := NewLLMProvider(cfg.APIKey, cfg.Cassette, cfg.CassetteDir)
+	if err != nil {
+		log.Printf("Failed to create LLM provider: %v", err)
+		return nil
+	}
+	defaultLLMProvider = llm
+	return defaultLLMProvider
+}
+
+// SetDefaultLLMProvider replaces the provider returned by DefaultLLMProvider (nil resets to OpenAI).
+// Services pick the default up when they are constructed.
+func SetDefaultLLMProvider(p LLMProvider) {
+	defaultLLMMu.Lock()
+	defer defaultLLMMu.Unlock()
+	defaultLLMProvider = p
+}
+
+// mustLLMProvider keeps the NewOpenAIExtDao contract for constructors without injection.
+func mustLLMProvider() LLMProvider {
+	llm := DefaultLLMProvider()
+	if llm == nil {
+		log.Fatal("OpenAI API key is not configured")
+	}
+	return llm
+}
+
+// ErrLLMNotConfigured is returned when no provider is available (no OpenAI API key).
+var ErrLLMNotConfigured = errors.New("OpenAI API key not configured")
+
+// QueryLLM 쿼리를 위한 공통메소드 - modelType: text, vision, image (image 는 base64 문자열로 반환)
+func QueryLLM(ctx context.Context, llm LLMProvider,
+	modelType string,
+	model, valuedPrompt string, temperature float32, maxTokens int, size string, imageData []byte) (string, *Usage, error) {
+	if llm == nil {
+		return "", nil, ErrLLMNotConfigured
+	}
+	if model == "" {
+		model = openai.GPT4oMini
+	}
+
+	switch modelType {
+	case "text":
+		result, usage, err := llm.ChatCompletion(ctx, ChatCompletionRequest{
+			Model: model,
+			Messages: []ChatMessage{
+				{
+					Role:    "user",
+					Content: valuedPrompt,
+				},
+			},
+			Temperature: temperature,
+			MaxTokens:   maxTokens,
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to chat completion: %w", err)
+		}
+		return result, usage, nil
+	case "vision":
+		result, usage, err := llm.VisionAnalysis(ctx, VisionAnalysisRequest{
+			Model:       model,
+			Prompt:      valuedPrompt,
+			ImageData:   imageData,
+			Temperature: temperature,
+			MaxTokens:   maxTokens,
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to vision analysis: %w", err)
+		}
+		return result, usage, nil
+	case "image":
+		result, usage, err := llm.GenerateImage(ctx, ImageGenerationRequest{
+			Model:  model,
+			Prompt: valuedPrompt,
+			Size:   size,
+			N:      1,
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to generate image: %w", err)
+		}
+		// return base64 encoded image
+		return base64.StdEncoding.EncodeToString(result), usage, nil
+	default:
+		return "", nil, fmt.Errorf("invalid model type: %s", modelType)
+	}
+}
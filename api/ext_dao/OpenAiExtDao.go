@@ -23,9 +23,12 @@ func NewOpenAIExtDao() *OpenAIExtDao {
 		log.Fatal("OpenAI API key is not configured")
 	}
 
-	client := openai.NewClient(apiKey)
+	return newOpenAIExtDao(apiKey)
+}
+
+func newOpenAIExtDao(apiKey string) *OpenAIExtDao {
 	return &OpenAIExtDao{
-		client: client,
+		client: openai.NewClient(apiKey),
 	}
 }
 
@@ -39,14 +42,14 @@ type ChatCompletionRequest struct {
 
 // ChatMessage represents a single message in the conversation
 type ChatMessage struct {
-	Role    string // "system", "user", or "assistant"
-	Content string
+	Role    string `json:"role"` // "system", "user", or "assistant"
+	Content string `json:"content"`
 }
 
 type Usage struct {
-	Input  int
-	Output int
-	Total  int
+	Input  int `json:"input"`
+	Output int `json:"output"`
+	Total  int `json:"total"`
 }
 
 // ChatCompletion sends a chat completion request to OpenAI
@@ -162,8 +165,8 @@ func (dao *OpenAIExtDao) VisionAnalysis(ctx context.Context, req VisionAnalysisR
 	return resp.Choices[0].Message.Content, &usage, nil
 }
 
-// StreamChatCompletion sends a streaming chat completion request
-func (dao *OpenAIExtDao) StreamChatCompletion(ctx context.Context, req ChatCompletionRequest, onChunk func(string) error) error {
+// StreamChatCompletion sends a streaming chat completion request; usage arrives with the last chunk
+func (dao *OpenAIExtDao) StreamChatCompletion(ctx context.Context, req ChatCompletionRequest, onChunk func(string) error) (*Usage, error) {
 	if req.Model == "" {
 		req.Model = openai.GPT4o
 	}
@@ -177,11 +180,12 @@ func (dao *OpenAIExtDao) StreamChatCompletion(ctx context.Context, req ChatCompl
 	}
 
 	chatReq := openai.ChatCompletionRequest{
-		Model:       req.Model,
-		Messages:    messages,
-		Temperature: req.Temperature,
-		MaxTokens:   req.MaxTokens,
-		Stream:      true,
+		Model:         req.Model,
+		Messages:      messages,
+		Temperature:   req.Temperature,
+		MaxTokens:     req.MaxTokens,
+		Stream:        true,
+		StreamOptions: &openai.StreamOptions{IncludeUsage: true},
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 90*time.Second)
@@ -189,30 +193,38 @@ func (dao *OpenAIExtDao) StreamChatCompletion(ctx context.Context, req ChatCompl
 
 	stream, err := dao.client.CreateChatCompletionStream(ctx, chatReq)
 	if err != nil {
-		return fmt.Errorf("failed to create chat completion stream: %w", err)
+		return nil, fmt.Errorf("failed to create chat completion stream: %w", err)
 	}
 	defer stream.Close()
 
+	usage := Usage{}
 	for {
 		response, err := stream.Recv()
 		if err != nil {
 			if err.Error() == "EOF" {
 				break
 			}
-			return fmt.Errorf("stream error: %w", err)
+			return nil, fmt.Errorf("stream error: %w", err)
 		}
 
+		if response.Usage != nil {
+			usage = Usage{
+				Input:  response.Usage.PromptTokens,
+				Output: response.Usage.CompletionTokens,
+				Total:  response.Usage.TotalTokens,
+			}
+		}
 		if len(response.Choices) > 0 {
 			chunk := response.Choices[0].Delta.Content
 			if chunk != "" {
 				if err := onChunk(chunk); err != nil {
-					return err
+					return nil, err
 				}
 			}
 		}
 	}
 
-	return nil
+	return &usage, nil
 }
 
 // EmbeddingRequest represents an embedding request
@@ -222,7 +234,7 @@ type EmbeddingRequest struct {
 }
 
 // CreateEmbedding creates an embedding for the given text
-func (dao *OpenAIExtDao) CreateEmbedding(ctx context.Context, model, input string) ([]float32, error) {
+func (dao *OpenAIExtDao) CreateEmbedding(ctx context.Context, model, input string) ([]float32, *Usage, error) {
 	EmbeddingModel := openai.SmallEmbedding3
 	if model != "" {
 		EmbeddingModel = openai.EmbeddingModel(model)
@@ -237,14 +249,15 @@ func (dao *OpenAIExtDao) CreateEmbedding(ctx context.Context, model, input strin
 
 	resp, err := dao.client.CreateEmbeddings(ctx, embReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create embedding: %w", err)
+		return nil, nil, fmt.Errorf("failed to create embedding: %w", err)
 	}
 
 	if len(resp.Data) == 0 {
-		return nil, fmt.Errorf("no embedding data returned")
+		return nil, nil, fmt.Errorf("no embedding data returned")
 	}
 
-	return resp.Data[0].Embedding, nil
+	usage := Usage{Input: resp.Usage.PromptTokens, Total: resp.Usage.TotalTokens}
+	return resp.Data[0].Embedding, &usage, nil
 }
 
 // ImageGenerationRequest represents an image generation request
@@ -315,58 +328,9 @@ func (dao *OpenAIExtDao) GenerateImage(ctx context.Context, req ImageGenerationR
 	return nil, nil, fmt.Errorf("no valid image data in response")
 }
 
-// 쿼리를 위한 공통메소드
+// 쿼리를 위한 공통메소드 (QueryLLM 참고)
 func (dao *OpenAIExtDao) Query(ctx context.Context,
 	modelType string, // text, vision, image
 	model, valuedPrompt string, temperature float32, maxTokens int, size string, imageData []byte) (string, *Usage, error) {
-
-	if model == "" {
-		model = openai.GPT4oMini
-	}
-
-	if modelType == "text" {
-		result, resp, err := dao.ChatCompletion(ctx, ChatCompletionRequest{
-			Model: model,
-			Messages: []ChatMessage{
-				{
-					Role:    "user",
-					Content: valuedPrompt,
-				},
-			},
-			Temperature: temperature,
-			MaxTokens:   maxTokens,
-		})
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to chat completion: %w", err)
-		}
-		return result, resp, err
-	} else if modelType == "vision" {
-		result, resp, err := dao.VisionAnalysis(ctx, VisionAnalysisRequest{
-			Model:       model,
-			Prompt:      valuedPrompt,
-			ImageData:   imageData,
-			ImageURL:    "",
-			Temperature: temperature,
-			MaxTokens:   maxTokens,
-		})
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to vision analysis: %w", err)
-		}
-		return result, resp, err
-	} else if modelType == "image" {
-		result, resp, err := dao.GenerateImage(ctx, ImageGenerationRequest{
-			Model:  model,
-			Prompt: valuedPrompt,
-			Size:   size,
-			N:      1,
-		})
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to generate image: %w", err)
-		}
-		// return base64 encoded image
-		return base64.StdEncoding.EncodeToString(result), resp, nil
-	} else {
-		return "", nil, fmt.Errorf("invalid model type: %s", modelType)
-	}
-
+	return QueryLLM(ctx, dao, modelType, model, valuedPrompt, temperature, maxTokens, size, imageData)
 }
@@ -15,13 +15,18 @@ import (
 
 // OpenAiPhyExtDao handles OpenAI-based physiognomy analysis
 type OpenAiPhyExtDao struct {
-	openaiDao *OpenAIExtDao
+	llm LLMProvider
 }
 
 // NewOpenAiPhyExtDao creates a new OpenAiPhyExtDao instance
 func NewOpenAiPhyExtDao() *OpenAiPhyExtDao {
+	return NewOpenAiPhyExtDaoWithLLM(mustLLMProvider())
+}
+
+// NewOpenAiPhyExtDaoWithLLM creates an OpenAiPhyExtDao on the given provider
+func NewOpenAiPhyExtDaoWithLLM(llm LLMProvider) *OpenAiPhyExtDao {
 	return &OpenAiPhyExtDao{
-		openaiDao: NewOpenAIExtDao(),
+		llm: llm,
 	}
 }
 
@@ -199,7 +204,7 @@ func (dao *OpenAiPhyExtDao) ExtractFaceFeatures(ctx context.Context, imageBase64
 	}
 
 	// Call Vision API
-	responseText, _, err := dao.openaiDao.VisionAnalysis(ctx, VisionAnalysisRequest{
+	responseText, _, err := dao.llm.VisionAnalysis(ctx, VisionAnalysisRequest{
 		Model:       "gpt-4o-mini",
 		Prompt:      prompt,
 		ImageData:   imageData,
@@ -249,7 +254,7 @@ func (dao *OpenAiPhyExtDao) InterpretPhysiognomy(ctx context.Context, faceFeatur
 		MaxTokens:   3000,
 	}
 
-	responseText, _, err := dao.openaiDao.ChatCompletion(ctx, chatReq)
+	responseText, _, err := dao.llm.ChatCompletion(ctx, chatReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get OpenAI response: %w", err)
 	}
@@ -310,7 +315,7 @@ func (dao *OpenAiPhyExtDao) GenerateIdealPartnerImage(ctx context.Context, userD
 		N:      1,
 	}
 
-	imageBytes, _, err := dao.openaiDao.GenerateImage(ctx, imageReq)
+	imageBytes, _, err := dao.llm.GenerateImage(ctx, imageReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate ideal partner image: %w", err)
 	}
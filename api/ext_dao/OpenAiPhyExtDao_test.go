@@ -0,0 +1,46 @@
+package extdao
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"testing"
+)
+
+// 관상 파이프라인: 얼굴 특징 추출(vision) → 해석(chat) → 이상형 이미지(image)
+func TestPhyPipeline_Cassette(t *testing.T) {
+	ctx := context.Background()
+	image, err := os.ReadFile(fixturePath(t, "face.jpg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dao := NewOpenAiPhyExtDaoWithLLM(testLLM(t))
+
+	features, err := dao.ExtractFaceFeatures(ctx, "data:image/jpeg;base64,"+base64.StdEncoding.EncodeToString(image))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if features.FaceShape == "" || features.Eyes.Shape == "" {
+		t.Fatalf("incomplete features: %+v", features)
+	}
+
+	phy, err := dao.InterpretPhysiognomy(ctx, features, "female", "29")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phy.Summary == "" || phy.IdealPartnerPhysiognomy.PartnerSummary == "" {
+		t.Fatalf("incomplete interpretation: %+v", phy)
+	}
+	if phy.GetPartnerAge() == 25 && phy.IdealPartnerPhysiognomy.PartnerAge.String() != "25" {
+		t.Errorf("partner age not parsed: %q", phy.IdealPartnerPhysiognomy.PartnerAge.String())
+	}
+
+	img, err := dao.GenerateIdealPartnerImage(ctx, phy, "male")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(img, []byte("\x89PNG")) {
+		t.Fatalf("image is not a PNG (%d bytes)", len(img))
+	}
+}
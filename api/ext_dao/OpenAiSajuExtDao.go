@@ -11,13 +11,18 @@ import (
 
 // OpenAiSajuExtDao handles OpenAI-based Saju analysis
 type OpenAiSajuExtDao struct {
-	openaiDao *OpenAIExtDao
+	llm LLMProvider
 }
 
 // NewOpenAiSajuExtDao creates a new OpenAiSajuExtDao instance
 func NewOpenAiSajuExtDao() *OpenAiSajuExtDao {
+	return NewOpenAiSajuExtDaoWithLLM(mustLLMProvider())
+}
+
+// NewOpenAiSajuExtDaoWithLLM creates an OpenAiSajuExtDao on the given provider
+func NewOpenAiSajuExtDaoWithLLM(llm LLMProvider) *OpenAiSajuExtDao {
 	return &OpenAiSajuExtDao{
-		openaiDao: NewOpenAIExtDao(),
+		llm: llm,
 	}
 }
 
@@ -72,30 +77,67 @@ func buildPrompt(req SajuAnalysisRequest) string {
 	return fmt.Sprintf(GetPrompt(PromptTypeSaju), req.Gender, birthInfo, paljaInfo)
 }
 
-// AnalyzeSaju performs Saju analysis using OpenAI
-func (dao *OpenAiSajuExtDao) AnalyzeSaju(ctx context.Context, req SajuAnalysisRequest) (*SajuAnalysisResponse, error) {
-	// Build the prompt
-	prompt := buildPrompt(req)
+// SajuStreamFields are the SajuAnalysisResponse fields streamed as deltas by AnalyzeSajuStream.
+var SajuStreamFields = []string{"nickname", "summary", "content", "partner_tips"}
 
-	// Call OpenAI API
-	chatReq := ChatCompletionRequest{
+func sajuChatRequest(req SajuAnalysisRequest) ChatCompletionRequest {
+	return ChatCompletionRequest{
 		Model: "gpt-4o-mini", // Using gpt-4o-mini as closest to gpt-4.1-mini
 		Messages: []ChatMessage{
 			{
 				Role:    "user",
-				Content: prompt,
+				Content: buildPrompt(req),
 			},
 		},
 		Temperature: 0.6,
 		MaxTokens:   3000,
 	}
+}
 
-	responseText, _, err := dao.openaiDao.ChatCompletion(ctx, chatReq)
+// AnalyzeSaju performs Saju analysis using OpenAI
+func (dao *OpenAiSajuExtDao) AnalyzeSaju(ctx context.Context, req SajuAnalysisRequest) (*SajuAnalysisResponse, error) {
+	responseText, _, err := dao.llm.ChatCompletion(ctx, sajuChatRequest(req))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get OpenAI response: %w", err)
 	}
+	return ParseSajuAnalysisResponse(responseText)
+}
+
+// AnalyzeSajuStream is the streaming variant of AnalyzeSaju: onDelta receives text appended to
+// SajuStreamFields as the model writes them; the fully parsed response and usage are returned at the end.
+func (dao *OpenAiSajuExtDao) AnalyzeSajuStream(ctx context.Context, req SajuAnalysisRequest, onDelta func(FieldDelta) error) (*SajuAnalysisResponse, *Usage, error) {
+	return StreamSajuAnalysis(ctx, dao.llm, sajuChatRequest(req), onDelta)
+}
+
+// StreamSajuAnalysis streams chatReq (a prompt answering in SajuAnalysisResponse JSON) and reports field deltas.
+func StreamSajuAnalysis(ctx context.Context, llm LLMProvider, chatReq ChatCompletionRequest, onDelta func(FieldDelta) error) (*SajuAnalysisResponse, *Usage, error) {
+	if llm == nil {
+		return nil, nil, ErrLLMNotConfigured
+	}
+	parser := NewJSONFieldStream(SajuStreamFields...)
+	var full strings.Builder
+	usage, err := llm.StreamChatCompletion(ctx, chatReq, func(chunk string) error {
+		full.WriteString(chunk)
+		for _, d := range parser.Feed(chunk) {
+			if err := onDelta(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to stream OpenAI response: %w", err)
+	}
+	resp, err := ParseSajuAnalysisResponse(full.String())
+	if err != nil {
+		return nil, usage, err
+	}
+	return resp, usage, nil
+}
 
-	// Extract JSON from response (handle cases where response might have extra text)
+// ParseSajuAnalysisResponse extracts and parses the JSON object in an LLM answer
+// (handle cases where response might have extra text).
+func ParseSajuAnalysisResponse(responseText string) (*SajuAnalysisResponse, error) {
 	responseText = strings.TrimSpace(responseText)
 	start := strings.Index(responseText, "{")
 	end := strings.LastIndex(responseText, "}")
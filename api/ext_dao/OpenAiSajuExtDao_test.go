@@ -0,0 +1,45 @@
+package extdao
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+var cassetteSajuRequest = SajuAnalysisRequest{Gender: "female", Birth: "199603141230", Palja: "병자신묘계미무오"}
+
+func TestAnalyzeSaju_Cassette(t *testing.T) {
+	resp, err := NewOpenAiSajuExtDaoWithLLM(testLLM(t)).AnalyzeSaju(context.Background(), cassetteSajuRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Nickname == "" || resp.Summary == "" || resp.Content == "" || resp.PartnerTips == "" {
+		t.Fatalf("incomplete response: %+v", resp)
+	}
+	if resp.Sex != "female" {
+		t.Errorf("sex = %q", resp.Sex)
+	}
+}
+
+func TestAnalyzeSajuStream_Cassette(t *testing.T) {
+	fields := map[string]*strings.Builder{}
+	resp, usage, err := NewOpenAiSajuExtDaoWithLLM(testLLM(t)).AnalyzeSajuStream(context.Background(), cassetteSajuRequest, func(d FieldDelta) error {
+		if fields[d.Field] == nil {
+			fields[d.Field] = &strings.Builder{}
+		}
+		fields[d.Field].WriteString(d.Delta)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage == nil || usage.Total == 0 {
+		t.Errorf("usage = %+v, want recorded usage", usage)
+	}
+	if fields["summary"] == nil || fields["summary"].String() != resp.Summary {
+		t.Fatalf("streamed summary does not match parsed response")
+	}
+	if fields["content"] == nil || fields["content"].String() != resp.Content {
+		t.Fatalf("streamed content does not match parsed response")
+	}
+}
@@ -2,8 +2,8 @@ package extdao
 
 import (
 	"fmt"
-	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	ttycdom "sajudating_api/api/domain/ttyc"
@@ -12,6 +12,40 @@ import (
 
 const defaultSajuTimezone = "Asia/Seoul"
 
+// 생년월일 역법: 음력 입력은 ttyc 음양력 변환(1900~2100, 윤달 포함) 후 양력으로 계산한다.
+const (
+	CalendarSolar = "SOLAR"
+	CalendarLunar = "LUNAR"
+)
+
+// NormalizeCalendar accepts solar/lunar (대소문자 무관) and 양력/음력; empty means SOLAR.
+func NormalizeCalendar(calendar string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(calendar)) {
+	case "", CalendarSolar, "양력":
+		return CalendarSolar, nil
+	case CalendarLunar, "음력":
+		return CalendarLunar, nil
+	}
+	return "", fmt.Errorf("invalid calendar: %q (expected SOLAR or LUNAR)", calendar)
+}
+
+// ResolveSolarDate converts y-m-d in the given calendar to the solar date.
+// leapMonth is only meaningful for LUNAR (윤달).
+func ResolveSolarDate(calendar string, leapMonth bool, y, m, d int) (int, int, int, error) {
+	cal, err := NormalizeCalendar(calendar)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if cal == CalendarSolar {
+		return y, m, d, nil
+	}
+	solar, err := ttycdom.LunarToSolar(y, m, d, leapMonth)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return solar.Year, solar.Month, solar.Day, nil
+}
+
 // NOTE ABOUT SxtwlExtDao.go
 //
 // This file keeps the legacy SxtwlExtDao API surface for compatibility with
@@ -90,6 +124,11 @@ func (r *SxtwlResult) GetPaljaKorean() string {
 }
 
 func GenPalja(birthdate string, timezone string) (*SxtwlResult, error) {
+	return GenPaljaCalendar(birthdate, timezone, CalendarSolar, false)
+}
+
+// GenPaljaCalendar is GenPalja with the birthdate given in calendar (SOLAR|LUNAR, leapMonth for 윤달).
+func GenPaljaCalendar(birthdate string, timezone string, calendar string, leapMonth bool) (*SxtwlResult, error) {
 	if len(birthdate) != 8 && len(birthdate) != 12 {
 		return nil, fmt.Errorf("invalid birth format: expected YYYYMMDD or YYYYMMDDHHmm, got %q", birthdate)
 	}
@@ -121,20 +160,77 @@ func GenPalja(birthdate string, timezone string) (*SxtwlResult, error) {
 		mm = &mInt
 	}
 
-	palja, err := CallSxtwlOptional(y, m, d, hh, mm, timezone, nil)
+	palja, err := CallSxtwlCalendar(calendar, leapMonth, y, m, d, hh, mm, timezone, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate palja: %w", err)
 	}
 	return palja, nil
 }
 
+// SolarBirthdate converts a YYYYMMDD[HHmm] birthdate in calendar to the solar YYYYMMDD[HHmm] string.
+func SolarBirthdate(birthdate string, calendar string, leapMonth bool) (string, error) {
+	if len(birthdate) != 8 && len(birthdate) != 12 {
+		return "", fmt.Errorf("invalid birth format: expected YYYYMMDD or YYYYMMDDHHmm, got %q", birthdate)
+	}
+	y, errY := strconv.Atoi(birthdate[0:4])
+	m, errM := strconv.Atoi(birthdate[4:6])
+	d, errD := strconv.Atoi(birthdate[6:8])
+	if errY != nil || errM != nil || errD != nil {
+		return "", fmt.Errorf("invalid birth format: %q", birthdate)
+	}
+	sy, sm, sd, err := ResolveSolarDate(calendar, leapMonth, y, m, d)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%04d%02d%02d", sy, sm, sd) + birthdate[8:], nil
+}
+
+// ErrDateOutOfRange is ttyc's ErrOutOfRange: CallSxtwl*/GenPalja* wrap it for dates outside the
+// supported years (1-9999 solar, 1900-2100 lunar).
+var ErrDateOutOfRange = ttycdom.ErrOutOfRange
+
+// CallSxtwlCalendar is CallSxtwlOptional for a birth date in calendar (SOLAR|LUNAR).
+// Lunar input is converted first; Input records the original calendar/date and the solar date used.
+func CallSxtwlCalendar(calendar string, leapMonth bool, y, m, d int, hh, mm *int, timezone string, longitude *float64) (*SxtwlResult, error) {
+	cal, err := NormalizeCalendar(calendar)
+	if err != nil {
+		return nil, err
+	}
+	sy, sm, sd, err := ResolveSolarDate(cal, leapMonth, y, m, d)
+	if err != nil {
+		return nil, err
+	}
+	res, err := CallSxtwlOptional(sy, sm, sd, hh, mm, timezone, longitude)
+	if err != nil {
+		return nil, err
+	}
+	res.Input["calendar"] = cal
+	if cal == CalendarLunar {
+		res.Input["lunar"] = map[string]any{"y": y, "m": m, "d": d, "leap_month": leapMonth}
+	}
+	res.Input["solar_date"] = fmt.Sprintf("%04d-%02d-%02d", sy, sm, sd)
+	return res, nil
+}
+
 func CallSxtwl(y, m, d, hh, mm int, timezone string, longitude *float64) (*SxtwlResult, error) {
 	return CallSxtwlOptional(y, m, d, &hh, &mm, timezone, longitude)
 }
 
 // CallSxtwlOptional keeps the historical API contract but is now backed by ttyc.
 // If hh or mm is nil, hour pillar is omitted (UNKNOWN precision), matching legacy behavior.
+// A non-nil longitude applies the legacy mean solar time correction (MEAN).
 func CallSxtwlOptional(y, m, d int, hh, mm *int, timezone string, longitude *float64) (*SxtwlResult, error) {
+	mode := ""
+	if longitude != nil {
+		mode = string(ttycdom.TtycSolarTimeModeMean)
+	}
+	return CallSxtwlSolarTime(y, m, d, hh, mm, timezone, longitude, mode)
+}
+
+// CallSxtwlSolarTime is CallSxtwlOptional with an explicit solar time mode (NONE|MEAN|APPARENT).
+// MEAN/APPARENT need longitude; day/hour pillars then follow local solar time and
+// Meta["solar_time"] reports the applied correction.
+func CallSxtwlSolarTime(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*SxtwlResult, error) {
 	if err := validateSolarDate(y, m, d); err != nil {
 		return nil, err
 	}
@@ -186,10 +282,17 @@ func CallSxtwlOptional(y, m, d int, hh, mm *int, timezone string, longitude *flo
 		timePrecision = ttycdom.TtycTimePrecisionMinute
 	}
 
+	solarMode, err := ttycdom.NormalizeSolarTimeMode(ttycdom.TtycSolarTimeMode(strings.ToUpper(strings.TrimSpace(solarTimeMode))))
+	if err != nil {
+		return nil, err
+	}
+
 	calc, err := ttycdom.CalculatePillars(ttycdom.TtycPillarCalcInput{
 		Ts:              ts,
 		TzOffsetMinutes: &offsetMinutes,
 		TimePrecision:   timePrecision,
+		SolarTimeMode:   solarMode,
+		Longitude:       longitude,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("ttyc calculate failed: %w", err)
@@ -205,8 +308,8 @@ func CallSxtwlOptional(y, m, d int, hh, mm *int, timezone string, longitude *flo
 
 	if hasTime {
 		actualHour, actualMinute := *hh, *mm
-		if longitude != nil {
-			actualHour, actualMinute = applySolarTimeCorrection(actualHour, actualMinute, offsetMinutes, *longitude)
+		if calc.SolarLocal != nil {
+			actualHour, actualMinute = calc.SolarLocal.Hour, calc.SolarLocal.Minute
 		}
 
 		hStem, hBranch, err := calcHourPillar(calc.Pillars.Day.Stem, actualHour)
@@ -272,13 +375,51 @@ func CallSxtwlOptional(y, m, d int, hh, mm *int, timezone string, longitude *flo
 			"local_time": localISO,
 		},
 	}
+	if calc.SolarTime != nil {
+		res.Meta["solar_time"] = map[string]any{
+			"mode":                 string(calc.SolarTime.Mode),
+			"tz_offset_minutes":    calc.SolarTime.TzOffsetMinutes,
+			"longitude_minutes":    calc.SolarTime.LongitudeMinutes,
+			"equation_of_time_min": calc.SolarTime.EquationOfTimeMinutes,
+			"correction_minutes":   calc.SolarTime.CorrectionMinutes,
+		}
+	}
 
 	return res, nil
 }
 
+// ApplyZishiConvention re-derives day/hour pillars for the 자시 convention (ttyc TtycZishiConvention).
+// CallSxtwl* results follow DAY_CHANGE_00; for 23시대 births YAJASI keeps the 일주 and takes the 子시 천간
+// from the next day's 일간, DAY_CHANGE_23 moves both 일주 and 子시 to the next day.
+func ApplyZishiConvention(res *SxtwlResult, convention string) error {
+	zishi, err := ttycdom.NormalizeZishiConvention(ttycdom.TtycZishiConvention(strings.ToUpper(strings.TrimSpace(convention))))
+	if err != nil {
+		return err
+	}
+	if res.Meta != nil {
+		res.Meta["zishi_convention"] = string(zishi)
+	}
+	if zishi == ttycdom.TtycZishiConventionDayChange00 || res.Pillars.Hour == nil || res.Pillars.Hour.ActualHour != 23 {
+		return nil
+	}
+	hourDayStem := mod(res.Pillars.Day.Tg+1, 10)
+	if zishi == ttycdom.TtycZishiConventionDayChange23 {
+		res.Pillars.Day.Tg = hourDayStem
+		res.Pillars.Day.Dz = mod(res.Pillars.Day.Dz+1, 12)
+	}
+	hStem, hBranch, err := calcHourPillar(hourDayStem, res.Pillars.Hour.ActualHour)
+	if err != nil {
+		return err
+	}
+	res.Pillars.Hour.Tg = hStem
+	res.Pillars.Hour.Dz = hBranch
+	res.Pillars.Hour.DzIndex = hBranch
+	return nil
+}
+
 func validateSolarDate(y, m, d int) error {
-	if y <= 0 {
-		return fmt.Errorf("invalid year: %d", y)
+	if err := ttycdom.CheckYearRange(y); err != nil {
+		return err
 	}
 	if m < 1 || m > 12 {
 		return fmt.Errorf("invalid month: %d", m)
@@ -338,21 +479,6 @@ func calcHourPillar(dayStem, hour int) (int, int, error) {
 	return stem, branch, nil
 }
 
-func applySolarTimeCorrection(hour, minute, tzOffsetMinutes int, longitude float64) (int, int) {
-	timezoneCenterLongitude := (float64(tzOffsetMinutes) / 60.0) * 15.0
-	timeOffsetMinutes := (longitude - timezoneCenterLongitude) * 4.0
-	totalMinutes := float64(hour*60+minute) + timeOffsetMinutes
-
-	hourBase := int(math.Floor(totalMinutes / 60.0))
-	minutePart := math.Mod(totalMinutes, 60.0)
-	if minutePart < 0 {
-		minutePart += 60.0
-	}
-	actualHour := mod(hourBase, 24)
-	actualMinute := int(minutePart)
-	return actualHour, actualMinute
-}
-
 func mod(n, m int) int {
 	return ((n % m) + m) % m
 }
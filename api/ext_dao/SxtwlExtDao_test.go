@@ -142,16 +142,17 @@ func TestCallSxtwlOptional_MinutePrecisionCompat(t *testing.T) {
 		mm   int
 		exp  expect
 	}{
+		// 절입 당일 절입 시각 이전 출생은 이전 월/년주 (1984 입춘 00:18 다음날, 2017 입춘 00:34 다음날, 2021 입춘 23:58, 2030 입동 18:08 KST)
 		{name: "1984-02-02 12:00", y: 1984, m: 2, d: 2, hh: 12, mm: 0, exp: expect{9, 11, 1, 1, 2, 2, 0, 6}},
-		{name: "1984-02-04 12:00", y: 1984, m: 2, d: 4, hh: 12, mm: 0, exp: expect{0, 0, 2, 2, 4, 4, 4, 6}},
-		{name: "2017-02-03 12:30", y: 2017, m: 2, d: 3, hh: 12, mm: 30, exp: expect{3, 9, 8, 2, 7, 9, 0, 6}},
+		{name: "1984-02-04 12:00", y: 1984, m: 2, d: 4, hh: 12, mm: 0, exp: expect{9, 11, 1, 1, 4, 4, 4, 6}},
+		{name: "2017-02-03 12:30", y: 2017, m: 2, d: 3, hh: 12, mm: 30, exp: expect{2, 8, 7, 1, 7, 9, 0, 6}},
 		{name: "2017-02-04 12:30", y: 2017, m: 2, d: 4, hh: 12, mm: 30, exp: expect{3, 9, 8, 2, 8, 10, 2, 6}},
-		{name: "2021-02-03 12:30", y: 2021, m: 2, d: 3, hh: 12, mm: 30, exp: expect{7, 1, 6, 2, 8, 6, 2, 6}},
+		{name: "2021-02-03 12:30", y: 2021, m: 2, d: 3, hh: 12, mm: 30, exp: expect{6, 0, 5, 1, 8, 6, 2, 6}},
 		{name: "2022-02-01 12:00", y: 2022, m: 2, d: 1, hh: 12, mm: 0, exp: expect{7, 1, 7, 1, 1, 9, 8, 6}},
 		{name: "1999-12-31 23:59", y: 1999, m: 12, d: 31, hh: 23, mm: 59, exp: expect{5, 3, 2, 0, 3, 5, 6, 0}},
 		{name: "2000-01-01 00:00", y: 2000, m: 1, d: 1, hh: 0, mm: 0, exp: expect{5, 3, 2, 0, 4, 6, 8, 0}},
 		{name: "2008-08-08 08:08", y: 2008, m: 8, d: 8, hh: 8, mm: 8, exp: expect{4, 0, 6, 8, 6, 4, 6, 4}},
-		{name: "2030-11-07 12:30", y: 2030, m: 11, d: 7, hh: 12, mm: 30, exp: expect{6, 10, 3, 11, 2, 6, 0, 6}},
+		{name: "2030-11-07 12:30", y: 2030, m: 11, d: 7, hh: 12, mm: 30, exp: expect{6, 10, 2, 10, 2, 6, 0, 6}},
 	}
 
 	for _, tc := range tests {
@@ -200,16 +201,17 @@ func TestCallSxtwlOptional_UnknownPrecisionCompat(t *testing.T) {
 		d    int
 		exp  expect
 	}{
+		// 시간 미상은 정오 기준으로 절입 여부 판단
 		{name: "1984-02-02", y: 1984, m: 2, d: 2, exp: expect{9, 11, 1, 1, 2, 2}},
-		{name: "1984-02-04", y: 1984, m: 2, d: 4, exp: expect{0, 0, 2, 2, 4, 4}},
-		{name: "2017-02-03", y: 2017, m: 2, d: 3, exp: expect{3, 9, 8, 2, 7, 9}},
+		{name: "1984-02-04", y: 1984, m: 2, d: 4, exp: expect{9, 11, 1, 1, 4, 4}},
+		{name: "2017-02-03", y: 2017, m: 2, d: 3, exp: expect{2, 8, 7, 1, 7, 9}},
 		{name: "2017-02-04", y: 2017, m: 2, d: 4, exp: expect{3, 9, 8, 2, 8, 10}},
-		{name: "2021-02-03", y: 2021, m: 2, d: 3, exp: expect{7, 1, 6, 2, 8, 6}},
+		{name: "2021-02-03", y: 2021, m: 2, d: 3, exp: expect{6, 0, 5, 1, 8, 6}},
 		{name: "2022-02-01", y: 2022, m: 2, d: 1, exp: expect{7, 1, 7, 1, 1, 9}},
 		{name: "1999-12-31", y: 1999, m: 12, d: 31, exp: expect{5, 3, 2, 0, 3, 5}},
 		{name: "2000-01-01", y: 2000, m: 1, d: 1, exp: expect{5, 3, 2, 0, 4, 6}},
 		{name: "2008-08-08", y: 2008, m: 8, d: 8, exp: expect{4, 0, 6, 8, 6, 4}},
-		{name: "2030-11-07", y: 2030, m: 11, d: 7, exp: expect{6, 10, 3, 11, 2, 6}},
+		{name: "2030-11-07", y: 2030, m: 11, d: 7, exp: expect{6, 10, 2, 10, 2, 6}},
 	}
 
 	for _, tc := range tests {
@@ -236,3 +238,130 @@ func TestCallSxtwlOptional_UnknownPrecisionCompat(t *testing.T) {
 		})
 	}
 }
+
+func TestCallSxtwlCalendar_Lunar(t *testing.T) {
+	// 음력 2023 윤2월 1일 = 양력 2023-03-22
+	lunar, err := CallSxtwlCalendar("lunar", true, 2023, 2, 1, intPtr(9), intPtr(30), "Asia/Seoul", nil)
+	if err != nil {
+		t.Fatalf("CallSxtwlCalendar() error = %v", err)
+	}
+	solar, err := CallSxtwlOptional(2023, 3, 22, intPtr(9), intPtr(30), "Asia/Seoul", nil)
+	if err != nil {
+		t.Fatalf("CallSxtwlOptional() error = %v", err)
+	}
+	if lunar.GetFullPalja() != solar.GetFullPalja() {
+		t.Fatalf("palja = %s, want %s", lunar.GetFullPalja(), solar.GetFullPalja())
+	}
+	if lunar.Input["calendar"] != CalendarLunar || lunar.Input["solar_date"] != "2023-03-22" || lunar.Input["y"] != 2023 || lunar.Input["m"] != 3 {
+		t.Fatalf("input = %+v", lunar.Input)
+	}
+
+	if _, err := CallSxtwlCalendar("LUNAR", true, 2024, 3, 1, nil, nil, "Asia/Seoul", nil); err == nil {
+		t.Fatalf("expected error for missing leap month")
+	}
+	if _, err := CallSxtwlCalendar("julian", false, 2024, 3, 1, nil, nil, "Asia/Seoul", nil); err == nil {
+		t.Fatalf("expected error for invalid calendar")
+	}
+}
+
+func TestSolarBirthdate(t *testing.T) {
+	tests := []struct {
+		birthdate string
+		calendar  string
+		leap      bool
+		want      string
+	}{
+		{birthdate: "202401010930", calendar: "", want: "202401010930"},
+		{birthdate: "202401010930", calendar: "LUNAR", want: "202402100930"},
+		{birthdate: "20200401", calendar: "음력", leap: true, want: "20200523"},
+	}
+	for _, tt := range tests {
+		got, err := SolarBirthdate(tt.birthdate, tt.calendar, tt.leap)
+		if err != nil {
+			t.Fatalf("SolarBirthdate(%q) error = %v", tt.birthdate, err)
+		}
+		if got != tt.want {
+			t.Fatalf("SolarBirthdate(%q, %q) = %q, want %q", tt.birthdate, tt.calendar, got, tt.want)
+		}
+	}
+}
+
+func TestApplyZishiConvention(t *testing.T) {
+	late, err := CallSxtwlOptional(2024, 5, 10, intPtr(23), intPtr(30), "Asia/Seoul", nil)
+	if err != nil {
+		t.Fatalf("CallSxtwlOptional() error = %v", err)
+	}
+	next, err := CallSxtwlOptional(2024, 5, 11, intPtr(0), intPtr(30), "Asia/Seoul", nil)
+	if err != nil {
+		t.Fatalf("CallSxtwlOptional() error = %v", err)
+	}
+	before := late.GetFullPalja()
+	if err := ApplyZishiConvention(late, ""); err != nil {
+		t.Fatalf("ApplyZishiConvention() error = %v", err)
+	}
+	if late.GetFullPalja() != before || late.Meta["zishi_convention"] != "DAY_CHANGE_00" {
+		t.Fatalf("DAY_CHANGE_00 changed palja %s -> %s (meta %+v)", before, late.GetFullPalja(), late.Meta)
+	}
+
+	// 야자시: 일주는 그대로, 子시 천간만 다음날 일간 기준
+	yajasi, err := CallSxtwlOptional(2024, 5, 10, intPtr(23), intPtr(30), "Asia/Seoul", nil)
+	if err != nil {
+		t.Fatalf("CallSxtwlOptional() error = %v", err)
+	}
+	if err := ApplyZishiConvention(yajasi, "yajasi"); err != nil {
+		t.Fatalf("ApplyZishiConvention() error = %v", err)
+	}
+	if yajasi.Pillars.Day != late.Pillars.Day || yajasi.Pillars.Hour.Tg != next.Pillars.Hour.Tg || yajasi.Pillars.Hour.Dz != 0 {
+		t.Fatalf("YAJASI palja = %s, want day of %s and hour of %s", yajasi.GetFullPalja(), before, next.GetFullPalja())
+	}
+
+	if err := ApplyZishiConvention(late, "day_change_23"); err != nil {
+		t.Fatalf("ApplyZishiConvention() error = %v", err)
+	}
+	if late.Pillars.Day != next.Pillars.Day || late.Pillars.Hour.Tg != next.Pillars.Hour.Tg || late.Pillars.Hour.Dz != next.Pillars.Hour.Dz {
+		t.Fatalf("DAY_CHANGE_23 palja = %s, want day/hour of %s", late.GetFullPalja(), next.GetFullPalja())
+	}
+
+	early, err := CallSxtwlOptional(2024, 5, 11, intPtr(0), intPtr(30), "Asia/Seoul", nil)
+	if err != nil {
+		t.Fatalf("CallSxtwlOptional() error = %v", err)
+	}
+	if err := ApplyZishiConvention(early, "DAY_CHANGE_23"); err != nil {
+		t.Fatalf("ApplyZishiConvention() error = %v", err)
+	}
+	if early.GetFullPalja() != next.GetFullPalja() {
+		t.Fatalf("00:30 palja = %s, want %s", early.GetFullPalja(), next.GetFullPalja())
+	}
+	if err := ApplyZishiConvention(early, "NOON"); err == nil {
+		t.Fatalf("expected error for invalid convention")
+	}
+}
+
+func TestCallSxtwlSolarTime_HistoricalOffset(t *testing.T) {
+	seoul := 126.98
+	// 1987 서머타임(UTC+10): 10:30 KDT → 지방평균태양시 08:58 (辰시)
+	res, err := CallSxtwlSolarTime(1987, 7, 1, intPtr(10), intPtr(30), "Asia/Seoul", &seoul, "mean")
+	if err != nil {
+		t.Fatalf("CallSxtwlSolarTime() error = %v", err)
+	}
+	if res.Pillars.Hour.ActualHour != 8 || res.Pillars.Hour.ActualMin != 58 || res.Pillars.Hour.Dz != 4 {
+		t.Fatalf("hour = %+v, want 08:58 辰", *res.Pillars.Hour)
+	}
+	solar, ok := res.Meta["solar_time"].(map[string]any)
+	if !ok || solar["tz_offset_minutes"] != 600 || solar["correction_minutes"] != -92 {
+		t.Fatalf("solar_time meta = %+v", res.Meta["solar_time"])
+	}
+
+	// 레거시 CallSxtwlOptional 의 경도 보정은 MEAN 과 동일
+	legacy, err := CallSxtwlOptional(1987, 7, 1, intPtr(10), intPtr(30), "Asia/Seoul", &seoul)
+	if err != nil {
+		t.Fatalf("CallSxtwlOptional() error = %v", err)
+	}
+	if legacy.GetFullPalja() != res.GetFullPalja() {
+		t.Fatalf("legacy palja = %s, want %s", legacy.GetFullPalja(), res.GetFullPalja())
+	}
+
+	if _, err := CallSxtwlSolarTime(1987, 7, 1, intPtr(10), intPtr(30), "Asia/Seoul", nil, "APPARENT"); err == nil {
+		t.Fatalf("expected error without longitude")
+	}
+}
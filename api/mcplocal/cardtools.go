@@ -25,7 +25,7 @@ type updateCardArgs struct {
 
 // listCardsArgs is the input for the list_cards tool (optional filters).
 type listCardsArgs struct {
-	Scope    string `json:"scope"`    // "saju" | "pair" | ""
+	Scope    string `json:"scope"`    // "saju" | "pair" | "daily" | ""
 	Status   string `json:"status"`   // e.g. "published" | ""
 	Category string `json:"category"` // exact or ""
 	CardID   string `json:"card_id"`  // substring match or ""
@@ -60,8 +60,8 @@ func cardJSONToInput(raw string) (model.ItemNCardInput, error) {
 	if c.CardID == "" {
 		return model.ItemNCardInput{}, fmt.Errorf("card_id is required")
 	}
-	if c.Scope != "saju" && c.Scope != "pair" {
-		return model.ItemNCardInput{}, fmt.Errorf("scope must be saju or pair")
+	if c.Scope != "saju" && c.Scope != "pair" && c.Scope != "daily" {
+		return model.ItemNCardInput{}, fmt.Errorf("scope must be saju, pair or daily")
 	}
 	if c.Title == "" {
 		return model.ItemNCardInput{}, fmt.Errorf("title is required")
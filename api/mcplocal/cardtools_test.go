@@ -35,8 +35,8 @@ func TestCardJSONToInput(t *testing.T) {
 		{"invalid JSON", `{card_id: unquoted}`, true, "invalid card_json"},
 		{"missing card_id", `{"scope":"saju","title":"x","trigger":{},"score":{},"content":{}}`, true, "card_id is required"},
 		{"empty card_id", `{"card_id":"","scope":"saju","title":"x","trigger":{},"score":{},"content":{}}`, true, "card_id is required"},
-		{"invalid scope", `{"card_id":"c","scope":"other","title":"x","trigger":{},"score":{},"content":{}}`, true, "scope must be saju or pair"},
-		{"missing scope", `{"card_id":"c","title":"x","trigger":{},"score":{},"content":{}}`, true, "scope must be saju or pair"},
+		{"invalid scope", `{"card_id":"c","scope":"other","title":"x","trigger":{},"score":{},"content":{}}`, true, "scope must be saju, pair or daily"},
+		{"missing scope", `{"card_id":"c","title":"x","trigger":{},"score":{},"content":{}}`, true, "scope must be saju, pair or daily"},
 		{"missing title", `{"card_id":"c","scope":"saju","trigger":{},"score":{},"content":{}}`, true, "title is required"},
 		{"empty title", `{"card_id":"c","scope":"saju","title":"","trigger":{},"score":{},"content":{}}`, true, "title is required"},
 	}
@@ -22,7 +22,7 @@ type helloArgs struct {
 	Name string `json:"name"`
 }
 
-// NewServer creates an MCP server with default implementation info and registers hello + card register/update tools.
+// NewServer creates an MCP server with default implementation info and registers hello + card register/update + saju search tools.
 func NewServer() *mcp.Server {
 	s := mcp.NewServer(&mcp.Implementation{
 		Name:    ServerName,
@@ -42,7 +42,7 @@ func NewServer() *mcp.Server {
 	})
 	mcp.AddTool(s, &mcp.Tool{
 		Name:        "register_card",
-		Description: "Register a single data card (saju or pair). Accepts card_json: JSON string conforming to CardDataStructure (saju) or ChemiStructure (pair, src P|A|B). Required: card_id, scope, trigger, title. Returns ok and uid or error msg.",
+		Description: "Register a single data card (saju, pair or daily). Accepts card_json: JSON string conforming to CardDataStructure (saju; daily uses 일운 tokens) or ChemiStructure (pair, src P|A|B). Required: card_id, scope, trigger, title. Returns ok and uid or error msg.",
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args registerCardArgs) (*mcp.CallToolResult, any, error) {
 		ok, uid, msg := runRegisterCard(ctx, args.CardJSON)
 		text := formatToolResult(ok, uid, msg)
@@ -62,7 +62,7 @@ func NewServer() *mcp.Server {
 	})
 	mcp.AddTool(s, &mcp.Tool{
 		Name:        "list_cards",
-		Description: "Query existing cards. Optional filters: scope (saju|pair), status, category, card_id (substring), limit (default 50, max 200). Returns list of card summaries: card_id, uid, scope, title, status.",
+		Description: "Query existing cards. Optional filters: scope (saju|pair|daily), status, category, card_id (substring), limit (default 50, max 200). Returns list of card summaries: card_id, uid, scope, title, status.",
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args listCardsArgs) (*mcp.CallToolResult, any, error) {
 		summaries, msg := runListCards(ctx, args.Scope, args.Status, args.Category, args.CardID, args.Limit)
 		if msg != "" {
@@ -75,6 +75,14 @@ func NewServer() *mcp.Server {
 			Content: []mcp.Content{&mcp.TextContent{Text: string(out)}},
 		}, nil, nil
 	})
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "find_saju_datetimes",
+		Description: "Find local datetime windows whose saju chart matches a pillar pattern. pattern: year/month/day[/hour] ganji (hanja or hangul, * wildcard, e.g. \"甲子/丙寅/戊辰/庚午\", \"*/*/壬戌\", \"*/*/*/*子\"). from (inclusive) / to (exclusive): yyyy-mm-dd[ HH:MM]. Optional tz_offset_minutes (default 540), zishi_convention (DAY_CHANGE_00 default|YAJASI|DAY_CHANGE_23), limit (default 1000). Returns windows with start/end and pillars.",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args findSajuDatetimesArgs) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: runFindSajuDatetimes(ctx, args)}},
+		}, nil, nil
+	})
 	return s
 }
 
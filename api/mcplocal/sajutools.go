@@ -0,0 +1,35 @@
+// sajutools.go: MCP tools for saju engine lookups (reverse search by pillar pattern).
+package mcplocal
+
+import (
+	"context"
+	"encoding/json"
+
+	"sajudating_api/api/service"
+)
+
+// findSajuDatetimesArgs is the input for the find_saju_datetimes tool.
+type findSajuDatetimesArgs struct {
+	Pattern         string `json:"pattern"`           // "甲子/丙寅/戊辰/庚午", "*/*/壬戌"
+	From            string `json:"from"`              // local datetime (inclusive), yyyy-mm-dd[ HH:MM]
+	To              string `json:"to"`                // local datetime (exclusive)
+	TzOffsetMinutes *int   `json:"tz_offset_minutes"` // default 540 (KST)
+	ZishiConvention string `json:"zishi_convention"`  // DAY_CHANGE_00 | YAJASI | DAY_CHANGE_23
+	Limit           int    `json:"limit"`             // default 1000, max 10000
+}
+
+func runFindSajuDatetimes(ctx context.Context, args findSajuDatetimesArgs) string {
+	windows, truncated, err := service.FindSajuDatetimes(ctx, service.SajuSearchParams{
+		Pattern:         args.Pattern,
+		From:            args.From,
+		To:              args.To,
+		TzOffsetMinutes: args.TzOffsetMinutes,
+		ZishiConvention: args.ZishiConvention,
+		Limit:           args.Limit,
+	})
+	if err != nil {
+		return formatToolResult(false, "", err.Error())
+	}
+	out, _ := json.Marshal(map[string]any{"ok": true, "truncated": truncated, "windows": windows})
+	return string(out)
+}
@@ -0,0 +1,35 @@
+// sajutools_test.go: unit tests for MCP saju tools (find_saju_datetimes); no DB required.
+package mcplocal
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestRunFindSajuDatetimes(t *testing.T) {
+	var ok struct {
+		Ok        bool              `json:"ok"`
+		Truncated bool              `json:"truncated"`
+		Windows   []json.RawMessage `json:"windows"`
+	}
+	out := runFindSajuDatetimes(context.Background(), findSajuDatetimesArgs{Pattern: "*/*/甲子", From: "2024-01-01", To: "2025-01-01"})
+	if err := json.Unmarshal([]byte(out), &ok); err != nil {
+		t.Fatalf("invalid JSON: %v (%s)", err, out)
+	}
+	if !ok.Ok || len(ok.Windows) != 7 || ok.Truncated {
+		t.Fatalf("unexpected result: %s", out)
+	}
+
+	var bad struct {
+		Ok  bool   `json:"ok"`
+		Msg string `json:"msg"`
+	}
+	out = runFindSajuDatetimes(context.Background(), findSajuDatetimesArgs{Pattern: "甲丑/*/*", From: "2024-01-01", To: "2025-01-01"})
+	if err := json.Unmarshal([]byte(out), &bad); err != nil {
+		t.Fatalf("invalid JSON: %v (%s)", err, out)
+	}
+	if bad.Ok || bad.Msg == "" {
+		t.Fatalf("expected error result, got %s", out)
+	}
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"sajudating_api/api/utils"
+)
+
+// AdminTokenValidator resolves a bearer token to the uid and role of an active admin user.
+type AdminTokenValidator func(token string) (uid string, role string, err error)
+
+// AdminAuthMiddleware validates the "Authorization: Bearer <jwt>" header and, when valid,
+// stores the admin user uid and role in the request context. Requests without a valid token pass
+// through unauthenticated; use RequireAdminAuth (or the GraphQL @auth directive) to reject them.
+func AdminAuthMiddleware(validate AdminTokenValidator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := bearerToken(r)
+			if token != "" {
+				if uid, role, err := validate(token); err == nil && uid != "" {
+					ctx := utils.SetAdminUserUIDToContext(r.Context(), uid)
+					r = r.WithContext(utils.SetAdminUserRoleToContext(ctx, role))
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireAdminAuth rejects requests that AdminAuthMiddleware did not authenticate.
+func RequireAdminAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := utils.GetAdminUserUIDFromContext(r.Context()); err != nil {
+			utils.RespondWithError(w, http.StatusUnauthorized, "Authentication required")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func bearerToken(r *http.Request) string {
+	h := r.Header.Get("Authorization")
+	if len(h) < 7 || !strings.EqualFold(h[:7], "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(h[7:])
+}
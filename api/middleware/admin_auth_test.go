@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sajudating_api/api/utils"
+)
+
+func testValidator(token string) (string, string, error) {
+	if token == "good" {
+		return "admin-1", "editor", nil
+	}
+	return "", "", errors.New("invalid token")
+}
+
+func TestAdminAuthMiddleware_SetsContext(t *testing.T) {
+	var gotUID, gotRole string
+	h := AdminAuthMiddleware(testValidator)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUID, _ = utils.GetAdminUserUIDFromContext(r.Context())
+		gotRole = utils.GetAdminUserRoleFromContext(r.Context())
+	}))
+
+	cases := []struct {
+		header string
+		want   string
+	}{
+		{"Bearer good", "admin-1"},
+		{"bearer good", "admin-1"},
+		{"Bearer bad", ""},
+		{"good", ""},
+		{"", ""},
+	}
+	for _, c := range cases {
+		gotUID, gotRole = "", ""
+		req := httptest.NewRequest(http.MethodPost, "/api/admgql", nil)
+		if c.header != "" {
+			req.Header.Set("Authorization", c.header)
+		}
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		if gotUID != c.want {
+			t.Errorf("header %q: uid = %q, want %q", c.header, gotUID, c.want)
+		}
+		if c.want != "" && gotRole != "editor" {
+			t.Errorf("header %q: role = %q, want editor", c.header, gotRole)
+		}
+	}
+}
+
+func TestRequireAdminAuth(t *testing.T) {
+	h := AdminAuthMiddleware(testValidator)(RequireAdminAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/adm/saju_extract_test", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("no token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/adm/saju_extract_test", nil)
+	req.Header.Set("Authorization", "Bearer good")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("valid token: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+
+	"sajudating_api/api/utils"
+)
+
+const RequestIDHeader = "X-Request-Id"
+
+// RequestIDMiddleware takes the incoming X-Request-Id (or generates one), echoes it in the
+// response header and stores it in the request context (utils.GetRequestIDFromContext).
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" || len(id) > 128 {
+			id = utils.GenUid()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(utils.SetRequestIDToContext(r.Context(), id)))
+	})
+}
@@ -1,4 +1,4 @@
-// Package routes: admin-only extraction test endpoints (saju, pair).
+// Package routes: admin-only extraction test endpoints (saju, pair) and batch chart computation.
 package routes
 
 import (
@@ -9,9 +9,12 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
+// RouteAdm expects admin auth (server.go); every route also needs llm:generate like the admgql generation tests.
 func RouteAdm(r chi.Router) {
+	r.Use(service.RequireAdminPerm(service.PermLLMGenerate))
 	r.Post("/saju_extract_test", service.RunSajuExtractTest)
 	r.Post("/pair_extract_test", service.RunPairExtractTest)
 	r.Post("/llm_context_preview", service.RunLLMContextPreview)
+	r.Post("/saju_batch", service.RunSajuBatch)
 	log.Println("Admin extraction test routes initialized")
 }
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestRouteAdm_ViewerForbidden(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := utils.SetAdminUserUIDToContext(req.Context(), "viewer-1")
+			next.ServeHTTP(w, req.WithContext(utils.SetAdminUserRoleToContext(ctx, entity.AdminRoleViewer)))
+		})
+	})
+	r.Route("/api/adm", RouteAdm)
+
+	for _, path := range []string{"/saju_extract_test", "/pair_extract_test", "/llm_context_preview", "/saju_batch"} {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/adm"+path, nil))
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("viewer POST %s: status = %d, want %d", path, rec.Code, http.StatusForbidden)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Package routes: public almanac (만세력) endpoints.
+package routes
+
+import (
+	"log"
+
+	"sajudating_api/api/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func RouteAlmanac(r chi.Router) {
+	r.Get("/", service.GetAlmanac) // 만세력 (?from=&to=&tzOffsetMinutes=), 파라미터 없으면 오늘 일진
+	log.Println("Almanac routes initialized")
+}
@@ -11,8 +11,11 @@ import (
 func RouteSajuProfile(r chi.Router) {
 	r.Get("/{uid}", sajuProfileService.GetSajuProfile)                                 // 프로필 조회 모든 영역
 	r.Get("/{uid}/saju", sajuProfileService.GetSajuProfileSajuResult)                  // 사주 결과만 조회
+	r.Get("/{uid}/saju/stream", sajuProfileService.StreamSajuProfileSaju)              // 사주 해석 스트리밍 (SSE)
 	r.Get("/{uid}/kwansang", sajuProfileService.GetSajuProfileKwansangResult)          // 관상 결과만 조회
 	r.Get("/{uid}/partner_image", sajuProfileService.GetSajuProfilePartnerImageResult) // 파트너 이미지 조회
+	r.Get("/{uid}/events", sajuProfileService.GetSajuProfileEvents)                    // 진행상태 SSE 스트림
+	r.Get("/{uid}/daily", sajuProfileService.GetSajuProfileDaily)                      // 일운 조회 (?date=yyyy-mm-dd, 기본 오늘)
 
 	r.Post("/", sajuProfileService.CreateSajuProfile)
 	r.Put("/{uid}", sajuProfileService.UpdateSajuProfile) // 이메일 업데이트
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 
@@ -34,12 +35,19 @@ func main() {
 	r := chi.NewRouter()
 
 	r.Use(middleware.CORSMiddleware)
+	r.Use(middleware.RequestIDMiddleware)
+
+	// admin bearer token → admin uid in context (rejection is done by @auth / RequireAdminAuth)
+	adminAuth := middleware.AdminAuthMiddleware(service.NewAdminUserService().AuthenticateToken)
 
 	// admin management graphql
 	gqlAdmService := handler.NewDefaultServer(
-		admgql_generated.NewExecutableSchema(admgql_generated.Config{Resolvers: &admgql.Resolver{}}),
+		admgql_generated.NewExecutableSchema(admgql_generated.Config{
+			Resolvers:  &admgql.Resolver{},
+			Directives: admgql_generated.DirectiveRoot{Auth: admgql.Auth, HasPerm: admgql.HasPerm},
+		}),
 	)
-	r.Post("/api/admgql", func(w http.ResponseWriter, r *http.Request) {
+	r.With(adminAuth).Post("/api/admgql", func(w http.ResponseWriter, r *http.Request) {
 		gqlAdmService.ServeHTTP(w, r)
 	})
 	r.Get("/api/admimg/*", service.GetAdminImage)
@@ -52,10 +60,18 @@ func main() {
 		log.Println("MCP server enabled at /mcp")
 	}
 
+	// background job workers (사주 프로필 분석 파이프라인) - 중단된 job 복구 후 시작
+	jobQueue := service.GetJobQueueService()
+	sajuProfileJobs := service.NewSajuProfileService()
+	sajuProfileJobs.RegisterJobHandlers(jobQueue)
+	jobQueue.Start(context.Background())
+	sajuProfileJobs.StartDailyScheduler(context.Background()) // 일운 피드: KST 날짜가 바뀔 때마다 전 프로필 갱신
+
 	// init user api route
 	routes.InitRoutes()
 	r.Route("/api/saju_profile", routes.RouteSajuProfile)
-	r.Route("/api/adm", routes.RouteAdm)
+	r.Route("/api/almanac", routes.RouteAlmanac)
+	r.With(adminAuth, middleware.RequireAdminAuth).Route("/api/adm", routes.RouteAdm)
 
 	port := config.AppConfig.Server.Port
 	log.Fatal(http.ListenAndServe(":"+port, r))
@@ -16,16 +16,18 @@ import (
 
 type AdminAIMetaService struct {
 	aimetaRepo *dao.AIMetaRepository
+	audit      *AdminAuditService
 }
 
 func NewAdminAIMetaService() *AdminAIMetaService {
 	return &AdminAIMetaService{
 		aimetaRepo: dao.NewAIMetaRepository(),
+		audit:      NewAdminAuditService(),
 	}
 }
 
 func (s *AdminAIMetaService) PutAiMeta(ctx context.Context, input model.AiMetaInput) (*model.SimpleResult, error) {
-	var meta *entity.AIMeta
+	var meta, before *entity.AIMeta
 	var err error
 
 	// If UID is provided, update existing meta; otherwise, create new one
@@ -38,6 +40,8 @@ func (s *AdminAIMetaService) PutAiMeta(ctx context.Context, input model.AiMetaIn
 				Msg: utils.StrPtr(fmt.Sprintf("AI Meta not found: %v", err)),
 			}, nil
 		}
+		prev := *meta
+		before = &prev
 
 		meta.Name = input.Name
 		meta.Desc = input.Desc
@@ -83,6 +87,7 @@ func (s *AdminAIMetaService) PutAiMeta(ctx context.Context, input model.AiMetaIn
 			}, nil
 		}
 	}
+	s.audit.Record(ctx, "putAiMeta", AuditTargetAiMeta, meta.Uid, before, meta)
 
 	return &model.SimpleResult{
 		Ok:  true,
@@ -92,7 +97,7 @@ func (s *AdminAIMetaService) PutAiMeta(ctx context.Context, input model.AiMetaIn
 
 func (s *AdminAIMetaService) DelAiMeta(ctx context.Context, uid string) (*model.SimpleResult, error) {
 	// Check if the meta exists
-	_, err := s.aimetaRepo.FindByUID(uid)
+	meta, err := s.aimetaRepo.FindByUID(uid)
 	if err != nil {
 		return &model.SimpleResult{
 			Ok:  false,
@@ -107,6 +112,7 @@ func (s *AdminAIMetaService) DelAiMeta(ctx context.Context, uid string) (*model.
 			Msg: utils.StrPtr(fmt.Sprintf("Failed to delete AI Meta: %v", err)),
 		}, nil
 	}
+	s.audit.Record(ctx, "delAiMeta", AuditTargetAiMeta, uid, meta, nil)
 
 	return &model.SimpleResult{Ok: true}, nil
 }
@@ -123,6 +129,7 @@ func (s *AdminAIMetaService) SetAiMetaDefault(ctx context.Context, uid string) (
 
 	// For now, just return success. In the future, you might want to add a "is_default" field
 	// to the AIMeta entity and update it here.
+	s.audit.Record(ctx, "setAiMetaDefault", AuditTargetAiMeta, uid, meta, meta)
 	return &model.SimpleResult{
 		Ok:  true,
 		UID: &meta.Uid,
@@ -189,7 +196,7 @@ func (s *AdminAIMetaService) GetAiMetaTypes(ctx context.Context) (*model.SimpleR
 	metaTypes = append(metaTypes, model.AiMetaType{
 		ID:             "saju",
 		Type:           "Saju",
-		InputFields:    []string{"sex", "birthdate"},
+		InputFields:    []string{"sex", "birthdate", "calendar", "leap_month"},
 		OutputFields:   []string{"partner_sex", "palja", "age"},
 		HasInputImage:  false,
 		HasOutputImage: false,
@@ -198,7 +205,7 @@ func (s *AdminAIMetaService) GetAiMetaTypes(ctx context.Context) (*model.SimpleR
 	metaTypes = append(metaTypes, model.AiMetaType{
 		ID:             "face_feature",
 		Type:           "FaceFeature",
-		InputFields:    []string{"sex", "birthdate"},
+		InputFields:    []string{"sex", "birthdate", "calendar", "leap_month"},
 		OutputFields:   []string{"partner_sex", "palja", "age"},
 		HasInputImage:  true,
 		HasOutputImage: false,
@@ -207,7 +214,7 @@ func (s *AdminAIMetaService) GetAiMetaTypes(ctx context.Context) (*model.SimpleR
 	metaTypes = append(metaTypes, model.AiMetaType{
 		ID:   "phy",
 		Type: "Phy",
-		InputFields: []string{"sex", "birthdate",
+		InputFields: []string{"sex", "birthdate", "calendar", "leap_month",
 			"phy_features_json"},
 		OutputFields: []string{
 			"partner_sex", "palja", "age", // gen by sex, birthdate
@@ -219,7 +226,7 @@ func (s *AdminAIMetaService) GetAiMetaTypes(ctx context.Context) (*model.SimpleR
 	metaTypes = append(metaTypes, model.AiMetaType{
 		ID:   "ideal_partner_image_male",
 		Type: "IdealPartnerImageMale",
-		InputFields: []string{"sex", "birthdate",
+		InputFields: []string{"sex", "birthdate", "calendar", "leap_month",
 			"partner_age", "partner_eyes", "partner_nose", "partner_mouth", "partner_face_shape"},
 		OutputFields: []string{
 			"partner_sex", "palja", "age", // gen by sex, birthdate
@@ -231,7 +238,7 @@ func (s *AdminAIMetaService) GetAiMetaTypes(ctx context.Context) (*model.SimpleR
 	metaTypes = append(metaTypes, model.AiMetaType{
 		ID:   "ideal_partner_image_female",
 		Type: "IdealPartnerImageFemale",
-		InputFields: []string{"sex", "birthdate",
+		InputFields: []string{"sex", "birthdate", "calendar", "leap_month",
 			"partner_age", "partner_eyes", "partner_nose", "partner_mouth", "partner_face_shape"},
 		OutputFields: []string{
 			"partner_sex", "palja", "age", // gen by sex, birthdate
@@ -258,12 +265,18 @@ func GetAiMetaValues(metaType string, inputValues map[string]string) map[string]
 		ret["partner_sex"] = "male"
 	}
 
-	// 생년월일에 따른 기본값
+	// 생년월일에 따른 기본값 (calendar=LUNAR 면 음력 생년월일, leap_month=true 면 윤달)
 	ret["birthdate"] = inputValues["birthdate"]
 	ret["age"] = "0"
 	if len(ret["birthdate"]) >= 8 {
-		ret["age"] = utils.GetAgeFromBirthdate(ret["birthdate"])
-		palja, err := extdao.GenPalja(ret["birthdate"], "Asia/Seoul")
+		calendar := inputValues["calendar"]
+		leapMonth := inputValues["leap_month"] == "true" || inputValues["leap_month"] == "1"
+		solarBirthdate := ret["birthdate"]
+		if solar, err := extdao.SolarBirthdate(ret["birthdate"], calendar, leapMonth); err == nil {
+			solarBirthdate = solar
+		}
+		ret["age"] = utils.GetAgeFromBirthdate(solarBirthdate)
+		palja, err := extdao.GenPaljaCalendar(ret["birthdate"], "Asia/Seoul", calendar, leapMonth)
 		if err != nil {
 			log.Printf("Failed to generate palja: %v", err)
 			return ret
@@ -312,12 +325,29 @@ func (s *AdminAIMetaService) SetAiMetaInUse(ctx context.Context, uid string) (*m
 		}, nil
 	}
 
+	// 같은 meta_type 에서 꺼지는 meta 들도 감사 기록을 남기기 위해 미리 조회
+	sameType, err := s.aimetaRepo.FindByMetaType(meta.MetaType)
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr(fmt.Sprintf("Failed to retrieve AI Metas: %v", err)),
+		}, nil
+	}
 	if err := s.aimetaRepo.UpdateNotInUseByMetaType(meta.MetaType, uid); err != nil {
 		return &model.SimpleResult{
 			Ok:  false,
 			Err: utils.StrPtr(fmt.Sprintf("Failed to update AI Meta: %v", err)),
 		}, nil
 	}
+	for i := range sameType {
+		prev := &sameType[i]
+		if prev.Uid == uid || !prev.InUse {
+			continue
+		}
+		deactivated := *prev
+		deactivated.InUse = false
+		s.audit.Record(ctx, "unsetAiMetaInUse", AuditTargetAiMeta, prev.Uid, prev, &deactivated)
+	}
 
 	if err := s.aimetaRepo.UpdateInUse(uid); err != nil {
 		return &model.SimpleResult{
@@ -325,6 +355,9 @@ func (s *AdminAIMetaService) SetAiMetaInUse(ctx context.Context, uid string) (*m
 			Err: utils.StrPtr(fmt.Sprintf("Failed to update AI Meta: %v", err)),
 		}, nil
 	}
+	if after, err := s.aimetaRepo.FindByUID(uid); err == nil {
+		s.audit.Record(ctx, "setAiMetaInUse", AuditTargetAiMeta, uid, meta, after)
+	}
 
 	return &model.SimpleResult{Ok: true}, nil
 }
@@ -11,16 +11,27 @@ import (
 	"sajudating_api/api/dao/entity"
 	extdao "sajudating_api/api/ext_dao"
 	"sajudating_api/api/utils"
+	"sajudating_api/api/utils/dslog"
 	"time"
 )
 
 type AdminAiExecutionService struct {
 	aiExecutionRepo *dao.AiExecutionRepository
+	audit           *AdminAuditService
+	llm             extdao.LLMProvider
+	sajuProfileRepo *dao.SajuProfileRepository
 }
 
 func NewAdminAiExecutionService() *AdminAiExecutionService {
+	return NewAdminAiExecutionServiceWithLLM(extdao.DefaultLLMProvider())
+}
+
+func NewAdminAiExecutionServiceWithLLM(llm extdao.LLMProvider) *AdminAiExecutionService {
 	return &AdminAiExecutionService{
 		aiExecutionRepo: dao.NewAiExecutionRepository(),
+		audit:           NewAdminAuditService(),
+		llm:             llm,
+		sajuProfileRepo: dao.NewSajuProfileRepository(),
 	}
 }
 
@@ -130,7 +141,10 @@ func (s *AdminAiExecutionService) RunAiExecution(ctx context.Context, input mode
 	if err := s.aiExecutionRepo.Create(&aiExecution); err != nil {
 		return nil, fmt.Errorf("failed to create ai execution: %w", err)
 	}
-	openAiExtDao := extdao.NewOpenAIExtDao()
+	// 관리자 실행만 감사 기록 (시스템 실행은 ctx 에 관리자 없음); 실패 포함 최종 상태 기록
+	defer func() {
+		s.audit.Record(ctx, "runAiExecution", AuditTargetAiExecution, aiExecution.Uid, nil, &aiExecution)
+	}()
 	imageData := []byte{}
 	var err error
 	modelType := "text"
@@ -156,7 +170,7 @@ func (s *AdminAiExecutionService) RunAiExecution(ctx context.Context, input mode
 		modelType = "image"
 	}
 	runnedTime := time.Now().UnixMilli()
-	result, usage, err := openAiExtDao.Query(ctx, modelType, input.Model, input.ValuedPrompt, float32(input.Temperature), input.MaxTokens, input.Size, imageData)
+	result, usage, err := extdao.QueryLLM(ctx, s.llm, modelType, input.Model, input.ValuedPrompt, float32(input.Temperature), input.MaxTokens, input.Size, imageData)
 	if err != nil {
 		aiExecution.Status = "failed"
 		aiExecution.ErrorMessage = err.Error()
@@ -171,14 +185,17 @@ func (s *AdminAiExecutionService) RunAiExecution(ctx context.Context, input mode
 			Err: utils.StrPtr(fmt.Sprintf("Failed to run ai execution: %v", err)),
 		}, nil
 	}
-	aiExecution.InputTokens = usage.Input
-	aiExecution.OutputTokens = usage.Output
-	aiExecution.TotalTokens = usage.Total
+	images := 0
+	if input.PromptType == "image" {
+		images = 1
+	}
+	s.recordUsage(&aiExecution, usage, images)
 	aiExecution.ElapsedTime = int(time.Now().UnixMilli() - runnedTime)
 
 	if input.PromptType == "image" {
 		imageData, err = base64.StdEncoding.DecodeString(result)
 		if err != nil {
+			s.failAfterUsage(&aiExecution, err)
 			return &model.SimpleResult{
 				Ok:  false,
 				Err: utils.StrPtr(fmt.Sprintf("Failed to decode base64 image: %v", err)),
@@ -187,6 +204,7 @@ func (s *AdminAiExecutionService) RunAiExecution(ctx context.Context, input mode
 		imageS3Dao := extdao.NewImageS3Dao()
 		err, _ = imageS3Dao.SaveImageToS3(utils.GetAiExecutionOutputImagePath(aiExecution.Uid), imageData)
 		if err != nil {
+			s.failAfterUsage(&aiExecution, err)
 			return &model.SimpleResult{
 				Ok:  false,
 				Err: utils.StrPtr(fmt.Sprintf("Failed to save image to S3: %v", err)),
@@ -219,3 +237,45 @@ func (s *AdminAiExecutionService) RunAiExecution(ctx context.Context, input mode
 	}
 	return ret, nil
 }
+
+// RunEmbedding creates a text embedding with the default embedding model and records it as an AiExecution
+// (usage and cost are added to runSajuProfileUid like any other run).
+func (s *AdminAiExecutionService) RunEmbedding(ctx context.Context, text string, runBy, runSajuProfileUid string) ([]float32, error) {
+	if s.llm == nil {
+		return nil, extdao.ErrLLMNotConfigured
+	}
+	now := time.Now().UnixMilli()
+	aiExecution := entity.AiExecution{
+		Uid:               utils.GenUid(),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		MetaType:          aiExecutionMetaTypeEmbedding,
+		PromptType:        "embedding",
+		ValuedPrompt:      text,
+		Model:             defaultEmbeddingModel,
+		Status:            "running",
+		RunBy:             runBy,
+		RunSajuProfileUid: runSajuProfileUid,
+	}
+	if err := s.aiExecutionRepo.Create(&aiExecution); err != nil {
+		return nil, fmt.Errorf("failed to create ai execution: %w", err)
+	}
+	runnedTime := time.Now().UnixMilli()
+	// 모델 "" → provider 기본 임베딩 모델 (defaultEmbeddingModel)
+	embedding, usage, err := s.llm.CreateEmbedding(ctx, "", text)
+	if err != nil {
+		aiExecution.Status = "failed"
+		aiExecution.ErrorMessage = err.Error()
+		if uerr := s.aiExecutionRepo.Update(&aiExecution); uerr != nil {
+			dslog.Log("error", fmt.Sprintf("[RunEmbedding] Failed to update ai execution: %v", uerr))
+		}
+		return nil, err
+	}
+	s.recordUsage(&aiExecution, usage, 0)
+	aiExecution.ElapsedTime = int(time.Now().UnixMilli() - runnedTime)
+	aiExecution.Status = "done"
+	if err := s.aiExecutionRepo.Update(&aiExecution); err != nil {
+		dslog.Log("error", fmt.Sprintf("[RunEmbedding] Failed to update ai execution: %v", err))
+	}
+	return embedding, nil
+}
@@ -0,0 +1,202 @@
+// AdminAuditService records structured audit entries for admin mutations and serves adminAuditLogs.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+
+	"sajudating_api/api/admgql/model"
+	"sajudating_api/api/converter"
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// 감사 대상 타입 (AdminAuditLog.TargetType)
+const (
+	AuditTargetAiMeta          = "ai_meta"
+	AuditTargetItemNCard       = "itemn_card"
+	AuditTargetSajuProfile     = "saju_profile"
+	AuditTargetPhyIdealPartner = "phy_ideal_partner"
+	AuditTargetAiExecution     = "ai_execution"
+	AuditTargetJob             = "job"
+	AuditTargetAdminUser       = "admin_user"
+)
+
+// auditRedactedFields: 스냅샷·diff 에 값 대신 auditRedacted 로 남기는 bson 필드 (비밀값, 임베딩 벡터,
+// 사용자 이미지가 담긴 job payload) - 변경 여부만 기록
+var auditRedactedFields = map[string]bool{
+	"password":    true,
+	"secret_key":  true,
+	"session_key": true,
+	"embedding":   true,
+	"payload":     true,
+}
+
+const auditRedacted = "[redacted]"
+
+type AdminAuditService struct {
+	auditLogRepo *dao.AdminAuditLogRepo
+}
+
+func NewAdminAuditService() *AdminAuditService {
+	return &AdminAuditService{
+		auditLogRepo: dao.NewAdminAuditLogRepo(),
+	}
+}
+
+// Record writes an audit entry for a completed admin mutation. before/after are entity snapshots
+// (nil for create/delete respectively). Calls without an authenticated admin in ctx (system runs
+// such as the SajuProfile pipeline) are not audited. Failures are logged and never fail the mutation.
+func (s *AdminAuditService) Record(ctx context.Context, action, targetType, targetUid string, before, after any) {
+	actorUid, err := utils.GetAdminUserUIDFromContext(ctx)
+	if err != nil {
+		return
+	}
+	beforeJSON, afterJSON, diff, err := auditDiff(before, after)
+	if err != nil {
+		log.Printf("[audit] %s %s/%s: diff failed: %v", action, targetType, targetUid, err)
+	}
+	entry := &entity.AdminAuditLog{
+		Uid:        utils.GenUid(),
+		RequestId:  utils.GetRequestIDFromContext(ctx),
+		ActorUid:   actorUid,
+		ActorRole:  entity.EffectiveAdminRole(utils.GetAdminUserRoleFromContext(ctx)),
+		Action:     action,
+		TargetType: targetType,
+		TargetUid:  targetUid,
+		BeforeJSON: beforeJSON,
+		AfterJSON:  afterJSON,
+		Diff:       diff,
+	}
+	if err := s.auditLogRepo.Create(entry); err != nil {
+		log.Printf("[audit] %s %s/%s: save failed: %v", action, targetType, targetUid, err)
+	}
+}
+
+// GetAdminAuditLogs lists audit entries (newest first) filtered by actor/action/target/request id/time range.
+func (s *AdminAuditService) GetAdminAuditLogs(ctx context.Context, input model.AdminAuditLogSearchInput) (*model.SimpleResult, error) {
+	logs, total, err := s.auditLogRepo.FindWithPagination(dao.AdminAuditLogFilter{
+		ActorUid:   input.ActorUID,
+		Action:     input.Action,
+		TargetType: input.TargetType,
+		TargetUid:  input.TargetUID,
+		RequestId:  input.RequestID,
+		FromAt:     input.FromCreatedAt,
+		ToAt:       input.ToCreatedAt,
+		Limit:      input.Limit,
+		Offset:     input.Offset,
+	})
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr(fmt.Sprintf("Failed to retrieve audit logs: %v", err)),
+		}, nil
+	}
+
+	nodes := make([]model.Node, len(logs))
+	for i := range logs {
+		nodes[i] = converter.AdminAuditLogToModel(&logs[i])
+	}
+
+	return &model.SimpleResult{
+		Ok:     true,
+		Nodes:  nodes,
+		Total:  utils.IntPtr(int(total)),
+		Limit:  utils.IntPtr(input.Limit),
+		Offset: utils.IntPtr(input.Offset),
+	}, nil
+}
+
+// auditDiff snapshots before/after as JSON keyed by their bson field names and lists changed fields.
+func auditDiff(before, after any) (string, string, []entity.AdminAuditDiff, error) {
+	beforeDoc, err := auditSnapshot(before)
+	if err != nil {
+		return "", "", nil, err
+	}
+	afterDoc, err := auditSnapshot(after)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	keys := map[string]bool{}
+	for k := range beforeDoc {
+		keys[k] = true
+	}
+	for k := range afterDoc {
+		keys[k] = true
+	}
+	fields := make([]string, 0, len(keys))
+	for k := range keys {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	diff := []entity.AdminAuditDiff{}
+	for _, f := range fields {
+		b, inBefore := beforeDoc[f]
+		a, inAfter := afterDoc[f]
+		if inBefore && inAfter && reflect.DeepEqual(b, a) {
+			continue
+		}
+		if auditRedactedFields[f] {
+			b, a = auditRedacted, auditRedacted
+		}
+		d := entity.AdminAuditDiff{Field: f}
+		if inBefore {
+			d.Before = auditJSON(b)
+		}
+		if inAfter {
+			d.After = auditJSON(a)
+		}
+		diff = append(diff, d)
+	}
+
+	beforeJSON, afterJSON := "", ""
+	if beforeDoc != nil {
+		beforeJSON = auditJSON(auditRedact(beforeDoc))
+	}
+	if afterDoc != nil {
+		afterJSON = auditJSON(auditRedact(afterDoc))
+	}
+	return beforeJSON, afterJSON, diff, nil
+}
+
+// auditRedact replaces auditRedactedFields values in a snapshot (in place).
+func auditRedact(doc map[string]any) map[string]any {
+	for f := range auditRedactedFields {
+		if _, ok := doc[f]; ok {
+			doc[f] = auditRedacted
+		}
+	}
+	return doc
+}
+
+func auditSnapshot(v any) (map[string]any, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return nil, nil
+	}
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func auditJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
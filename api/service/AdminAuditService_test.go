@@ -0,0 +1,117 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"sajudating_api/api/dao/entity"
+)
+
+func TestAuditDiff_Update(t *testing.T) {
+	before := &entity.AIMeta{Uid: "m1", Name: "saju v1", Prompt: "old", Temperature: 0.7, InUse: false}
+	after := &entity.AIMeta{Uid: "m1", Name: "saju v1", Prompt: "new", Temperature: 0.7, InUse: true}
+
+	beforeJSON, afterJSON, diff, err := auditDiff(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beforeJSON == "" || afterJSON == "" {
+		t.Fatalf("expected both snapshots, got before=%q after=%q", beforeJSON, afterJSON)
+	}
+	want := map[string][2]string{
+		"in_use": {"false", "true"},
+		"prompt": {`"old"`, `"new"`},
+	}
+	if len(diff) != len(want) {
+		t.Fatalf("diff = %+v, want fields %v", diff, want)
+	}
+	for _, d := range diff {
+		w, ok := want[d.Field]
+		if !ok {
+			t.Errorf("unexpected diff field %q", d.Field)
+			continue
+		}
+		if d.Before != w[0] || d.After != w[1] {
+			t.Errorf("field %s: got %s -> %s, want %s -> %s", d.Field, d.Before, d.After, w[0], w[1])
+		}
+	}
+}
+
+func TestAuditDiff_CreateDelete(t *testing.T) {
+	card := &entity.ItemNCard{Uid: "c1", CardID: "card_a", Title: "t"}
+
+	beforeJSON, afterJSON, diff, err := auditDiff(nil, card)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beforeJSON != "" || afterJSON == "" {
+		t.Errorf("create: before=%q after=%q", beforeJSON, afterJSON)
+	}
+	for _, d := range diff {
+		if d.Before != "" {
+			t.Errorf("create: field %s has before %q", d.Field, d.Before)
+		}
+	}
+
+	var nilCard *entity.ItemNCard
+	beforeJSON, afterJSON, diff, err = auditDiff(card, nilCard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beforeJSON == "" || afterJSON != "" {
+		t.Errorf("delete: before=%q after=%q", beforeJSON, afterJSON)
+	}
+	if len(diff) == 0 {
+		t.Error("delete: expected removed fields in diff")
+	}
+}
+
+func TestAuditDiff_RedactsSecretsAndEmbeddings(t *testing.T) {
+	before := &entity.AdminUser{Uid: "u1", Email: "a@x", Password: "hash1", SecretKey: "otp", SessionKey: "s1"}
+	after := &entity.AdminUser{Uid: "u1", Email: "a@x", Password: "hash2", SecretKey: "otp", SessionKey: "s1"}
+	beforeJSON, afterJSON, diff, err := auditDiff(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"hash1", "hash2", "otp", "s1"} {
+		if strings.Contains(beforeJSON, secret) || strings.Contains(afterJSON, secret) {
+			t.Errorf("snapshot leaks %q: before=%s after=%s", secret, beforeJSON, afterJSON)
+		}
+	}
+	if len(diff) != 1 || diff[0].Field != "password" || diff[0].Before != `"[redacted]"` || diff[0].After != `"[redacted]"` {
+		t.Errorf("diff = %+v, want redacted password change only", diff)
+	}
+
+	partner := &entity.PhyIdealPartner{Uid: "p1", Embedding: []float64{0.125, -0.5}}
+	_, afterJSON, diff, err = auditDiff(nil, partner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(afterJSON, "0.125") {
+		t.Errorf("snapshot keeps embedding vector: %s", afterJSON)
+	}
+	for _, d := range diff {
+		if d.Field == "embedding" && d.After != `"[redacted]"` {
+			t.Errorf("embedding diff = %q, want redacted", d.After)
+		}
+	}
+}
+
+func TestAuditDiff_RedactsJobPayload(t *testing.T) {
+	before := &entity.Job{Uid: "j1", Status: entity.JobStatusDead, Steps: []string{}, Payload: map[string]string{jobPayloadImageBase64: "aW1nZGF0YQ=="}}
+	after := &entity.Job{Uid: "j1", Status: entity.JobStatusDead, Steps: []string{}, Payload: map[string]string{}}
+	beforeJSON, afterJSON, diff, err := auditDiff(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := beforeJSON + afterJSON
+	for _, d := range diff {
+		entry += d.Before + d.After
+	}
+	if strings.Contains(entry, "aW1nZGF0YQ==") || strings.Contains(entry, jobPayloadImageBase64) {
+		t.Errorf("audit entry keeps the job image payload: before=%s after=%s diff=%+v", beforeJSON, afterJSON, diff)
+	}
+	if len(diff) != 1 || diff[0].Field != "payload" {
+		t.Errorf("diff = %+v, want redacted payload change only", diff)
+	}
+}
@@ -4,12 +4,12 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"sajudating_api/api/admgql/model"
-	"sajudating_api/api/config"
 	"sajudating_api/api/dao"
 	"sajudating_api/api/dao/entity"
 	"sajudating_api/api/dto"
@@ -20,11 +20,18 @@ import (
 )
 
 // AdminExtractService provides GraphQL-facing methods for saju/chemi generation (delegate to package-level Run*).
-type AdminExtractService struct{}
+type AdminExtractService struct {
+	llm extdao.LLMProvider
+}
 
 // NewAdminExtractService returns a new AdminExtractService.
 func NewAdminExtractService() *AdminExtractService {
-	return &AdminExtractService{}
+	return NewAdminExtractServiceWithLLM(extdao.DefaultLLMProvider())
+}
+
+// NewAdminExtractServiceWithLLM returns an AdminExtractService whose direct LLM calls (SendLLMRequestGql) use llm.
+func NewAdminExtractServiceWithLLM(llm extdao.LLMProvider) *AdminExtractService {
+	return &AdminExtractService{llm: llm}
 }
 
 // RunSajuExtractTest runs pillars → items → tokens → saju card trigger; returns userInfo + selected cards.
@@ -47,11 +54,11 @@ func RunSajuExtractTest(w http.ResponseWriter, r *http.Request) {
 	if timezone == "" {
 		timezone = "Asia/Seoul"
 	}
-	y, m, d, hh, mm, _ := itemncard.BirthInput(req.Birth.Date, req.Birth.Time)
-	if y == 0 {
+	y, m, d, hh, mm, err := itemncard.BirthInputCalendar(req.Birth.Date, req.Birth.Time, birthCalendar(req.Birth, req.Calendar), req.Birth.LeapMonth)
+	if err != nil || y == 0 {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"error": "invalid birth date"})
+		json.NewEncoder(w).Encode(map[string]string{"error": birthDateError(err).Error()})
 		return
 	}
 	mode := req.Mode
@@ -61,8 +68,6 @@ func RunSajuExtractTest(w http.ResponseWriter, r *http.Request) {
 	runY, runM, runD := y, m, d
 	period := ""
 	var pillars itemncardtypes.PillarsText
-	var palja string
-	var err error
 
 	switch mode {
 	case "연도별":
@@ -129,12 +134,11 @@ func RunSajuExtractTest(w http.ResponseWriter, r *http.Request) {
 		period = periodLabel
 		runY, runM, runD = y, m, d
 		pillars = daesoonPillars
-		palja = daesoonPillars.Year + daesoonPillars.Month + daesoonPillars.Day + daesoonPillars.Hour
 		err = nil
 		goto buildItems
 	}
 
-	pillars, palja, err = itemncard.PillarsFromBirth(runY, runM, runD, hh, mm, timezone)
+	pillars, _, err = itemncard.PillarsFromBirth(runY, runM, runD, hh, mm, timezone)
 	if err != nil {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusInternalServerError)
@@ -142,7 +146,13 @@ func RunSajuExtractTest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 buildItems:
-	items := itemncard.ItemsFromPillars(pillars, palja)
+	items, err := itemncard.ItemsFromPillars(pillars)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
 	tokens := itemncard.ItemsToTokens(items)
 	tokenSet := make(map[string]bool)
 	for _, t := range tokens {
@@ -247,31 +257,38 @@ func RunPairExtractTest(w http.ResponseWriter, r *http.Request) {
 	if timezone == "" {
 		timezone = "Asia/Seoul"
 	}
-	ya, ma, da, hha, mma, _ := itemncard.BirthInput(req.BirthA.Date, req.BirthA.Time)
-	yb, mb, db, hhb, mmb, _ := itemncard.BirthInput(req.BirthB.Date, req.BirthB.Time)
-	if ya == 0 || yb == 0 {
+	ya, ma, da, hha, mma, errA := itemncard.BirthInputCalendar(req.BirthA.Date, req.BirthA.Time, req.BirthA.Calendar, req.BirthA.LeapMonth)
+	yb, mb, db, hhb, mmb, errB := itemncard.BirthInputCalendar(req.BirthB.Date, req.BirthB.Time, req.BirthB.Calendar, req.BirthB.LeapMonth)
+	if errA != nil || errB != nil || ya == 0 || yb == 0 {
+		if errA == nil {
+			errA = errB
+		}
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"error": "invalid birth date"})
+		json.NewEncoder(w).Encode(map[string]string{"error": birthDateError(errA).Error()})
 		return
 	}
-	pillarsA, paljaA, err := itemncard.PillarsFromBirth(ya, ma, da, hha, mma, timezone)
+	pillarsA, _, err := itemncard.PillarsFromBirth(ya, ma, da, hha, mma, timezone)
 	if err != nil {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusInternalServerError)
 		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
 		return
 	}
-	pillarsB, paljaB, err := itemncard.PillarsFromBirth(yb, mb, db, hhb, mmb, timezone)
+	pillarsB, _, err := itemncard.PillarsFromBirth(yb, mb, db, hhb, mmb, timezone)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	itemsA, itemsB, pItems, err := itemncard.PairItemsFromPillars(pillarsA, pillarsB)
 	if err != nil {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusInternalServerError)
 		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
 		return
 	}
-	itemsA := itemncard.ItemsFromPillars(pillarsA, paljaA)
-	itemsB := itemncard.ItemsFromPillars(pillarsB, paljaB)
-	pItems := itemncard.PItemsFromPillars(pillarsA, pillarsB)
 	tokensA := itemncard.ItemsToTokens(itemsA)
 	tokensB := itemncard.ItemsToTokens(itemsB)
 	pTokens := itemncard.ItemsToTokens(pItems)
@@ -395,14 +412,15 @@ func RunLLMContextPreview(w http.ResponseWriter, r *http.Request) {
 
 // RunSajuGeneration runs the saju generation base method: for each target, pillars → items → tokens → cards → LLM → result.
 // 대운 uses DaesoonPillars(birth, gender, period as step index); other kinds use kind+period+birth to resolve run date.
-func RunSajuGeneration(ctx context.Context, req dto.SajuGenerationRequest) (dto.SajuGenerationResponse, error) {
+// LLM calls go to llm (nil → each target reports "OpenAI API key not configured").
+func RunSajuGeneration(ctx context.Context, llm extdao.LLMProvider, req dto.SajuGenerationRequest) (dto.SajuGenerationResponse, error) {
 	timezone := req.UserInput.Timezone
 	if timezone == "" {
 		timezone = "Asia/Seoul"
 	}
-	y, m, d, hh, mm, _ := itemncard.BirthInput(req.UserInput.Birth.Date, req.UserInput.Birth.Time)
-	if y == 0 {
-		return dto.SajuGenerationResponse{}, fmt.Errorf("invalid birth date")
+	y, m, d, hh, mm, err := itemncard.BirthInputCalendar(req.UserInput.Birth.Date, req.UserInput.Birth.Time, req.UserInput.Birth.Calendar, req.UserInput.Birth.LeapMonth)
+	if err != nil || y == 0 {
+		return dto.SajuGenerationResponse{}, birthDateError(err)
 	}
 	out := dto.SajuGenerationResponse{
 		Targets: make([]dto.SajuGenerationTargetOutput, len(req.Targets)),
@@ -424,8 +442,11 @@ func RunSajuGeneration(ctx context.Context, req dto.SajuGenerationRequest) (dto.
 				out.Targets[i].Result = "대운 pillars: " + errDaesoon.Error()
 				continue
 			}
-			palja := pillars.Year + pillars.Month + pillars.Day + pillars.Hour
-			items := itemncard.ItemsFromPillars(pillars, palja)
+			items, err := itemncard.ItemsFromPillars(pillars)
+			if err != nil {
+				out.Targets[i].Result = "items: " + err.Error()
+				continue
+			}
 			tokens := itemncard.ItemsToTokens(items)
 			tokenSet := make(map[string]bool)
 			for _, tok := range tokens {
@@ -441,11 +462,10 @@ func RunSajuGeneration(ctx context.Context, req dto.SajuGenerationRequest) (dto.
 				maxChars = defaultLLMContextMaxChars
 			}
 			contextStr := itemncard.BuildLLMContextFromCards(selected, maxChars)
-			if config.AppConfig == nil || config.AppConfig.OpenAI.APIKey == "" {
+			if llm == nil {
 				out.Targets[i].Result = "OpenAI API key not configured"
 				continue
 			}
-			openaiDao := extdao.NewOpenAIExtDao()
 			systemMsg := fmt.Sprintf("You are a Korean saju (사주) expert. Generate a reading based on the following context. Keep the response within approximately %d characters.", maxChars)
 			chatReq := extdao.ChatCompletionRequest{
 				Messages: []extdao.ChatMessage{
@@ -455,7 +475,7 @@ func RunSajuGeneration(ctx context.Context, req dto.SajuGenerationRequest) (dto.
 				Temperature: 0.7,
 				MaxTokens:   (maxChars / 2) + 200,
 			}
-			text, _, err := openaiDao.ChatCompletion(ctx, chatReq)
+			text, _, err := llm.ChatCompletion(ctx, chatReq)
 			if err != nil {
 				out.Targets[i].Result = "LLM: " + err.Error()
 				continue
@@ -468,12 +488,16 @@ func RunSajuGeneration(ctx context.Context, req dto.SajuGenerationRequest) (dto.
 			out.Targets[i].Result = "invalid period for kind " + t.Kind + ": " + t.Period
 			continue
 		}
-		pillars, palja, err := itemncard.PillarsFromBirth(runY, runM, runD, hh, mm, timezone)
+		pillars, _, err := itemncard.PillarsFromBirth(runY, runM, runD, hh, mm, timezone)
 		if err != nil {
 			out.Targets[i].Result = "pillars: " + err.Error()
 			continue
 		}
-		items := itemncard.ItemsFromPillars(pillars, palja)
+		items, err := itemncard.ItemsFromPillars(pillars)
+		if err != nil {
+			out.Targets[i].Result = "items: " + err.Error()
+			continue
+		}
 		tokens := itemncard.ItemsToTokens(items)
 		tokenSet := make(map[string]bool)
 		for _, tok := range tokens {
@@ -489,11 +513,10 @@ func RunSajuGeneration(ctx context.Context, req dto.SajuGenerationRequest) (dto.
 			maxChars = defaultLLMContextMaxChars
 		}
 		contextStr := itemncard.BuildLLMContextFromCards(selected, maxChars)
-		if config.AppConfig == nil || config.AppConfig.OpenAI.APIKey == "" {
+		if llm == nil {
 			out.Targets[i].Result = "OpenAI API key not configured"
 			continue
 		}
-		openaiDao := extdao.NewOpenAIExtDao()
 		systemMsg := fmt.Sprintf("You are a Korean saju (사주) expert. Generate a reading based on the following context. Keep the response within approximately %d characters.", maxChars)
 		chatReq := extdao.ChatCompletionRequest{
 			Messages: []extdao.ChatMessage{
@@ -503,7 +526,7 @@ func RunSajuGeneration(ctx context.Context, req dto.SajuGenerationRequest) (dto.
 			Temperature: 0.7,
 			MaxTokens:   (maxChars / 2) + 200,
 		}
-		text, _, err := openaiDao.ChatCompletion(ctx, chatReq)
+		text, _, err := llm.ChatCompletion(ctx, chatReq)
 		if err != nil {
 			out.Targets[i].Result = "LLM: " + err.Error()
 			continue
@@ -513,31 +536,32 @@ func RunSajuGeneration(ctx context.Context, req dto.SajuGenerationRequest) (dto.
 	return out, nil
 }
 
-// RunChemiGeneration runs the chemi (pair) generation base method: pair input → A/B pillars → items → tokens → SelectPairCards → for each target BuildLLMContextFromCards + OpenAI → result.
-func RunChemiGeneration(ctx context.Context, req dto.ChemiGenerationRequest) (dto.ChemiGenerationResponse, error) {
+// RunChemiGeneration runs the chemi (pair) generation base method: pair input → A/B pillars → items → tokens → SelectPairCards → for each target BuildLLMContextFromCards + llm → result.
+func RunChemiGeneration(ctx context.Context, llm extdao.LLMProvider, req dto.ChemiGenerationRequest) (dto.ChemiGenerationResponse, error) {
 	timezone := req.PairInput.Timezone
 	if timezone == "" {
 		timezone = "Asia/Seoul"
 	}
-	ya, ma, da, hha, mma, _ := itemncard.BirthInput(req.PairInput.BirthA.Date, req.PairInput.BirthA.Time)
-	yb, mb, db, hhb, mmb, _ := itemncard.BirthInput(req.PairInput.BirthB.Date, req.PairInput.BirthB.Time)
-	if ya == 0 {
-		return dto.ChemiGenerationResponse{}, fmt.Errorf("invalid birth A date")
+	ya, ma, da, hha, mma, errA := itemncard.BirthInputCalendar(req.PairInput.BirthA.Date, req.PairInput.BirthA.Time, req.PairInput.BirthA.Calendar, req.PairInput.BirthA.LeapMonth)
+	yb, mb, db, hhb, mmb, errB := itemncard.BirthInputCalendar(req.PairInput.BirthB.Date, req.PairInput.BirthB.Time, req.PairInput.BirthB.Calendar, req.PairInput.BirthB.LeapMonth)
+	if errA != nil || ya == 0 {
+		return dto.ChemiGenerationResponse{}, fmt.Errorf("birth A: %w", birthDateError(errA))
 	}
-	if yb == 0 {
-		return dto.ChemiGenerationResponse{}, fmt.Errorf("invalid birth B date")
+	if errB != nil || yb == 0 {
+		return dto.ChemiGenerationResponse{}, fmt.Errorf("birth B: %w", birthDateError(errB))
 	}
-	pillarsA, paljaA, err := itemncard.PillarsFromBirth(ya, ma, da, hha, mma, timezone)
+	pillarsA, _, err := itemncard.PillarsFromBirth(ya, ma, da, hha, mma, timezone)
 	if err != nil {
 		return dto.ChemiGenerationResponse{}, fmt.Errorf("pillars A: %w", err)
 	}
-	pillarsB, paljaB, err := itemncard.PillarsFromBirth(yb, mb, db, hhb, mmb, timezone)
+	pillarsB, _, err := itemncard.PillarsFromBirth(yb, mb, db, hhb, mmb, timezone)
 	if err != nil {
 		return dto.ChemiGenerationResponse{}, fmt.Errorf("pillars B: %w", err)
 	}
-	itemsA := itemncard.ItemsFromPillars(pillarsA, paljaA)
-	itemsB := itemncard.ItemsFromPillars(pillarsB, paljaB)
-	pItems := itemncard.PItemsFromPillars(pillarsA, pillarsB)
+	itemsA, itemsB, pItems, err := itemncard.PairItemsFromPillars(pillarsA, pillarsB)
+	if err != nil {
+		return dto.ChemiGenerationResponse{}, fmt.Errorf("items: %w", err)
+	}
 	tokensA := itemncard.ItemsToTokens(itemsA)
 	tokensB := itemncard.ItemsToTokens(itemsB)
 	pTokens := itemncard.ItemsToTokens(pItems)
@@ -570,11 +594,10 @@ func RunChemiGeneration(ctx context.Context, req dto.ChemiGenerationRequest) (dt
 			maxChars = defaultLLMContextMaxChars
 		}
 		contextStr := itemncard.BuildLLMContextFromCards(selected, maxChars)
-		if config.AppConfig == nil || config.AppConfig.OpenAI.APIKey == "" {
+		if llm == nil {
 			out.Targets[i].Result = "OpenAI API key not configured"
 			continue
 		}
-		openaiDao := extdao.NewOpenAIExtDao()
 		systemMsg := fmt.Sprintf("You are a Korean relationship (궁합) expert. Write from the perspective: %s. Keep the response within approximately %d characters.", t.Perspective, maxChars)
 		chatReq := extdao.ChatCompletionRequest{
 			Messages: []extdao.ChatMessage{
@@ -584,7 +607,7 @@ func RunChemiGeneration(ctx context.Context, req dto.ChemiGenerationRequest) (dt
 			Temperature: 0.7,
 			MaxTokens:   (maxChars / 2) + 200,
 		}
-		text, _, err := openaiDao.ChatCompletion(ctx, chatReq)
+		text, _, err := llm.ChatCompletion(ctx, chatReq)
 		if err != nil {
 			out.Targets[i].Result = "LLM: " + err.Error()
 			continue
@@ -601,11 +624,7 @@ func (s *AdminExtractService) RunSajuGenerationGql(ctx context.Context, input mo
 	}
 	req := dto.SajuGenerationRequest{
 		UserInput: dto.SajuGenerationUserInput{
-			Birth: dto.BirthInput{
-				Date:          input.UserInput.Birth.Date,
-				Time:          input.UserInput.Birth.Time,
-				TimePrecision: utils.PtrToStr(input.UserInput.Birth.TimePrecision),
-			},
+			Birth:    toDtoBirthInput(input.UserInput.Birth),
 			Timezone: utils.PtrToStr(input.UserInput.Timezone),
 			RuleSet:  utils.PtrToStr(input.UserInput.RuleSet),
 			Gender:   utils.PtrToStr(input.UserInput.Gender),
@@ -622,7 +641,7 @@ func (s *AdminExtractService) RunSajuGenerationGql(ctx context.Context, input mo
 			MaxChars: t.MaxChars,
 		})
 	}
-	resp, err := RunSajuGeneration(ctx, req)
+	resp, err := RunSajuGeneration(ctx, s.llm, req)
 	if err != nil {
 		return nil, err
 	}
@@ -647,16 +666,8 @@ func (s *AdminExtractService) RunChemiGenerationGql(ctx context.Context, input m
 	}
 	req := dto.ChemiGenerationRequest{
 		PairInput: dto.ChemiGenerationPairInput{
-			BirthA: dto.BirthInput{
-				Date:          input.PairInput.BirthA.Date,
-				Time:          input.PairInput.BirthA.Time,
-				TimePrecision: utils.PtrToStr(input.PairInput.BirthA.TimePrecision),
-			},
-			BirthB: dto.BirthInput{
-				Date:          input.PairInput.BirthB.Date,
-				Time:          input.PairInput.BirthB.Time,
-				TimePrecision: utils.PtrToStr(input.PairInput.BirthB.TimePrecision),
-			},
+			BirthA:   toDtoBirthInput(input.PairInput.BirthA),
+			BirthB:   toDtoBirthInput(input.PairInput.BirthB),
 			Timezone: utils.PtrToStr(input.PairInput.Timezone),
 		},
 		Targets: make([]dto.ChemiGenerationTargetInput, 0, len(input.Targets)),
@@ -670,7 +681,7 @@ func (s *AdminExtractService) RunChemiGenerationGql(ctx context.Context, input m
 			MaxChars:    t.MaxChars,
 		})
 	}
-	resp, err := RunChemiGeneration(ctx, req)
+	resp, err := RunChemiGeneration(ctx, s.llm, req)
 	if err != nil {
 		return nil, err
 	}
@@ -708,9 +719,9 @@ func buildSajuChartData(req *dto.SajuExtractTestRequest) (*sajuChartData, error)
 	if timezone == "" {
 		timezone = "Asia/Seoul"
 	}
-	y, m, d, hh, mm, _ := itemncard.BirthInput(req.Birth.Date, req.Birth.Time)
-	if y == 0 {
-		return nil, fmt.Errorf("invalid birth date")
+	y, m, d, hh, mm, err := itemncard.BirthInputCalendar(req.Birth.Date, req.Birth.Time, birthCalendar(req.Birth, req.Calendar), req.Birth.LeapMonth)
+	if err != nil || y == 0 {
+		return nil, birthDateError(err)
 	}
 	mode := req.Mode
 	if mode == "" {
@@ -720,7 +731,6 @@ func buildSajuChartData(req *dto.SajuExtractTestRequest) (*sajuChartData, error)
 	period := ""
 	var pillars itemncardtypes.PillarsText
 	var palja string
-	var err error
 
 	switch mode {
 	case "연도별":
@@ -777,7 +787,10 @@ func buildSajuChartData(req *dto.SajuExtractTestRequest) (*sajuChartData, error)
 		return nil, err
 	}
 buildItems:
-	items := itemncard.ItemsFromPillars(pillars, palja)
+	items, err := itemncard.ItemsFromPillars(pillars)
+	if err != nil {
+		return nil, err
+	}
 	tokens := itemncard.ItemsToTokens(items)
 	baseTimeUsed := "unknown"
 	if req.Birth.Time != "" && req.Birth.Time != "unknown" {
@@ -825,6 +838,34 @@ func ptrToInt(p *int) int {
 	return *p
 }
 
+func toDtoBirthInput(b *model.SajuBirthInput) dto.BirthInput {
+	out := dto.BirthInput{
+		Date:          b.Date,
+		Time:          b.Time,
+		TimePrecision: utils.PtrToStr(b.TimePrecision),
+		Calendar:      utils.PtrToStr(b.Calendar),
+	}
+	if b.LeapMonth != nil {
+		out.LeapMonth = *b.LeapMonth
+	}
+	return out
+}
+
+// birthCalendar: 생년월일 단위 calendar 우선, 없으면 요청 단위 calendar.
+func birthCalendar(b dto.BirthInput, fallback string) string {
+	if b.Calendar != "" {
+		return b.Calendar
+	}
+	return fallback
+}
+
+func birthDateError(err error) error {
+	if err != nil && !errors.Is(err, itemncard.ErrBirthDateFormat) {
+		return fmt.Errorf("invalid birth date: %w", err)
+	}
+	return fmt.Errorf("invalid birth date")
+}
+
 func intPtrToStr(p *int) string {
 	if p == nil {
 		return ""
@@ -838,12 +879,9 @@ func (s *AdminExtractService) SajuChartGql(ctx context.Context, input model.Saju
 		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr("birth is required")}, nil
 	}
 	req := &dto.SajuExtractTestRequest{
-		Birth: dto.BirthInput{
-			Date:          input.Birth.Date,
-			Time:          input.Birth.Time,
-			TimePrecision: utils.PtrToStr(input.Birth.TimePrecision),
-		},
+		Birth:              toDtoBirthInput(input.Birth),
 		Timezone:           utils.PtrToStr(input.Timezone),
+		Calendar:           utils.PtrToStr(input.Calendar),
 		Mode:               input.Mode,
 		TargetYear:         intPtrToStr(input.TargetYear),
 		TargetMonth:        intPtrToStr(input.TargetMonth),
@@ -908,8 +946,8 @@ func (s *AdminExtractService) SajuPairChartGql(ctx context.Context, input model.
 	if timezone == "" {
 		timezone = "Asia/Seoul"
 	}
-	reqA := &dto.SajuExtractTestRequest{Birth: dto.BirthInput{Date: input.BirthA.Date, Time: input.BirthA.Time, TimePrecision: utils.PtrToStr(input.BirthA.TimePrecision)}, Timezone: timezone, Mode: "인생"}
-	reqB := &dto.SajuExtractTestRequest{Birth: dto.BirthInput{Date: input.BirthB.Date, Time: input.BirthB.Time, TimePrecision: utils.PtrToStr(input.BirthB.TimePrecision)}, Timezone: timezone, Mode: "인생"}
+	reqA := &dto.SajuExtractTestRequest{Birth: toDtoBirthInput(input.BirthA), Timezone: timezone, Calendar: utils.PtrToStr(input.Calendar), Mode: "인생"}
+	reqB := &dto.SajuExtractTestRequest{Birth: toDtoBirthInput(input.BirthB), Timezone: timezone, Calendar: utils.PtrToStr(input.Calendar), Mode: "인생"}
 	dataA, err := buildSajuChartData(reqA)
 	if err != nil {
 		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr("chartA: " + err.Error())}, nil
@@ -918,7 +956,10 @@ func (s *AdminExtractService) SajuPairChartGql(ctx context.Context, input model.
 	if err != nil {
 		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr("chartB: " + err.Error())}, nil
 	}
-	pItems := itemncard.PItemsFromPillars(dataA.pillars, dataB.pillars)
+	pItems, err := itemncard.PItemsFromPillars(dataA.pillars, dataB.pillars)
+	if err != nil {
+		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr("pair: " + err.Error())}, nil
+	}
 	pTokens := itemncard.ItemsToTokens(pItems)
 	includeTokens := input.IncludeTokens != nil && *input.IncludeTokens
 	chartA := sajuChartDataToModel(dataA, includeTokens)
@@ -1047,9 +1088,9 @@ func selectedCardsToNodes(selected []entity.ItemNCard, evidences [][]string, sco
 	return nodes
 }
 
-// SendLLMRequestGql calls OpenAI with prompt (and optional systemPrompt/model/maxTokens/temperature); returns SimpleResult with node = LLMRequestResult. No card UID.
+// SendLLMRequestGql calls the LLM provider with prompt (and optional systemPrompt/model/maxTokens/temperature); returns SimpleResult with node = LLMRequestResult. No card UID.
 func (s *AdminExtractService) SendLLMRequestGql(ctx context.Context, input model.SendLLMRequestInput) (*model.SimpleResult, error) {
-	if config.AppConfig == nil || config.AppConfig.OpenAI.APIKey == "" {
+	if s.llm == nil {
 		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr("OpenAI API key not configured")}, nil
 	}
 	messages := []extdao.ChatMessage{{Role: "user", Content: input.Prompt}}
@@ -1068,8 +1109,7 @@ func (s *AdminExtractService) SendLLMRequestGql(ctx context.Context, input model
 	if input.Model != nil {
 		modelName = *input.Model
 	}
-	openaiDao := extdao.NewOpenAIExtDao()
-	text, usage, err := openaiDao.ChatCompletion(ctx, extdao.ChatCompletionRequest{
+	text, usage, err := s.llm.ChatCompletion(ctx, extdao.ChatCompletionRequest{
 		Model:       modelName,
 		Messages:    messages,
 		Temperature: temperature,
@@ -10,7 +10,10 @@ import (
 	"strings"
 	"testing"
 
+	"sajudating_api/api/admgql/model"
 	"sajudating_api/api/dto"
+	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/utils"
 )
 
 func TestRunSajuExtractTest_MethodNotAllowed(t *testing.T) {
@@ -266,7 +269,7 @@ func TestRunSajuGeneration_InvalidBirth(t *testing.T) {
 		},
 		Targets: []dto.SajuGenerationTargetInput{{Kind: "인생", Period: "", MaxChars: 500}},
 	}
-	_, err := RunSajuGeneration(ctx, req)
+	_, err := RunSajuGeneration(ctx, extdao.DefaultLLMProvider(), req)
 	if err == nil {
 		t.Error("expected error for invalid birth date")
 	}
@@ -285,7 +288,7 @@ func TestRunSajuGeneration_DaesoonMissingGender(t *testing.T) {
 		},
 		Targets: []dto.SajuGenerationTargetInput{{Kind: "대운", Period: "0", MaxChars: 500}},
 	}
-	resp, err := RunSajuGeneration(ctx, req)
+	resp, err := RunSajuGeneration(ctx, extdao.DefaultLLMProvider(), req)
 	if err != nil {
 		t.Fatalf("RunSajuGeneration: %v", err)
 	}
@@ -307,7 +310,7 @@ func TestRunSajuGeneration_DaesoonWithGender(t *testing.T) {
 		},
 		Targets: []dto.SajuGenerationTargetInput{{Kind: "대운", Period: "0", MaxChars: 500}},
 	}
-	resp, err := RunSajuGeneration(ctx, req)
+	resp, err := RunSajuGeneration(ctx, extdao.DefaultLLMProvider(), req)
 	if err != nil {
 		t.Fatalf("RunSajuGeneration: %v", err)
 	}
@@ -332,7 +335,7 @@ func TestRunSajuGeneration_SingleTargetInsaeng(t *testing.T) {
 		},
 		Targets: []dto.SajuGenerationTargetInput{{Kind: "인생", Period: "", MaxChars: 500}},
 	}
-	resp, err := RunSajuGeneration(ctx, req)
+	resp, err := RunSajuGeneration(ctx, extdao.DefaultLLMProvider(), req)
 	if err != nil {
 		t.Fatalf("RunSajuGeneration: %v", err)
 	}
@@ -360,7 +363,7 @@ func TestRunSajuGeneration_MultipleTargets(t *testing.T) {
 			{Kind: "세운", Period: "2025", MaxChars: 400},
 		},
 	}
-	resp, err := RunSajuGeneration(ctx, req)
+	resp, err := RunSajuGeneration(ctx, extdao.DefaultLLMProvider(), req)
 	if err != nil {
 		t.Fatalf("RunSajuGeneration: %v", err)
 	}
@@ -390,7 +393,7 @@ func TestRunChemiGeneration_InvalidBirthA(t *testing.T) {
 		},
 		Targets: []dto.ChemiGenerationTargetInput{{Perspective: "overview", MaxChars: 500}},
 	}
-	_, err := RunChemiGeneration(ctx, req)
+	_, err := RunChemiGeneration(ctx, extdao.DefaultLLMProvider(), req)
 	if err == nil {
 		t.Error("expected error for invalid birth A date")
 	}
@@ -409,7 +412,7 @@ func TestRunChemiGeneration_InvalidBirthB(t *testing.T) {
 		},
 		Targets: []dto.ChemiGenerationTargetInput{{Perspective: "overview", MaxChars: 500}},
 	}
-	_, err := RunChemiGeneration(ctx, req)
+	_, err := RunChemiGeneration(ctx, extdao.DefaultLLMProvider(), req)
 	if err == nil {
 		t.Error("expected error for invalid birth B date")
 	}
@@ -428,7 +431,7 @@ func TestRunChemiGeneration_Valid(t *testing.T) {
 		},
 		Targets: []dto.ChemiGenerationTargetInput{{Perspective: "overview", MaxChars: 500}},
 	}
-	resp, err := RunChemiGeneration(ctx, req)
+	resp, err := RunChemiGeneration(ctx, extdao.DefaultLLMProvider(), req)
 	if err != nil {
 		if strings.Contains(err.Error(), "pillars") || strings.Contains(err.Error(), "select pair cards") {
 			t.Skip("RunChemiGeneration valid requires sxtwl/MongoDB or seed; skipping when unavailable")
@@ -475,3 +478,39 @@ func TestResolveRunDateForKind(t *testing.T) {
 		}
 	}
 }
+
+func TestSendLLMRequestGql_FakeProvider(t *testing.T) {
+	fake := extdao.NewFakeLLMProvider()
+	fake.AddChat(extdao.ChatCompletionRequest{
+		Model: "gpt-4o-mini",
+		Messages: []extdao.ChatMessage{
+			{Role: "system", Content: "사주 전문가"},
+			{Role: "user", Content: "갑자일주 요약"},
+		},
+	}, "곧은 나무의 기운", &extdao.Usage{Input: 10, Output: 5, Total: 15})
+	s := NewAdminExtractServiceWithLLM(fake)
+
+	res, err := s.SendLLMRequestGql(context.Background(), model.SendLLMRequestInput{
+		Prompt:       "갑자일주 요약",
+		SystemPrompt: utils.StrPtr("사주 전문가"),
+		Model:        utils.StrPtr("gpt-4o-mini"),
+	})
+	if err != nil || !res.Ok {
+		t.Fatalf("SendLLMRequestGql = %+v, %v", res, err)
+	}
+	node, ok := res.Node.(*model.LLMRequestResult)
+	if !ok || node.ResponseText == nil || *node.ResponseText != "곧은 나무의 기운" || node.TotalTokens == nil || *node.TotalTokens != 15 {
+		t.Fatalf("node = %+v", res.Node)
+	}
+
+	// fixture 없는 요청은 ErrorMessage 로 반환
+	res, _ = s.SendLLMRequestGql(context.Background(), model.SendLLMRequestInput{Prompt: "unknown"})
+	if node, ok := res.Node.(*model.LLMRequestResult); !ok || node.ErrorMessage == nil {
+		t.Fatalf("miss node = %+v", res.Node)
+	}
+
+	res, _ = NewAdminExtractServiceWithLLM(nil).SendLLMRequestGql(context.Background(), model.SendLLMRequestInput{Prompt: "x"})
+	if res.Ok {
+		t.Fatal("nil provider should not be ok")
+	}
+}
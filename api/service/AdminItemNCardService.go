@@ -14,11 +14,12 @@ import (
 )
 
 type AdminItemNCardService struct {
-	repo *dao.ItemNCardRepository
+	repo  *dao.ItemNCardRepository
+	audit *AdminAuditService
 }
 
 func NewAdminItemNCardService() *AdminItemNCardService {
-	return &AdminItemNCardService{repo: dao.NewItemNCardRepository()}
+	return &AdminItemNCardService{repo: dao.NewItemNCardRepository(), audit: NewAdminAuditService()}
 }
 
 func (s *AdminItemNCardService) GetItemnCards(ctx context.Context, input model.ItemNCardSearchInput) (*model.SimpleResult, error) {
@@ -126,6 +127,7 @@ func (s *AdminItemNCardService) CreateItemnCard(ctx context.Context, input model
 	if err := s.repo.Create(card); err != nil {
 		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr(fmt.Sprintf("create card: %v", err))}, nil
 	}
+	s.audit.Record(ctx, "createItemnCard", AuditTargetItemNCard, uid, nil, card)
 	return &model.SimpleResult{Ok: true, UID: &uid}, nil
 }
 
@@ -137,6 +139,7 @@ func (s *AdminItemNCardService) UpdateItemnCard(ctx context.Context, uid string,
 	if err != nil {
 		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr(fmt.Sprintf("card not found: %v", err))}, nil
 	}
+	before := *card
 	card.CardID = input.CardID
 	card.Version = input.Version
 	card.Status = input.Status
@@ -156,12 +159,17 @@ func (s *AdminItemNCardService) UpdateItemnCard(ctx context.Context, uid string,
 	if err := s.repo.Update(card); err != nil {
 		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr(fmt.Sprintf("update card: %v", err))}, nil
 	}
+	s.audit.Record(ctx, "updateItemnCard", AuditTargetItemNCard, uid, &before, card)
 	return &model.SimpleResult{Ok: true, UID: &uid}, nil
 }
 
 func (s *AdminItemNCardService) DeleteItemnCard(ctx context.Context, uid string) (*model.SimpleResult, error) {
+	before, _ := s.repo.FindByUID(uid)
 	if err := s.repo.Delete(uid); err != nil {
 		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr(fmt.Sprintf("delete card: %v", err))}, nil
 	}
+	// soft delete: after 는 deleted_at 이 채워진 문서
+	after, _ := s.repo.FindByUID(uid)
+	s.audit.Record(ctx, "deleteItemnCard", AuditTargetItemNCard, uid, before, after)
 	return &model.SimpleResult{Ok: true}, nil
 }
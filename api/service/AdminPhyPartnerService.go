@@ -21,11 +21,13 @@ import (
 
 type AdminPhyPartnerService struct {
 	phyPartnerRepo *dao.PhyIdealPartnerRepository
+	audit          *AdminAuditService
 }
 
 func NewAdminPhyPartnerService() *AdminPhyPartnerService {
 	return &AdminPhyPartnerService{
 		phyPartnerRepo: dao.NewPhyIdealPartnerRepository(),
+		audit:          NewAdminAuditService(),
 	}
 }
 
@@ -203,7 +205,7 @@ func (s *AdminPhyPartnerService) DeletePhyPartnerGql(ctx context.Context, uid st
 		}, nil
 	}
 
-	_, err := s.phyPartnerRepo.FindByUID(uid)
+	partner, err := s.phyPartnerRepo.FindByUID(uid)
 	if err != nil {
 		return &model.SimpleResult{
 			Ok:  false,
@@ -217,6 +219,7 @@ func (s *AdminPhyPartnerService) DeletePhyPartnerGql(ctx context.Context, uid st
 			Msg: utils.StrPtr(fmt.Sprintf("Failed to delete phy ideal partner: %v", err)),
 		}, nil
 	}
+	s.audit.Record(ctx, "deletePhyIdealPartner", AuditTargetPhyIdealPartner, uid, partner, nil)
 
 	return &model.SimpleResult{
 		Ok:  true,
@@ -280,7 +283,9 @@ func (s *AdminPhyPartnerService) CreatePhyPartnerGql(ctx context.Context, input
 				Msg: utils.StrPtr(fmt.Sprintf("Failed to update phy partner image mime type: %v", err)),
 			}, nil
 		}
+		partner.ImageMimeType = imageMimeType
 	}
+	s.audit.Record(ctx, "createPhyIdealPartner", AuditTargetPhyIdealPartner, partner.Uid, nil, partner)
 
 	return &model.SimpleResult{
 		Ok:   true,
@@ -0,0 +1,84 @@
+// AdminRolePermission: admin role → permission matrix enforced by the admgql @hasPerm directive
+// and, for the /api/adm REST routes, by RequireAdminPerm
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/utils"
+)
+
+// 관리자 권한 (admgql.graphql 의 @hasPerm(perm: ...) 값과 동일)
+const (
+	PermAdminUserWrite   = "admin_user:write"   // 관리자 계정 생성/수정/활성화/역할 지정
+	PermSajuProfileWrite = "saju_profile:write" // 사주 프로필 생성/삭제
+	PermPhyPartnerWrite  = "phy_partner:write"  // 이상형 파트너 생성/삭제
+	PermAiMetaWrite      = "ai_meta:write"      // AI 메타(프롬프트) 저장/사용설정/삭제
+	PermAiExecutionRun   = "ai_execution:run"   // AI 메타 기반 실행
+	PermItemNCardWrite   = "itemn_card:write"   // ItemNCard 생성/수정/삭제
+	PermLLMGenerate      = "llm:generate"       // 사주어셈블 생성 테스트/공통 LLM 요청
+	PermAuditLogRead     = "audit_log:read"     // 관리자 감사 로그 조회
+)
+
+var adminRolePermissions = map[string][]string{
+	entity.AdminRoleOwner: {
+		PermAdminUserWrite, PermSajuProfileWrite, PermPhyPartnerWrite, PermAiMetaWrite,
+		PermAiExecutionRun, PermItemNCardWrite, PermLLMGenerate, PermAuditLogRead,
+	},
+	entity.AdminRoleEditor: {
+		PermSajuProfileWrite, PermPhyPartnerWrite, PermAiMetaWrite,
+		PermAiExecutionRun, PermItemNCardWrite, PermLLMGenerate,
+	},
+	entity.AdminRoleCardAuthor: {
+		PermItemNCardWrite, PermLLMGenerate,
+	},
+	entity.AdminRoleViewer: {},
+}
+
+// IsValidAdminRole reports whether role is one of the defined admin roles.
+func IsValidAdminRole(role string) bool {
+	_, ok := adminRolePermissions[role]
+	return ok
+}
+
+// AdminRoleHasPermission reports whether role grants perm.
+func AdminRoleHasPermission(role string, perm string) bool {
+	for _, p := range adminRolePermissions[entity.EffectiveAdminRole(role)] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAdminPermission returns an error unless the authenticated admin in ctx holds perm.
+func CheckAdminPermission(ctx context.Context, perm string) error {
+	if _, err := utils.GetAdminUserUIDFromContext(ctx); err != nil {
+		return err
+	}
+	if !AdminRoleHasPermission(utils.GetAdminUserRoleFromContext(ctx), perm) {
+		return errors.New("permission denied: " + perm)
+	}
+	return nil
+}
+
+// RequireAdminPerm is the REST counterpart of @hasPerm: 401 without an authenticated admin,
+// 403 when the admin's role does not grant perm.
+func RequireAdminPerm(perm string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, err := utils.GetAdminUserUIDFromContext(r.Context()); err != nil {
+				utils.RespondWithError(w, http.StatusUnauthorized, "Authentication required")
+				return
+			}
+			if err := CheckAdminPermission(r.Context(), perm); err != nil {
+				utils.RespondWithError(w, http.StatusForbidden, "Permission denied")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
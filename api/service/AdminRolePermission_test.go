@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/utils"
+)
+
+func TestAdminRoleHasPermission(t *testing.T) {
+	cases := []struct {
+		role string
+		perm string
+		want bool
+	}{
+		{entity.AdminRoleOwner, PermAdminUserWrite, true},
+		{entity.AdminRoleOwner, PermAiMetaWrite, true},
+		{"", PermAdminUserWrite, false}, // 역할 도입 이전 계정 → viewer
+		{"", PermLLMGenerate, false},
+		{entity.AdminRoleEditor, PermAdminUserWrite, false},
+		{entity.AdminRoleEditor, PermAiMetaWrite, true},
+		{entity.AdminRoleEditor, PermSajuProfileWrite, true},
+		{entity.AdminRoleCardAuthor, PermItemNCardWrite, true},
+		{entity.AdminRoleCardAuthor, PermLLMGenerate, true},
+		{entity.AdminRoleCardAuthor, PermAiMetaWrite, false},
+		{entity.AdminRoleCardAuthor, PermAdminUserWrite, false},
+		{entity.AdminRoleCardAuthor, PermSajuProfileWrite, false},
+		{entity.AdminRoleViewer, PermItemNCardWrite, false},
+		{"unknown", PermItemNCardWrite, false},
+	}
+	for _, c := range cases {
+		if got := AdminRoleHasPermission(c.role, c.perm); got != c.want {
+			t.Errorf("AdminRoleHasPermission(%q, %q) = %v, want %v", c.role, c.perm, got, c.want)
+		}
+	}
+}
+
+func TestCheckAdminPermission(t *testing.T) {
+	if err := CheckAdminPermission(context.Background(), PermItemNCardWrite); err == nil {
+		t.Error("unauthenticated context: expected error")
+	}
+	ctx := utils.SetAdminUserUIDToContext(context.Background(), "a1")
+	ctx = utils.SetAdminUserRoleToContext(ctx, entity.AdminRoleCardAuthor)
+	if err := CheckAdminPermission(ctx, PermItemNCardWrite); err != nil {
+		t.Errorf("card_author itemn_card:write: %v", err)
+	}
+	if err := CheckAdminPermission(ctx, PermAiMetaWrite); err == nil {
+		t.Error("card_author ai_meta:write: expected error")
+	}
+}
+
+func TestRequireAdminPerm(t *testing.T) {
+	h := RequireAdminPerm(PermLLMGenerate)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	cases := []struct {
+		uid, role string
+		want      int
+	}{
+		{"", "", http.StatusUnauthorized},
+		{"a1", entity.AdminRoleViewer, http.StatusForbidden},
+		{"a1", "", http.StatusForbidden}, // 역할 미지정 계정
+		{"a1", entity.AdminRoleCardAuthor, http.StatusOK},
+		{"a1", entity.AdminRoleOwner, http.StatusOK},
+	}
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/api/adm/saju_batch", nil)
+		if c.uid != "" {
+			ctx := utils.SetAdminUserUIDToContext(req.Context(), c.uid)
+			req = req.WithContext(utils.SetAdminUserRoleToContext(ctx, c.role))
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != c.want {
+			t.Errorf("role %q: status = %d, want %d", c.role, rec.Code, c.want)
+		}
+	}
+}
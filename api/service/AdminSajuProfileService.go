@@ -20,12 +20,16 @@ import (
 type AdminSajuProfileService struct {
 	sajuRepo           *dao.SajuProfileRepository
 	sajuProfileLogRepo *dao.SajuProfileLogRepository
+	audit              *AdminAuditService
+	aiExecution        *AdminAiExecutionService
 }
 
 func NewAdminSajuProfileService() *AdminSajuProfileService {
 	return &AdminSajuProfileService{
 		sajuRepo:           dao.NewSajuProfileRepository(),
 		sajuProfileLogRepo: dao.NewSajuProfileLogRepository(),
+		audit:              NewAdminAuditService(),
+		aiExecution:        NewAdminAiExecutionService(),
 	}
 }
 
@@ -76,7 +80,7 @@ func (s *AdminSajuProfileService) DeleteSajuProfileGql(ctx context.Context, uid
 		}, nil
 	}
 
-	_, err := s.sajuRepo.FindByUID(uid)
+	profile, err := s.sajuRepo.FindByUID(uid)
 	if err != nil {
 		return &model.SimpleResult{
 			Ok:  false,
@@ -90,6 +94,10 @@ func (s *AdminSajuProfileService) DeleteSajuProfileGql(ctx context.Context, uid
 			Msg: utils.StrPtr(fmt.Sprintf("Failed to delete saju profile: %v", err)),
 		}, nil
 	}
+	if err := dao.NewSajuProfileDailyRepository().DeleteByProfile(uid); err != nil {
+		log.Printf("[deleteSajuProfile] failed to delete daily feed of %s: %v", uid, err)
+	}
+	s.audit.Record(ctx, "deleteSajuProfile", AuditTargetSajuProfile, uid, profile, nil)
 
 	return &model.SimpleResult{
 		Ok:  true,
@@ -142,6 +150,7 @@ func (s *AdminSajuProfileService) CreateSajuProfileGql(ctx context.Context, inpu
 			Msg: utils.StrPtr(fmt.Sprintf("Failed to save image to S3: %v", err)),
 		}, nil
 	}
+	s.audit.Record(ctx, "createSajuProfile", AuditTargetSajuProfile, profile.Uid, nil, profile)
 
 	return &model.SimpleResult{
 		Ok:   true,
@@ -167,8 +176,8 @@ func (s *AdminSajuProfileService) GetSajuProfileSimilarPartners(ctx context.Cont
 		}, nil
 	}
 	embeddingText := sajuProfile.GeneratePhyPartnerEmbeddingText()
-	openaiDao := extdao.NewOpenAIExtDao()
-	embedding, err := openaiDao.CreateEmbedding(context.Background(), "", embeddingText)
+	// 관리자 조회도 임베딩 비용은 해당 프로필에 누적
+	embedding, err := s.aiExecution.RunEmbedding(ctx, embeddingText, "admin", uid)
 	if err != nil {
 		log.Printf("Failed to create embedding: %v", err)
 		return &model.SimpleResult{
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -30,10 +31,13 @@ func getCycleID(tg, dz int) int {
 	return (tg*6 + dz/2) % 60
 }
 
-// GetPaljaGql calculates palja using sxtwl (만세력)
-func (s *AdminToolService) GetPaljaGql(ctx context.Context, birthdate string, timezone string) (*model.SimpleResult, error) {
-	palja, err := extdao.GenPalja(birthdate, timezone)
+// GetPaljaGql calculates palja using sxtwl (만세력); calendar SOLAR(기본)|LUNAR, leapMonth for 윤달
+func (s *AdminToolService) GetPaljaGql(ctx context.Context, birthdate string, timezone string, calendar *string, leapMonth *bool) (*model.SimpleResult, error) {
+	palja, err := extdao.GenPaljaCalendar(birthdate, timezone, utils.PtrToStr(calendar), leapMonth != nil && *leapMonth)
 	if err != nil {
+		if errors.Is(err, extdao.ErrDateOutOfRange) {
+			return sajuErrResult(err), nil
+		}
 		return nil, err
 	}
 
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/utils"
+)
+
+func TestGetPaljaGql_Range(t *testing.T) {
+	svc := &AdminToolService{}
+
+	// 구 절기표(1800~2200) 밖도 계산된다
+	res, err := svc.GetPaljaGql(context.Background(), "150003151030", "Asia/Seoul", nil, nil)
+	if err != nil || res == nil || !res.Ok {
+		t.Fatalf("GetPaljaGql(1500) = %+v, %v", res, err)
+	}
+
+	res, err = svc.GetPaljaGql(context.Background(), "00000601", "Asia/Seoul", nil, nil)
+	if err != nil {
+		t.Fatalf("GetPaljaGql(0000) error = %v", err)
+	}
+	if res.Ok || res.Err == nil || *res.Err != sajuErrOutOfRange || res.Msg == nil || !strings.Contains(*res.Msg, "year 0") {
+		t.Fatalf("GetPaljaGql(0000) = %+v, want OUT_OF_RANGE", res)
+	}
+}
+
+func TestGetPaljaGql_Lunar(t *testing.T) {
+	svc := &AdminToolService{}
+	palja := func(birthdate string, calendar *string, leapMonth *bool) string {
+		t.Helper()
+		res, err := svc.GetPaljaGql(context.Background(), birthdate, "Asia/Seoul", calendar, leapMonth)
+		if err != nil || !res.Ok {
+			t.Fatalf("GetPaljaGql(%s) = %+v, %v", birthdate, res, err)
+		}
+		var out struct {
+			Palja string `json:"palja"`
+		}
+		if err := json.Unmarshal([]byte(*res.Value), &out); err != nil {
+			t.Fatal(err)
+		}
+		return out.Palja
+	}
+	lunar := utils.StrPtr(extdao.CalendarLunar)
+	for _, leap := range []bool{false, true} {
+		// 2020 윤4월: 평달/윤달은 다른 양력 날짜
+		solar, err := extdao.SolarBirthdate("202004151030", extdao.CalendarLunar, leap)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := palja("202004151030", lunar, &leap), palja(solar, nil, nil); got != want {
+			t.Fatalf("lunar leap=%v palja = %s, want %s (solar %s)", leap, got, want, solar)
+		}
+	}
+	if _, err := svc.GetPaljaGql(context.Background(), "202004151030", "Asia/Seoul", utils.StrPtr("NOON"), nil); err == nil {
+		t.Fatal("expected error for invalid calendar")
+	}
+}
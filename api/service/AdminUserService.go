@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base32"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -21,12 +22,14 @@ import (
 type AdminUserService struct {
 	adminUserRepo    *dao.AdminUserRepo
 	adminUserLogRepo *dao.AdminUserLogRepo
+	audit            *AdminAuditService
 }
 
 func NewAdminUserService() *AdminUserService {
 	return &AdminUserService{
 		adminUserRepo:    dao.NewAdminUserRepo(),
 		adminUserLogRepo: dao.NewAdminUserLogRepo(),
+		audit:            NewAdminAuditService(),
 	}
 }
 
@@ -129,7 +132,7 @@ func (s *AdminUserService) Logout(ctx context.Context) (*model.SimpleResult, err
 	}, nil
 }
 
-// 관리자 생성 join - 권한없이 가능 - 이메일 중복체크 필요
+// 관리자 생성 join - 로그인 권한 필요 - 이메일 중복체크 필요
 // 계정생성시, SecretKey 랜덤생성하여 유저 전달 => ui에서 google otp 생성하는 qr code를 보여줄 예정
 // isActive 기본값 false
 func (s *AdminUserService) CreateAdminUser(ctx context.Context, email string, password string) (*model.SimpleResult, error) {
@@ -173,7 +176,8 @@ func (s *AdminUserService) CreateAdminUser(ctx context.Context, email string, pa
 		Password:  string(hashedPassword),
 		SecretKey: secretKey,
 		IsActive:  false,
-		Username:  email, // Use email as username by default
+		Role:      entity.AdminRoleViewer, // 역할은 owner 가 setAdminUserRole 로 지정
+		Username:  email,                  // Use email as username by default
 	}
 
 	err = s.adminUserRepo.Create(user)
@@ -186,6 +190,7 @@ func (s *AdminUserService) CreateAdminUser(ctx context.Context, email string, pa
 
 	// Log user creation
 	s.logAdminUserAction(ctx, user.Uid, "create", fmt.Sprintf("Admin user created - %s", email), true)
+	s.audit.Record(ctx, "createAdminUser", AuditTargetAdminUser, user.Uid, nil, user)
 
 	// Return OTP URL for QR code generation
 	return &model.SimpleResult{
@@ -231,6 +236,9 @@ func (s *AdminUserService) SetAdminUserActive(ctx context.Context, uid string, a
 		action = "activate"
 	}
 	s.logAdminUserAction(ctx, adminUID, action, fmt.Sprintf("User %s %sd by admin %s", user.Email, action, adminUID), true)
+	after := *user
+	after.IsActive = active
+	s.audit.Record(ctx, "setAdminUserActive", AuditTargetAdminUser, uid, user, &after)
 
 	return &model.SimpleResult{
 		Ok:  true,
@@ -259,6 +267,8 @@ func (s *AdminUserService) UpdateAdminUser(ctx context.Context, uid string, emai
 		}, nil
 	}
 
+	before := *user
+
 	// Check email duplication if email is being changed
 	if user.Email != email {
 		existingUser, err := s.adminUserRepo.FindByEmail(email)
@@ -295,6 +305,7 @@ func (s *AdminUserService) UpdateAdminUser(ctx context.Context, uid string, emai
 
 	// Log the action
 	s.logAdminUserAction(ctx, adminUID, "update", fmt.Sprintf("User %s updated by admin %s", user.Email, adminUID), true)
+	s.audit.Record(ctx, "updateAdminUser", AuditTargetAdminUser, uid, &before, user)
 
 	return &model.SimpleResult{
 		Ok:  true,
@@ -302,6 +313,76 @@ func (s *AdminUserService) UpdateAdminUser(ctx context.Context, uid string, emai
 	}, nil
 }
 
+// AuthenticateToken validates an admin JWT and returns the admin uid and role when the embedded
+// session key matches the stored one and the account is active. Used by the auth middleware.
+func (s *AdminUserService) AuthenticateToken(token string) (string, string, error) {
+	uid, sessionKey, err := utils.ValidateAdminToken(token)
+	if err != nil {
+		return "", "", err
+	}
+	user, err := s.adminUserRepo.FindByUID(uid)
+	if err != nil {
+		return "", "", fmt.Errorf("admin user not found: %w", err)
+	}
+	if user.SessionKey == "" || user.SessionKey != sessionKey {
+		return "", "", errors.New("session expired")
+	}
+	if !user.IsActive {
+		return "", "", errors.New("admin user is not active")
+	}
+	return user.Uid, user.Role, nil
+}
+
+// 관리자 역할 지정 - owner 권한 필요 (@hasPerm admin_user:write) - 본인 역할은 변경 불가
+func (s *AdminUserService) SetAdminUserRole(ctx context.Context, uid string, role string) (*model.SimpleResult, error) {
+	adminUID, err := utils.GetAdminUserUIDFromContext(ctx)
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr("Authentication required"),
+		}, nil
+	}
+
+	if !IsValidAdminRole(role) {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr(fmt.Sprintf("Invalid role: %s", role)),
+		}, nil
+	}
+	if uid == adminUID {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr("Cannot change your own role"),
+		}, nil
+	}
+
+	user, err := s.adminUserRepo.FindByUID(uid)
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr("User not found"),
+		}, nil
+	}
+
+	err = s.adminUserRepo.UpdateRole(uid, role)
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr(fmt.Sprintf("Failed to update user role: %s", err.Error())),
+		}, nil
+	}
+
+	s.logAdminUserAction(ctx, adminUID, "role", fmt.Sprintf("User %s role %s -> %s by admin %s", user.Email, entity.EffectiveAdminRole(user.Role), role, adminUID), true)
+	after := *user
+	after.Role = role
+	s.audit.Record(ctx, "setAdminUserRole", AuditTargetAdminUser, uid, user, &after)
+
+	return &model.SimpleResult{
+		Ok:  true,
+		Msg: utils.StrPtr(fmt.Sprintf("User role set to %s", role)),
+	}, nil
+}
+
 // GetAdminUsers returns all admin users as SimpleResult with nodes and total.
 func (s *AdminUserService) GetAdminUsers(ctx context.Context) (*model.SimpleResult, error) {
 	users, err := s.adminUserRepo.FindAll()
@@ -0,0 +1,119 @@
+// AI 실행 비용: config 단가표(config/prices.go) 기준 AiExecution 비용 계산, 사주 프로필별 누적 및 집계 조회
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"sajudating_api/api/admgql/model"
+	"sajudating_api/api/config"
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/utils"
+	"sajudating_api/api/utils/dslog"
+)
+
+// defaultAiModel is the model QueryLLM uses when an AiMeta leaves it empty.
+const defaultAiModel = "gpt-4o-mini"
+
+// defaultEmbeddingModel is the model CreateEmbedding uses when called without one.
+const defaultEmbeddingModel = "text-embedding-3-small"
+
+// aiExecutionMetaTypeEmbedding marks embedding runs, which have no AiMeta.
+const aiExecutionMetaTypeEmbedding = "Embedding"
+
+// AiExecutionCost returns the USD cost of an execution from its usage; models missing from the price table cost 0.
+func AiExecutionCost(e *entity.AiExecution) float64 {
+	modelName := e.Model
+	if modelName == "" {
+		modelName = defaultAiModel
+	}
+	price, ok := config.PriceOf(modelName)
+	if !ok {
+		return 0
+	}
+	return price.Cost(e.InputTokens, e.OutputTokens, e.ImageCount)
+}
+
+// recordUsage stores usage and cost on the execution and adds them to the saju profile it ran for.
+func (s *AdminAiExecutionService) recordUsage(e *entity.AiExecution, usage *extdao.Usage, images int) {
+	if usage != nil {
+		e.InputTokens = usage.Input
+		e.OutputTokens = usage.Output
+		e.TotalTokens = usage.Total
+	}
+	e.ImageCount = images
+	e.Cost = AiExecutionCost(e)
+	if e.RunSajuProfileUid == "" || (e.Cost == 0 && e.TotalTokens == 0) {
+		return
+	}
+	if err := s.sajuProfileRepo.AddAiUsage(e.RunSajuProfileUid, e.Cost, e.TotalTokens); err != nil {
+		dslog.Log("error", fmt.Sprintf("[recordUsage] Failed to add ai usage to saju profile %s: %v", e.RunSajuProfileUid, err))
+	}
+}
+
+// failAfterUsage marks an execution failed after the provider call succeeded, keeping its usage and cost.
+func (s *AdminAiExecutionService) failAfterUsage(e *entity.AiExecution, err error) {
+	e.Status = "failed"
+	e.ErrorMessage = err.Error()
+	if uerr := s.aiExecutionRepo.Update(e); uerr != nil {
+		dslog.Log("error", fmt.Sprintf("[failAfterUsage] Failed to update ai execution: %v", uerr))
+	}
+}
+
+var aiCostGroupBy = map[model.AiCostGroupBy]string{
+	model.AiCostGroupByDay:         dao.AiCostGroupByDay,
+	model.AiCostGroupByMetaType:    dao.AiCostGroupByMetaType,
+	model.AiCostGroupBySajuProfile: dao.AiCostGroupBySajuProfile,
+	model.AiCostGroupByModel:       dao.AiCostGroupByModel,
+}
+
+// GetAiCostSummary aggregates execution usage and cost by day / meta type / saju profile / model.
+func (s *AdminAiExecutionService) GetAiCostSummary(ctx context.Context, input model.AiCostSummaryInput) (*model.SimpleResult, error) {
+	groupBy, ok := aiCostGroupBy[input.GroupBy]
+	if !ok {
+		return &model.SimpleResult{Ok: false, Err: utils.StrPtr(fmt.Sprintf("invalid groupBy: %s", input.GroupBy))}, nil
+	}
+	limit, offset := 100, 0
+	if input.Limit != nil {
+		limit = *input.Limit
+	}
+	if input.Offset != nil {
+		offset = *input.Offset
+	}
+	buckets, total, err := s.aiExecutionRepo.AggregateCost(groupBy, dao.AiCostFilter{
+		FromCreatedAt:     input.FromCreatedAt,
+		ToCreatedAt:       input.ToCreatedAt,
+		MetaType:          input.MetaType,
+		Model:             input.Model,
+		RunSajuProfileUid: input.RunSajuProfileUID,
+	}, limit, offset)
+	if err != nil {
+		return &model.SimpleResult{Ok: false, Err: utils.StrPtr(fmt.Sprintf("Failed to aggregate ai cost: %v", err))}, nil
+	}
+
+	nodes := make([]model.Node, len(buckets))
+	totalCost := 0.0
+	for i, b := range buckets {
+		nodes[i] = &model.AiCostBucket{
+			ID:           utils.StrPtr(groupBy + ":" + b.Key),
+			Key:          b.Key,
+			Count:        b.Count,
+			InputTokens:  b.InputTokens,
+			OutputTokens: b.OutputTokens,
+			TotalTokens:  b.TotalTokens,
+			ImageCount:   b.ImageCount,
+			Cost:         b.Cost,
+		}
+		totalCost += b.Cost
+	}
+	return &model.SimpleResult{
+		Ok:     true,
+		Nodes:  nodes,
+		Total:  utils.IntPtr(int(total)),
+		Limit:  utils.IntPtr(limit),
+		Offset: utils.IntPtr(offset),
+		Value:  utils.StrPtr(fmt.Sprintf("%.6f", totalCost)), // 현재 페이지 비용 합계
+	}, nil
+}
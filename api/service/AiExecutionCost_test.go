@@ -0,0 +1,50 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"sajudating_api/api/config"
+	"sajudating_api/api/dao/entity"
+	extdao "sajudating_api/api/ext_dao"
+)
+
+func TestAiExecutionCost(t *testing.T) {
+	cases := []struct {
+		name string
+		exec entity.AiExecution
+		want float64
+	}{
+		{"text", entity.AiExecution{Model: "gpt-4o-mini", InputTokens: 1_000_000, OutputTokens: 500_000}, 0.15 + 0.3},
+		{"default model", entity.AiExecution{InputTokens: 2000, OutputTokens: 1000}, 2000*0.15/1e6 + 1000*0.6/1e6},
+		{"dated snapshot uses longest prefix", entity.AiExecution{Model: "gpt-4o-mini-2024-07-18", InputTokens: 1_000_000}, 0.15},
+		{"per image", entity.AiExecution{Model: "dall-e-3", ImageCount: 1}, 0.04},
+		{"token priced image", entity.AiExecution{Model: "gpt-image-1-mini", InputTokens: 312, OutputTokens: 4160, ImageCount: 1}, 312*2/1e6 + 4160*8/1e6},
+		{"unknown model", entity.AiExecution{Model: "mystery-1", InputTokens: 1000}, 0},
+	}
+	for _, c := range cases {
+		if got := AiExecutionCost(&c.exec); math.Abs(got-c.want) > 1e-12 {
+			t.Errorf("%s: cost = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestAiExecutionCost_ConfiguredPrices(t *testing.T) {
+	prev := config.AppConfig
+	config.AppConfig = &config.Config{Prices: map[string]config.ModelPrice{"gpt-4o-mini": {InputPer1M: 1, OutputPer1M: 2}}}
+	defer func() { config.AppConfig = prev }()
+
+	got := AiExecutionCost(&entity.AiExecution{Model: "gpt-4o-mini", InputTokens: 1_000_000, OutputTokens: 1_000_000})
+	if got != 3 {
+		t.Errorf("cost = %v, want 3", got)
+	}
+}
+
+func TestRecordUsage_WithoutProfile(t *testing.T) {
+	s := &AdminAiExecutionService{} // run_saju_profile_uid 없으면 DB 접근 없음
+	e := &entity.AiExecution{Model: "gpt-4o"}
+	s.recordUsage(e, &extdao.Usage{Input: 1000, Output: 100, Total: 1100}, 0)
+	if e.TotalTokens != 1100 || math.Abs(e.Cost-(1000*2.5/1e6+100*10/1e6)) > 1e-12 {
+		t.Errorf("execution = %+v", e)
+	}
+}
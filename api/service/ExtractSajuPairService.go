@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,26 +14,29 @@ import (
 	"sajudating_api/api/utils"
 )
 
+// sajuErrOutOfRange: SimpleResult.Err 코드 - 엔진 지원 범위 밖 날짜
+const sajuErrOutOfRange = "OUT_OF_RANGE"
+
 // ExtractSajuPairService provides GraphQL-facing methods for extract_saju / extract_pair queries.
 type ExtractSajuPairService struct {
 	// 외부 의존성을 함수로 분리해 테스트에서 sxtwl 호출을 쉽게 대체한다.
-	callSxtwl func(y, m, d int, hh, mm *int, timezone string, longitude *float64) (*extdao.SxtwlResult, error)
+	callSxtwl func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error)
 	// 문서 생성 시각 고정을 위해 주입 가능하게 둔다.
 	now func() time.Time
 }
 
 // NewExtractSajuPairService returns a new ExtractSajuPairService.
 func NewExtractSajuPairService() *ExtractSajuPairService {
-	return newExtractSajuPairServiceWithDeps(extdao.CallSxtwlOptional, func() time.Time { return time.Now().UTC() })
+	return newExtractSajuPairServiceWithDeps(extdao.CallSxtwlSolarTime, func() time.Time { return time.Now().UTC() })
 }
 
 // newExtractSajuPairServiceWithDeps 는 테스트/운영 모두에서 동일한 생성 경로를 사용하기 위한 내부 팩토리다.
 func newExtractSajuPairServiceWithDeps(
-	callSxtwl func(y, m, d int, hh, mm *int, timezone string, longitude *float64) (*extdao.SxtwlResult, error),
+	callSxtwl func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error),
 	now func() time.Time,
 ) *ExtractSajuPairService {
 	if callSxtwl == nil {
-		callSxtwl = extdao.CallSxtwlOptional
+		callSxtwl = extdao.CallSxtwlSolarTime
 	}
 	if now == nil {
 		now = func() time.Time { return time.Now().UTC() }
@@ -47,7 +52,7 @@ func (s *ExtractSajuPairService) ExtractSajuGql(ctx context.Context, input model
 	_ = ctx
 	doc, _, err := s.calculateSajuDoc(input, "extract_saju")
 	if err != nil {
-		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr(err.Error())}, nil
+		return sajuErrResult(err), nil
 	}
 	gqlDoc, err := toModelExtractSajuDoc(doc)
 	if err != nil {
@@ -68,11 +73,11 @@ func (s *ExtractSajuPairService) ExtractPairGql(ctx context.Context, input model
 
 	docA, birthA, err := s.calculateSajuDoc(*input.A, "extract_pair.a")
 	if err != nil {
-		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr("failed to calculate A: " + err.Error())}, nil
+		return sajuErrResult(fmt.Errorf("failed to calculate A: %w", err)), nil
 	}
 	docB, birthB, err := s.calculateSajuDoc(*input.B, "extract_pair.b")
 	if err != nil {
-		return &model.SimpleResult{Ok: false, Msg: utils.StrPtr("failed to calculate B: " + err.Error())}, nil
+		return sajuErrResult(fmt.Errorf("failed to calculate B: %w", err)), nil
 	}
 
 	pairInput := domain.PairInput{
@@ -92,9 +97,22 @@ func (s *ExtractSajuPairService) ExtractPairGql(ctx context.Context, input model
 	return &model.SimpleResult{Ok: true, Node: gqlDoc}, nil
 }
 
+// sajuErrResult: 지원 범위 밖 날짜는 Err "OUT_OF_RANGE" 로 구분해 돌려준다 (잘못된 명식 대신 실패).
+func sajuErrResult(err error) *model.SimpleResult {
+	res := &model.SimpleResult{Ok: false, Msg: utils.StrPtr(err.Error())}
+	if errors.Is(err, extdao.ErrDateOutOfRange) {
+		res.Err = utils.StrPtr(sajuErrOutOfRange)
+	}
+	return res
+}
+
 func (s *ExtractSajuPairService) calculateSajuDoc(input model.ExtractSajuInput, caller string) (*domain.SajuDoc, domain.BirthInput, error) {
-	// 1) 입력 시간 문자열을 표준 파트(년월일시분)로 정규화
-	parts, err := parseLocalDateTime(selectDtLocal(input))
+	// 1) 입력 시간 문자열을 표준 파트(년월일시분)로 정규화 (음력 입력은 양력으로 변환)
+	calendar, err := extdao.NormalizeCalendar(utils.PtrToStr(input.Calendar))
+	if err != nil {
+		return nil, domain.BirthInput{}, fmt.Errorf("%s: %w", caller, err)
+	}
+	parts, solarDt, err := resolveSolarBirthParts(input, calendar)
 	if err != nil {
 		return nil, domain.BirthInput{}, fmt.Errorf("%s: invalid dtLocal: %w", caller, err)
 	}
@@ -106,17 +124,36 @@ func (s *ExtractSajuPairService) calculateSajuDoc(input model.ExtractSajuInput,
 		tz = "Asia/Seoul"
 	}
 
-	// 3) sxtwl 호출용 시/분 결정(시주 미상은 nil 전달)
+	// 3) sxtwl 호출용 시/분 결정(시주 미상은 nil 전달), loc 가 있으면 진태양시 보정
 	hh, mm := toHourMinute(parts, timePrec)
-	palja, err := s.callSxtwl(parts.Year, parts.Month, parts.Day, hh, mm, tz, nil)
+	birthInput := toDomainBirthInput(input, timePrec)
+	solarMode, err := domain.SolarTimeModeOf(birthInput)
+	if err != nil {
+		return nil, domain.BirthInput{}, fmt.Errorf("%s: %w", caller, err)
+	}
+	var longitude *float64
+	if input.Loc != nil {
+		longitude = &input.Loc.Lon
+	}
+	palja, err := s.callSxtwl(parts.Year, parts.Month, parts.Day, hh, mm, tz, longitude, string(solarMode))
 	if err != nil {
 		return nil, domain.BirthInput{}, fmt.Errorf("%s: sxtwl failed: %w", caller, err)
 	}
+	zishi, err := domain.ZishiConventionOf(birthInput.Engine)
+	if err != nil {
+		return nil, domain.BirthInput{}, fmt.Errorf("%s: %w", caller, err)
+	}
+	if err := extdao.ApplyZishiConvention(palja, string(zishi)); err != nil {
+		return nil, domain.BirthInput{}, fmt.Errorf("%s: %w", caller, err)
+	}
 
 	// 4) 만세력 원천값을 도메인 문서 생성용 구조로 변환
 	raw := toRawPillars(palja)
-	birthInput := toDomainBirthInput(input, timePrec)
 	birthInput.Tz = tz
+	birthInput.Calendar = calendar
+	if solarDt != "" {
+		birthInput.SolarDt = solarDt
+	}
 
 	// 5) 도메인 규칙으로 SajuDoc 계산
 	doc, err := domain.BuildSajuDocAt(birthInput, raw, s.now())
@@ -170,6 +207,8 @@ func toDomainBirthInput(input model.ExtractSajuInput, timePrec domain.TimePrecis
 		WolunYear:     input.WolunYear,
 		IlunYear:      input.IlunYear,
 		IlunMonth:     input.IlunMonth,
+
+		DaeunConvention: utils.PtrToStr(input.DaeunConvention),
 	}
 }
 
@@ -221,6 +260,55 @@ func selectDtLocal(input model.ExtractSajuInput) string {
 	return input.DtLocal
 }
 
+// resolveSolarBirthParts 는 계산에 쓸 양력 파트를 돌려준다. 보정일시(adjustedDt)는 이미 양력으로 본다.
+// 음력 dtLocal 은 양력으로 변환하고 변환 결과 문자열(solarDt)을 함께 돌려준다.
+func resolveSolarBirthParts(input model.ExtractSajuInput, calendar string) (localDateTimeParts, string, error) {
+	if calendar != extdao.CalendarLunar || (input.AdjustedDt != nil && *input.AdjustedDt != "") {
+		parts, err := parseLocalDateTime(selectDtLocal(input))
+		return parts, "", err
+	}
+	parts, err := parseLunarLocalDateTime(input.DtLocal)
+	if err != nil {
+		return localDateTimeParts{}, "", err
+	}
+	leap := input.LeapMonth != nil && *input.LeapMonth
+	parts.Year, parts.Month, parts.Day, err = extdao.ResolveSolarDate(calendar, leap, parts.Year, parts.Month, parts.Day)
+	if err != nil {
+		return localDateTimeParts{}, "", err
+	}
+	solarDt := fmt.Sprintf("%04d-%02d-%02d", parts.Year, parts.Month, parts.Day)
+	if parts.HasTime {
+		solarDt += fmt.Sprintf("T%02d:%02d", parts.Hour, parts.Minute)
+	}
+	return parts, solarDt, nil
+}
+
+// parseLunarLocalDateTime 은 양력 달력에 없는 음력 일자(예: 2월 30일)도 받도록 날짜부를 직접 읽는다.
+func parseLunarLocalDateTime(v string) (localDateTimeParts, error) {
+	var datePart, placeholder string
+	switch {
+	case len(v) >= 10 && v[4] == '-' && v[7] == '-':
+		datePart, placeholder = v[:10], "2000-01-01"
+	case len(v) >= 8:
+		datePart, placeholder = v[:8], "20000101"
+	default:
+		return localDateTimeParts{}, fmt.Errorf("unsupported format: %q", v)
+	}
+	parts, err := parseLocalDateTime(placeholder + v[len(datePart):])
+	if err != nil {
+		return localDateTimeParts{}, fmt.Errorf("unsupported format: %q", v)
+	}
+	digits := strings.ReplaceAll(datePart, "-", "")
+	y, errY := strconv.Atoi(digits[0:4])
+	m, errM := strconv.Atoi(digits[4:6])
+	d, errD := strconv.Atoi(digits[6:8])
+	if errY != nil || errM != nil || errD != nil {
+		return localDateTimeParts{}, fmt.Errorf("unsupported format: %q", v)
+	}
+	parts.Year, parts.Month, parts.Day = y, m, d
+	return parts, nil
+}
+
 type localDateTimeParts struct {
 	Year    int
 	Month   int
@@ -310,7 +398,7 @@ func clampDay(year, month, day int) int {
 
 func (s *ExtractSajuPairService) calcRawPillarsAt(parts localDateTimeParts, timePrec domain.TimePrecision, tz string) (domain.RawPillars, error) {
 	hh, mm := toHourMinute(parts, timePrec)
-	res, err := s.callSxtwl(parts.Year, parts.Month, parts.Day, hh, mm, tz, nil)
+	res, err := s.callSxtwl(parts.Year, parts.Month, parts.Day, hh, mm, tz, nil, "")
 	if err != nil {
 		return domain.RawPillars{}, err
 	}
@@ -476,22 +564,14 @@ func (s *ExtractSajuPairService) applyFortuneRuns(doc *domain.SajuDoc, in domain
 	doc.Wolun = &wolun
 	doc.Ilun = &ilun
 
-	// 대운 포인트는 운세 기준 연도(기본: dtLocal) 기준으로 재선택한다.
-	if len(doc.DaeunList) > 0 && birthParts.Year > 0 && baseParts.Year > 0 {
-		age := baseParts.Year - birthParts.Year + 1
-		if age < 1 {
-			age = 1
-		}
-		idx := (age - 1) / 10
-		if idx < 0 {
-			idx = 0
-		}
-		if idx >= len(doc.DaeunList) {
-			idx = len(doc.DaeunList) - 1
+	// 대운 포인트는 운세 기준 일시(기본: dtLocal) 시점으로 재선택한다.
+	if len(doc.DaeunList) > 0 {
+		loc, err := time.LoadLocation(in.Tz)
+		if err != nil {
+			return fmt.Errorf("invalid tz: %w", err)
 		}
-		current := doc.DaeunList[idx]
-		current.Type = "DAEUN"
-		doc.Daeun = &current
+		baseAt := time.Date(baseParts.Year, time.Month(baseParts.Month), baseParts.Day, baseParts.Hour, baseParts.Minute, 0, 0, loc)
+		doc.Daeun = domain.PickCurrentDaeun(doc.DaeunList, baseAt.UnixMilli())
 	}
 
 	seunList, err := s.buildSeunList(in, baseParts, dayMaster)
@@ -834,6 +914,8 @@ func toModelSajuInputDisplay(in domain.BirthInput) *model.ExtractSajuInputDispla
 		WolunYear:     in.WolunYear,
 		IlunYear:      in.IlunYear,
 		IlunMonth:     in.IlunMonth,
+
+		DaeunConvention: strPtrIfNotEmpty(in.DaeunConvention),
 	}
 	if in.Loc != nil {
 		out.Loc = &model.ExtractGeo{
@@ -841,6 +923,16 @@ func toModelSajuInputDisplay(in domain.BirthInput) *model.ExtractSajuInputDispla
 			Lon: in.Loc.Lon,
 		}
 	}
+	if in.SolarTime != nil {
+		out.SolarTime = &model.ExtractSolarTime{
+			Mode:              in.SolarTime.Mode,
+			TzOffsetMinutes:   in.SolarTime.TzOffsetMinutes,
+			LongitudeMinutes:  in.SolarTime.LongitudeMinutes,
+			EquationOfTime:    in.SolarTime.EquationOfTime,
+			CorrectionMinutes: in.SolarTime.CorrectionMinutes,
+			SolarDt:           in.SolarTime.SolarDt,
+		}
+	}
 	return out
 }
 
@@ -969,6 +1061,11 @@ func toModelDaeunPeriod(in domain.DaeunPeriod) *model.ExtractDaeunPeriod {
 		Year:         in.Year,
 		Month:        in.Month,
 		Day:          in.Day,
+
+		StartAgeYears:  in.StartAgeYears,
+		StartAgeMonths: in.StartAgeMonths,
+		StartDt:        strPtrIfNotEmpty(in.StartDt),
+		Convention:     strPtrIfNotEmpty(in.Convention),
 	}
 }
 
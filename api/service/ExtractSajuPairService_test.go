@@ -9,12 +9,13 @@ import (
 
 	"sajudating_api/api/admgql/model"
 	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/utils"
 )
 
 func TestExtractSajuGql_Success(t *testing.T) {
 	called := 0
 	svc := newExtractSajuPairServiceWithDeps(
-		func(y, m, d int, hh, mm *int, timezone string, longitude *float64) (*extdao.SxtwlResult, error) {
+		func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error) {
 			called++
 			if y != 1990 || m != 5 || d != 15 {
 				t.Fatalf("unexpected date: %04d-%02d-%02d", y, m, d)
@@ -62,7 +63,7 @@ func TestExtractSajuGql_Success(t *testing.T) {
 func TestExtractSajuGql_FortuneBaseAndRangeLists(t *testing.T) {
 	called := 0
 	svc := newExtractSajuPairServiceWithDeps(
-		func(y, m, d int, hh, mm *int, timezone string, longitude *float64) (*extdao.SxtwlResult, error) {
+		func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error) {
 			called++
 			yStem := y % 10
 			yBranch := y % 12
@@ -99,7 +100,7 @@ func TestExtractSajuGql_FortuneBaseAndRangeLists(t *testing.T) {
 		t.Fatalf("ExtractSajuGql() error = %v", err)
 	}
 	if !res.Ok {
-		t.Fatalf("ok = false, msg = %v", res.Msg)
+		t.Fatalf("ok = false, msg = %s", utils.PtrToStr(res.Msg))
 	}
 	node, ok := res.Node.(*model.ExtractSajuDoc)
 	if !ok {
@@ -134,7 +135,7 @@ func TestExtractSajuGql_FortuneBaseAndRangeLists(t *testing.T) {
 
 func TestExtractSajuGql_InvalidDt(t *testing.T) {
 	svc := newExtractSajuPairServiceWithDeps(
-		func(y, m, d int, hh, mm *int, timezone string, longitude *float64) (*extdao.SxtwlResult, error) {
+		func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error) {
 			return nil, errors.New("should not be called")
 		},
 		nil,
@@ -157,7 +158,7 @@ func TestExtractSajuGql_InvalidDt(t *testing.T) {
 func TestExtractPairGql_Success(t *testing.T) {
 	call := 0
 	svc := newExtractSajuPairServiceWithDeps(
-		func(y, m, d int, hh, mm *int, timezone string, longitude *float64) (*extdao.SxtwlResult, error) {
+		func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error) {
 			call++
 			if call == 1 {
 				return buildMockSxtwlResult(6, 6, 7, 5, 4, 10, intPtr(9), intPtr(3)), nil
@@ -213,7 +214,7 @@ func TestExtractPairGql_Success(t *testing.T) {
 func TestExtractPairGql_SxtwlError(t *testing.T) {
 	call := 0
 	svc := newExtractSajuPairServiceWithDeps(
-		func(y, m, d int, hh, mm *int, timezone string, longitude *float64) (*extdao.SxtwlResult, error) {
+		func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error) {
 			call++
 			if call == 1 {
 				return buildMockSxtwlResult(6, 6, 7, 5, 4, 10, intPtr(9), intPtr(3)), nil
@@ -284,3 +285,131 @@ func strPtr(v string) *string {
 	x := v
 	return &x
 }
+
+func TestExtractSajuGql_LunarInput(t *testing.T) {
+	var gotY, gotM, gotD int
+	svc := newExtractSajuPairServiceWithDeps(
+		func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error) {
+			gotY, gotM, gotD = y, m, d
+			return buildMockSxtwlResult(9, 3, 1, 3, 4, 10, intPtr(1), intPtr(5)), nil
+		},
+		func() time.Time { return time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC) },
+	)
+
+	calendar := "LUNAR"
+	timePrec := model.ExtractTimePrecisionMinute
+	// 음력 2023-02-30 (평달, 양력 달력에 없는 일자) = 양력 2023-03-21
+	res, err := svc.ExtractSajuGql(context.Background(), model.ExtractSajuInput{
+		DtLocal:  "2023-02-30T09:30",
+		Tz:       "Asia/Seoul",
+		Calendar: &calendar,
+		TimePrec: &timePrec,
+		Engine:   &model.ExtractEngineInput{Name: "sxtwl", Ver: "1"},
+	})
+	if err != nil {
+		t.Fatalf("ExtractSajuGql() error = %v", err)
+	}
+	if !res.Ok {
+		t.Fatalf("ok = false, msg = %s", utils.PtrToStr(res.Msg))
+	}
+	if gotY != 2023 || gotM != 3 || gotD != 21 {
+		t.Fatalf("sxtwl date = %04d-%02d-%02d, want 2023-03-21", gotY, gotM, gotD)
+	}
+	node := res.Node.(*model.ExtractSajuDoc)
+	if node.Input == nil || node.Input.SolarDt == nil || *node.Input.SolarDt != "2023-03-21T09:30" {
+		t.Fatalf("input.solarDt = %v, want 2023-03-21T09:30", node.Input)
+	}
+	if node.Input.Calendar == nil || *node.Input.Calendar != "LUNAR" || node.Input.DtLocal != "2023-02-30T09:30" {
+		t.Fatalf("input = %+v", node.Input)
+	}
+
+	leap := true
+	res, err = svc.ExtractSajuGql(context.Background(), model.ExtractSajuInput{
+		DtLocal:   "2024-03-01",
+		Tz:        "Asia/Seoul",
+		Calendar:  &calendar,
+		LeapMonth: &leap,
+		Engine:    &model.ExtractEngineInput{Name: "sxtwl", Ver: "1"},
+	})
+	if err != nil {
+		t.Fatalf("ExtractSajuGql() error = %v", err)
+	}
+	if res.Ok || res.Msg == nil || !strings.Contains(*res.Msg, "no leap month") {
+		t.Fatalf("expected no-leap-month failure, got ok=%v msg=%v", res.Ok, res.Msg)
+	}
+}
+
+func TestExtractSajuGql_SolarTimeFromLoc(t *testing.T) {
+	svc := newExtractSajuPairServiceWithDeps(
+		func(y, m, d int, hh, mm *int, timezone string, longitude *float64, solarTimeMode string) (*extdao.SxtwlResult, error) {
+			if longitude == nil || *longitude != 126.98 || solarTimeMode != "APPARENT" {
+				t.Fatalf("longitude = %v, mode = %q, want 126.98 APPARENT", longitude, solarTimeMode)
+			}
+			return extdao.CallSxtwlSolarTime(y, m, d, hh, mm, timezone, longitude, solarTimeMode)
+		},
+		func() time.Time { return time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC) },
+	)
+
+	timePrec := model.ExtractTimePrecisionMinute
+	res, err := svc.ExtractSajuGql(context.Background(), model.ExtractSajuInput{
+		DtLocal:  "2024-02-11T09:40",
+		Tz:       "Asia/Seoul",
+		Loc:      &model.ExtractGeoInput{Lat: 37.57, Lon: 126.98},
+		TimePrec: &timePrec,
+		Engine:   &model.ExtractEngineInput{Name: "sxtwl", Ver: "1"},
+	})
+	if err != nil || !res.Ok {
+		t.Fatalf("ExtractSajuGql() = %+v, %v", res, err)
+	}
+	node := res.Node.(*model.ExtractSajuDoc)
+	st := node.Input.SolarTime
+	if st == nil || st.Mode != "APPARENT" || st.CorrectionMinutes != -46 || st.SolarDt != "2024-02-11T08:54" {
+		t.Fatalf("solarTime = %+v", st)
+	}
+	if node.Input.Engine.Params["solarTimeMode"] != "APPARENT" {
+		t.Fatalf("engine params = %+v", node.Input.Engine.Params)
+	}
+	if node.Pillars[3].Branch != 4 {
+		t.Fatalf("hour branch = %d, want 辰(4)", node.Pillars[3].Branch)
+	}
+
+	res, err = svc.ExtractSajuGql(context.Background(), model.ExtractSajuInput{
+		DtLocal:  "2024-02-11T09:40",
+		Tz:       "Asia/Seoul",
+		TimePrec: &timePrec,
+		Engine:   &model.ExtractEngineInput{Name: "sxtwl", Ver: "1", Params: map[string]any{"solarTimeMode": "MEAN"}},
+	})
+	if err != nil || res.Ok {
+		t.Fatalf("expected failure for solarTimeMode without loc, got %+v", res)
+	}
+}
+
+func TestExtractSajuGql_OutOfRange(t *testing.T) {
+	svc := newExtractSajuPairServiceWithDeps(nil, func() time.Time { return time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC) })
+
+	res, err := svc.ExtractSajuGql(context.Background(), model.ExtractSajuInput{
+		DtLocal: "0000-06-01 10:00",
+		Tz:      "Asia/Seoul",
+		Engine:  &model.ExtractEngineInput{Name: "sxtwl", Ver: "1"},
+	})
+	if err != nil {
+		t.Fatalf("ExtractSajuGql() error = %v", err)
+	}
+	if res.Ok || res.Err == nil || *res.Err != sajuErrOutOfRange {
+		t.Fatalf("result = %+v, want OUT_OF_RANGE", res)
+	}
+
+	lunar := "LUNAR"
+	res, err = svc.ExtractSajuGql(context.Background(), model.ExtractSajuInput{
+		DtLocal:  "1850-06-01",
+		Tz:       "Asia/Seoul",
+		Calendar: &lunar,
+		Engine:   &model.ExtractEngineInput{Name: "sxtwl", Ver: "1"},
+	})
+	if err != nil {
+		t.Fatalf("ExtractSajuGql() error = %v", err)
+	}
+	if res.Ok || res.Err == nil || *res.Err != sajuErrOutOfRange {
+		t.Fatalf("lunar result = %+v, want OUT_OF_RANGE", res)
+	}
+}
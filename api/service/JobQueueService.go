@@ -0,0 +1,391 @@
+// JobQueueService runs the Mongo-backed background job queue (leasing, retries with backoff, dead-letter)
+// and serves job state to admgql.
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sajudating_api/api/admgql/model"
+	"sajudating_api/api/config"
+	"sajudating_api/api/converter"
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/utils"
+)
+
+const (
+	jobPollInterval = 2 * time.Second
+	jobLeaseFor     = 2 * time.Minute // 실행중에는 jobLeaseRenew 마다 연장, 워커가 죽으면 만료 후 다른 워커가 리스
+	jobLeaseRenew   = 30 * time.Second
+	jobBackoffBase  = 10 * time.Second
+	jobBackoffMax   = 10 * time.Minute
+)
+
+// JobRunFunc executes one attempt of a job. Steps already recorded in job.Steps must be skipped
+// (use JobQueueService.CompleteStep after each step). A returned error schedules a retry.
+type JobRunFunc func(ctx context.Context, job *entity.Job) error
+
+// JobDeadFunc is called once when a job exhausts its attempts (dead-letter).
+type JobDeadFunc func(job *entity.Job, err error)
+
+// jobStore is the queue's persistence (dao.JobRepository; tests use an in-memory store).
+type jobStore interface {
+	Create(job *entity.Job) error
+	CreateUnique(job *entity.Job) (bool, error)
+	FindByUID(uid string) (*entity.Job, error)
+	FindWithPagination(f dao.JobFilter) ([]entity.Job, int64, error)
+	Lease(owner string, types []string, leaseFor time.Duration) (*entity.Job, error)
+	CompleteStep(uid, owner, step string, payload map[string]string, unsetKeys ...string) error
+	Finish(uid, owner, status, lastError string, unsetKeys ...string) error
+	Reschedule(uid, owner string, runAfter int64, lastError string) error
+	Requeue(uid string) (bool, error)
+	PurgePayload(uid string) (bool, error)
+	ExtendLease(uid, owner string, leaseFor time.Duration) (bool, error)
+}
+
+type jobHandler struct {
+	run       JobRunFunc
+	onDead    JobDeadFunc
+	clearKeys []string
+}
+
+type JobQueueService struct {
+	jobRepo     jobStore
+	audit       *AdminAuditService
+	owner       string // 인스턴스 id, 워커별 lease owner 는 owner-<n>
+	workers     int
+	maxAttempts int
+	mu          sync.RWMutex
+	handlers    map[string]jobHandler
+	startOnce   sync.Once
+}
+
+var (
+	jobQueueService     *JobQueueService
+	jobQueueServiceOnce sync.Once
+)
+
+// GetJobQueueService returns the process-wide queue (handlers are registered once at startup).
+func GetJobQueueService() *JobQueueService {
+	jobQueueServiceOnce.Do(func() {
+		workers, maxAttempts := 4, 3
+		if config.AppConfig != nil {
+			workers, maxAttempts = config.AppConfig.Jobs.Workers, config.AppConfig.Jobs.MaxAttempts
+		}
+		jobQueueService = NewJobQueueService(workers, maxAttempts)
+	})
+	return jobQueueService
+}
+
+func NewJobQueueService(workers, maxAttempts int) *JobQueueService {
+	if workers < 1 {
+		workers = 1
+	}
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &JobQueueService{
+		jobRepo:     dao.NewJobRepository(),
+		audit:       NewAdminAuditService(),
+		owner:       utils.GenUid(),
+		workers:     workers,
+		maxAttempts: maxAttempts,
+		handlers:    map[string]jobHandler{},
+	}
+}
+
+// RegisterHandler binds a job type to its run/dead callbacks. clearKeys are payload keys removed
+// when the job finishes successfully, e.g. user image data that must not be kept. A dead job keeps
+// them so retryJob can run it again; purgeJobPayload drops them explicitly.
+func (s *JobQueueService) RegisterHandler(jobType string, run JobRunFunc, onDead JobDeadFunc, clearKeys ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[jobType] = jobHandler{run: run, onDead: onDead, clearKeys: clearKeys}
+}
+
+// Enqueue stores a pending job; a worker picks it up on its next poll.
+func (s *JobQueueService) Enqueue(jobType, refUid string, payload map[string]string) (*entity.Job, error) {
+	job := &entity.Job{
+		Uid:         utils.GenUid(),
+		Type:        jobType,
+		RefUid:      refUid,
+		Payload:     payload,
+		MaxAttempts: s.maxAttempts,
+	}
+	if err := s.jobRepo.Create(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// EnqueueUnique stores a pending job unless one with dedupeKey already exists (any status).
+// Returns false for a duplicate.
+func (s *JobQueueService) EnqueueUnique(jobType, refUid, dedupeKey string, payload map[string]string) (bool, error) {
+	return s.jobRepo.CreateUnique(&entity.Job{
+		Uid:         utils.GenUid(),
+		Type:        jobType,
+		RefUid:      refUid,
+		DedupeKey:   dedupeKey,
+		Payload:     payload,
+		MaxAttempts: s.maxAttempts,
+	})
+}
+
+// CompleteStep records a finished step on the job (in DB and in memory) so retries skip it.
+// Returns dao.ErrJobLeaseLost when another worker has taken the job over.
+func (s *JobQueueService) CompleteStep(job *entity.Job, step string, payload map[string]string, unsetKeys ...string) error {
+	if err := s.jobRepo.CompleteStep(job.Uid, job.LeaseOwner, step, payload, unsetKeys...); err != nil {
+		return err
+	}
+	if !job.HasStep(step) {
+		job.Steps = append(job.Steps, step)
+	}
+	if job.Payload == nil {
+		job.Payload = map[string]string{}
+	}
+	for k, v := range payload {
+		job.Payload[k] = v
+	}
+	for _, k := range unsetKeys {
+		delete(job.Payload, k)
+	}
+	return nil
+}
+
+// Start launches the worker pool. Jobs left running by a dead worker are leased again once their
+// lease expires (see JobRepository.Lease). Safe to call more than once.
+func (s *JobQueueService) Start(ctx context.Context) {
+	s.startOnce.Do(func() {
+		for i := 0; i < s.workers; i++ {
+			go s.worker(ctx, fmt.Sprintf("%s-%d", s.owner, i))
+		}
+		log.Printf("[jobs] started %d workers (max attempts %d)", s.workers, s.maxAttempts)
+	})
+}
+
+func (s *JobQueueService) jobTypes() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	types := make([]string, 0, len(s.handlers))
+	for t := range s.handlers {
+		types = append(types, t)
+	}
+	return types
+}
+
+// worker leases jobs as owner; each worker has its own owner so a job re-leased by a sibling
+// worker after lease expiry is fenced off from the previous attempt.
+func (s *JobQueueService) worker(ctx context.Context, owner string) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		job, err := s.jobRepo.Lease(owner, s.jobTypes(), jobLeaseFor)
+		if err != nil {
+			log.Printf("[jobs] lease failed: %v", err)
+		}
+		if job == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(jobPollInterval):
+			}
+			continue
+		}
+		s.execute(ctx, job)
+	}
+}
+
+func (s *JobQueueService) execute(ctx context.Context, job *entity.Job) {
+	s.mu.RLock()
+	h, ok := s.handlers[job.Type]
+	s.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	var leaseLost atomic.Bool
+	go s.keepLease(runCtx, cancel, job, &leaseLost)
+	err := runJobSafely(runCtx, h.run, job)
+	cancel()
+	if leaseLost.Load() {
+		// 다른 워커가 이어받았으므로 결과를 기록하지 않음
+		log.Printf("[jobs] %s %s: lease lost during attempt %d", job.Type, job.Uid, job.Attempts)
+		return
+	}
+	if err == nil {
+		if err := s.jobRepo.Finish(job.Uid, job.LeaseOwner, entity.JobStatusDone, "", h.clearKeys...); err != nil {
+			log.Printf("[jobs] %s %s: finish failed: %v", job.Type, job.Uid, err)
+		}
+		return
+	}
+
+	if job.Attempts >= job.MaxAttempts {
+		log.Printf("[jobs] %s %s: dead after %d attempts: %v", job.Type, job.Uid, job.Attempts, err)
+		if ferr := s.jobRepo.Finish(job.Uid, job.LeaseOwner, entity.JobStatusDead, err.Error()); ferr != nil {
+			// lease 를 잃었으면 이어받은 워커가 결과를 정하므로 dead 처리하지 않음
+			log.Printf("[jobs] %s %s: finish failed: %v", job.Type, job.Uid, ferr)
+			return
+		}
+		if h.onDead != nil {
+			h.onDead(job, err)
+		}
+		return
+	}
+
+	runAfter := time.Now().Add(JobBackoff(job.Attempts)).UnixMilli()
+	log.Printf("[jobs] %s %s: attempt %d failed, retry at %d: %v", job.Type, job.Uid, job.Attempts, runAfter, err)
+	if rerr := s.jobRepo.Reschedule(job.Uid, job.LeaseOwner, runAfter, err.Error()); rerr != nil {
+		log.Printf("[jobs] %s %s: reschedule failed: %v", job.Type, job.Uid, rerr)
+	}
+}
+
+// keepLease renews the lease every jobLeaseRenew while the attempt runs. If the lease was lost
+// (expired and taken by another worker) the attempt is cancelled and lost is set.
+func (s *JobQueueService) keepLease(ctx context.Context, cancel context.CancelFunc, job *entity.Job, lost *atomic.Bool) {
+	ticker := time.NewTicker(jobLeaseRenew)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ok, err := s.jobRepo.ExtendLease(job.Uid, job.LeaseOwner, jobLeaseFor)
+			if err != nil {
+				log.Printf("[jobs] %s %s: lease renew failed: %v", job.Type, job.Uid, err)
+				continue
+			}
+			if !ok {
+				lost.Store(true)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// runJobSafely turns a handler panic into an error so one bad job cannot kill a worker.
+func runJobSafely(ctx context.Context, run JobRunFunc, job *entity.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return run(ctx, job)
+}
+
+// JobBackoff returns the wait before retrying after the given (1-based) failed attempt:
+// 10s, 20s, 40s, ... capped at 10m.
+func JobBackoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := jobBackoffBase
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= jobBackoffMax {
+			return jobBackoffMax
+		}
+	}
+	return d
+}
+
+// GetJobs lists jobs (newest first) filtered by type/status/ref uid.
+func (s *JobQueueService) GetJobs(ctx context.Context, input model.JobSearchInput) (*model.SimpleResult, error) {
+	jobs, total, err := s.jobRepo.FindWithPagination(dao.JobFilter{
+		Type:   input.Type,
+		Status: input.Status,
+		RefUid: input.RefUID,
+		Limit:  input.Limit,
+		Offset: input.Offset,
+	})
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr(fmt.Sprintf("Failed to retrieve jobs: %v", err)),
+		}, nil
+	}
+
+	nodes := make([]model.Node, len(jobs))
+	for i := range jobs {
+		nodes[i] = converter.JobToModel(&jobs[i])
+	}
+
+	return &model.SimpleResult{
+		Ok:     true,
+		Nodes:  nodes,
+		Total:  utils.IntPtr(int(total)),
+		Limit:  utils.IntPtr(input.Limit),
+		Offset: utils.IntPtr(input.Offset),
+	}, nil
+}
+
+func (s *JobQueueService) GetJob(ctx context.Context, uid string) (*model.SimpleResult, error) {
+	job, err := s.jobRepo.FindByUID(uid)
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr(fmt.Sprintf("Job not found: %v", err)),
+		}, nil
+	}
+	return &model.SimpleResult{
+		Ok:   true,
+		Node: converter.JobToModel(job),
+	}, nil
+}
+
+// RetryJob puts a dead job back to pending (completed steps are kept).
+func (s *JobQueueService) RetryJob(ctx context.Context, uid string) (*model.SimpleResult, error) {
+	before, _ := s.jobRepo.FindByUID(uid)
+	ok, err := s.jobRepo.Requeue(uid)
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr(fmt.Sprintf("Failed to retry job: %v", err)),
+		}, nil
+	}
+	if !ok {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr("Job not found or not dead"),
+		}, nil
+	}
+	after, _ := s.jobRepo.FindByUID(uid)
+	s.audit.Record(ctx, "retryJob", AuditTargetJob, uid, before, after)
+	return &model.SimpleResult{
+		Ok:  true,
+		Msg: utils.StrPtr("Job requeued"),
+	}, nil
+}
+
+// PurgeJobPayload drops the payload (user image etc.) of a dead job that will not be retried.
+func (s *JobQueueService) PurgeJobPayload(ctx context.Context, uid string) (*model.SimpleResult, error) {
+	before, _ := s.jobRepo.FindByUID(uid)
+	ok, err := s.jobRepo.PurgePayload(uid)
+	if err != nil {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr(fmt.Sprintf("Failed to purge job payload: %v", err)),
+		}, nil
+	}
+	if !ok {
+		return &model.SimpleResult{
+			Ok:  false,
+			Err: utils.StrPtr("Job not found or not dead"),
+		}, nil
+	}
+	after, _ := s.jobRepo.FindByUID(uid)
+	s.audit.Record(ctx, "purgeJobPayload", AuditTargetJob, uid, before, after)
+	return &model.SimpleResult{
+		Ok:  true,
+		Msg: utils.StrPtr("Job payload purged"),
+	}, nil
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+)
+
+func TestJobBackoff(t *testing.T) {
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 10 * time.Second},
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{6, 320 * time.Second},
+		{7, 10 * time.Minute},
+		{50, 10 * time.Minute},
+	}
+	for _, c := range cases {
+		if got := JobBackoff(c.attempt); got != c.want {
+			t.Errorf("JobBackoff(%d) = %v, want %v", c.attempt, got, c.want)
+		}
+	}
+}
+
+func TestJobHasStep(t *testing.T) {
+	job := &entity.Job{Steps: []string{jobStepPhy}}
+	if !job.HasStep(jobStepPhy) {
+		t.Errorf("HasStep(%q) = false, want true", jobStepPhy)
+	}
+	if job.HasStep(jobStepPartnerImage) {
+		t.Errorf("HasStep(%q) = true, want false", jobStepPartnerImage)
+	}
+}
+
+// memJobStore is an in-memory jobStore (run_after backoff is not simulated).
+type memJobStore struct {
+	jobs map[string]*entity.Job
+}
+
+func newMemJobStore() *memJobStore { return &memJobStore{jobs: map[string]*entity.Job{}} }
+
+func (m *memJobStore) copyOf(job *entity.Job) *entity.Job {
+	c := *job
+	c.Steps = append([]string{}, job.Steps...)
+	c.Payload = map[string]string{}
+	for k, v := range job.Payload {
+		c.Payload[k] = v
+	}
+	return &c
+}
+
+func (m *memJobStore) Create(job *entity.Job) error {
+	if job.Status == "" {
+		job.Status = entity.JobStatusPending
+	}
+	m.jobs[job.Uid] = m.copyOf(job)
+	return nil
+}
+
+func (m *memJobStore) CreateUnique(job *entity.Job) (bool, error) {
+	for _, j := range m.jobs {
+		if j.DedupeKey == job.DedupeKey {
+			return false, nil
+		}
+	}
+	return true, m.Create(job)
+}
+
+func (m *memJobStore) FindByUID(uid string) (*entity.Job, error) {
+	job, ok := m.jobs[uid]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return m.copyOf(job), nil
+}
+
+func (m *memJobStore) FindWithPagination(f dao.JobFilter) ([]entity.Job, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *memJobStore) Lease(owner string, types []string, leaseFor time.Duration) (*entity.Job, error) {
+	for _, job := range m.jobs {
+		if job.Status == entity.JobStatusPending {
+			job.Status, job.LeaseOwner = entity.JobStatusRunning, owner
+			job.Attempts++
+			return m.copyOf(job), nil
+		}
+	}
+	return nil, nil
+}
+
+// leased returns the job while owner holds its lease (dao.JobRepository fences updates the same way).
+func (m *memJobStore) leased(uid, owner string) (*entity.Job, error) {
+	job, ok := m.jobs[uid]
+	if !ok || job.Status != entity.JobStatusRunning || job.LeaseOwner != owner {
+		return nil, dao.ErrJobLeaseLost
+	}
+	return job, nil
+}
+
+func (m *memJobStore) CompleteStep(uid, owner, step string, payload map[string]string, unsetKeys ...string) error {
+	job, err := m.leased(uid, owner)
+	if err != nil {
+		return err
+	}
+	job.Steps = append(job.Steps, step)
+	for k, v := range payload {
+		job.Payload[k] = v
+	}
+	for _, k := range unsetKeys {
+		delete(job.Payload, k)
+	}
+	return nil
+}
+
+func (m *memJobStore) Finish(uid, owner, status, lastError string, unsetKeys ...string) error {
+	job, err := m.leased(uid, owner)
+	if err != nil {
+		return err
+	}
+	job.Status, job.LastError, job.LeaseOwner = status, lastError, ""
+	for _, k := range unsetKeys {
+		delete(job.Payload, k)
+	}
+	return nil
+}
+
+func (m *memJobStore) Reschedule(uid, owner string, runAfter int64, lastError string) error {
+	job, err := m.leased(uid, owner)
+	if err != nil {
+		return err
+	}
+	job.Status, job.RunAfter, job.LastError, job.LeaseOwner = entity.JobStatusPending, runAfter, lastError, ""
+	return nil
+}
+
+func (m *memJobStore) Requeue(uid string) (bool, error) {
+	job, ok := m.jobs[uid]
+	if !ok || job.Status != entity.JobStatusDead {
+		return false, nil
+	}
+	job.Status, job.Attempts = entity.JobStatusPending, 0
+	return true, nil
+}
+
+func (m *memJobStore) PurgePayload(uid string) (bool, error) {
+	job, ok := m.jobs[uid]
+	if !ok || job.Status != entity.JobStatusDead {
+		return false, nil
+	}
+	job.Payload = map[string]string{}
+	return true, nil
+}
+
+func (m *memJobStore) ExtendLease(uid, owner string, leaseFor time.Duration) (bool, error) {
+	job, ok := m.jobs[uid]
+	return ok && job.Status == entity.JobStatusRunning && job.LeaseOwner == owner, nil
+}
+
+func newTestJobQueue(store jobStore, maxAttempts int) *JobQueueService {
+	return &JobQueueService{
+		jobRepo:     store,
+		audit:       &AdminAuditService{},
+		owner:       "test",
+		workers:     1,
+		maxAttempts: maxAttempts,
+		handlers:    map[string]jobHandler{},
+	}
+}
+
+// runNext leases and executes one job; false when nothing was runnable.
+func runNext(t *testing.T, q *JobQueueService) bool {
+	t.Helper()
+	job, err := q.jobRepo.Lease(q.owner, q.jobTypes(), jobLeaseFor)
+	if err != nil {
+		t.Fatalf("Lease() error = %v", err)
+	}
+	if job == nil {
+		return false
+	}
+	q.execute(context.Background(), job)
+	return true
+}
+
+func TestJobQueue_RetryDeadPhyJob(t *testing.T) {
+	store := newMemJobStore()
+	q := newTestJobQueue(store, 2)
+	fail := true
+	var seenImages []string
+	dead := 0
+	q.RegisterHandler(JobTypeSajuProfilePhy, func(ctx context.Context, job *entity.Job) error {
+		seenImages = append(seenImages, job.Payload[jobPayloadImageBase64])
+		if fail {
+			return errors.New("llm unavailable")
+		}
+		return nil
+	}, func(job *entity.Job, err error) { dead++ }, jobPayloadImageBase64)
+
+	job, err := q.Enqueue(JobTypeSajuProfilePhy, "p1", map[string]string{jobPayloadImageBase64: "aW1n"})
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	for runNext(t, q) {
+	}
+	got, _ := store.FindByUID(job.Uid)
+	if got.Status != entity.JobStatusDead || dead != 1 {
+		t.Fatalf("after %d attempts: status = %s, onDead calls = %d", got.Attempts, got.Status, dead)
+	}
+	if got.Payload[jobPayloadImageBase64] != "aW1n" {
+		t.Fatalf("dead job lost its image payload: %+v", got.Payload)
+	}
+
+	// 관리자 retryJob → 이미지로 다시 실행, 성공하면 이미지 삭제
+	fail = false
+	if res, _ := q.RetryJob(context.Background(), job.Uid); !res.Ok {
+		t.Fatalf("RetryJob() = %+v", res)
+	}
+	if !runNext(t, q) {
+		t.Fatal("requeued job was not leased")
+	}
+	got, _ = store.FindByUID(job.Uid)
+	if got.Status != entity.JobStatusDone || seenImages[len(seenImages)-1] != "aW1n" {
+		t.Fatalf("retry: status = %s, images seen = %v", got.Status, seenImages)
+	}
+	if _, ok := got.Payload[jobPayloadImageBase64]; ok {
+		t.Fatalf("done job still holds the image payload")
+	}
+}
+
+func TestJobQueue_PurgeJobPayload(t *testing.T) {
+	store := newMemJobStore()
+	q := newTestJobQueue(store, 1)
+	q.RegisterHandler(JobTypeSajuProfilePhy, func(ctx context.Context, job *entity.Job) error {
+		return errors.New("fail")
+	}, nil, jobPayloadImageBase64)
+
+	job, _ := q.Enqueue(JobTypeSajuProfilePhy, "p1", map[string]string{jobPayloadImageBase64: "aW1n"})
+	if res, _ := q.PurgeJobPayload(context.Background(), job.Uid); res.Ok {
+		t.Fatalf("purge of a pending job should fail: %+v", res)
+	}
+	runNext(t, q)
+	if res, _ := q.PurgeJobPayload(context.Background(), job.Uid); !res.Ok {
+		t.Fatalf("PurgeJobPayload() = %+v", res)
+	}
+	if got, _ := store.FindByUID(job.Uid); len(got.Payload) != 0 {
+		t.Fatalf("payload after purge = %+v", got.Payload)
+	}
+}
+
+func TestJobQueue_EnqueueUnique(t *testing.T) {
+	q := newTestJobQueue(newMemJobStore(), 1)
+	for i, c := range []struct {
+		uid, date string
+		want      bool
+	}{
+		{"p1", "2024-01-01", true},
+		{"p1", "2024-01-01", false}, // 같은 프로필·날짜 재등록 (재시작, 다른 인스턴스)
+		{"p2", "2024-01-01", true},
+		{"p1", "2024-01-02", true},
+	} {
+		got, err := q.EnqueueUnique(JobTypeSajuProfileDaily, c.uid, dailyJobDedupeKey(c.uid, c.date), map[string]string{jobPayloadDailyDate: c.date})
+		if err != nil || got != c.want {
+			t.Errorf("#%d EnqueueUnique(%s, %s) = %v, %v; want %v", i, c.uid, c.date, got, err, c.want)
+		}
+	}
+}
+
+func TestJobQueue_LostLeaseDoesNotFinish(t *testing.T) {
+	store := newMemJobStore()
+	q := newTestJobQueue(store, 1)
+	fail := false
+	dead := 0
+	q.RegisterHandler(JobTypeSajuProfilePhy, func(ctx context.Context, job *entity.Job) error {
+		// 실행 도중 lease 가 만료되어 다른 워커가 이어받음
+		store.jobs[job.Uid].LeaseOwner = "other"
+		if fail {
+			return errors.New("fail")
+		}
+		return nil
+	}, func(job *entity.Job, err error) { dead++ })
+
+	for _, f := range []bool{false, true} {
+		fail = f
+		job, _ := q.Enqueue(JobTypeSajuProfilePhy, "p1", nil)
+		runNext(t, q)
+		got, _ := store.FindByUID(job.Uid)
+		if got.Status != entity.JobStatusRunning || got.LeaseOwner != "other" {
+			t.Errorf("fail=%v: stale worker changed the job: status = %s, owner = %s", f, got.Status, got.LeaseOwner)
+		}
+		if err := q.CompleteStep(&entity.Job{Uid: job.Uid, LeaseOwner: q.owner}, jobStepPhy, nil); !errors.Is(err, dao.ErrJobLeaseLost) {
+			t.Errorf("fail=%v: CompleteStep() by stale owner = %v, want ErrJobLeaseLost", f, err)
+		}
+	}
+	if dead != 0 {
+		t.Errorf("onDead called %d times for a job owned by another worker", dead)
+	}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"sajudating_api/api/admgql/model"
+	ttycdom "sajudating_api/api/domain/ttyc"
+	"sajudating_api/api/types"
+	"sajudating_api/api/utils"
+)
+
+// AlmanacParams is the input of BuildAlmanac (GET /api/almanac, admgql almanac).
+type AlmanacParams struct {
+	From            string // 현지 날짜 yyyy-mm-dd (비어있으면 오늘)
+	To              string // 현지 날짜 (포함, 비어있으면 From)
+	TzOffsetMinutes *int   // 기본 KST(540)
+}
+
+// BuildAlmanac returns the 만세력 days for params (ttyc.CalculateAlmanac).
+func BuildAlmanac(params AlmanacParams, now time.Time) ([]ttycdom.TtycAlmanacDay, error) {
+	tzOffset := ttycdom.KST_OFFSET_MINUTES
+	if params.TzOffsetMinutes != nil {
+		tzOffset = *params.TzOffsetMinutes
+	}
+	today := now.In(time.FixedZone("", tzOffset*60))
+	from := ttycdom.TtycSolarDate{Year: today.Year(), Month: int(today.Month()), Day: today.Day()}
+	if strings.TrimSpace(params.From) != "" {
+		d, err := parseAlmanacDate(params.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from: %w", err)
+		}
+		from = d
+	}
+	to := from
+	if strings.TrimSpace(params.To) != "" {
+		d, err := parseAlmanacDate(params.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to: %w", err)
+		}
+		to = d
+	}
+	return ttycdom.CalculateAlmanac(ttycdom.TtycAlmanacInput{From: from, To: to, TzOffsetMinutes: &tzOffset})
+}
+
+// GET /api/almanac?from=yyyy-mm-dd&to=yyyy-mm-dd&tzOffsetMinutes=540
+// 만세력 조회 (파라미터 없으면 오늘 일진)
+func GetAlmanac(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	params := AlmanacParams{From: q.Get("from"), To: q.Get("to")}
+	if v := q.Get("tzOffsetMinutes"); v != "" {
+		tzOffset, err := strconv.Atoi(v)
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, "invalid tzOffsetMinutes")
+			return
+		}
+		params.TzOffsetMinutes = &tzOffset
+	}
+	days, err := BuildAlmanac(params, time.Now())
+	if err != nil {
+		if errors.Is(err, ttycdom.ErrOutOfRange) {
+			utils.RespondWithError(w, http.StatusBadRequest, sajuErrOutOfRange)
+			return
+		}
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(types.APIResponse[[]ttycdom.TtycAlmanacDay]{
+		Data: days,
+	})
+}
+
+// GetAlmanacGql serves the almanac query: Value 는 날짜별 만세력 배열 JSON.
+func (s *AdminToolService) GetAlmanacGql(_ context.Context, input model.AlmanacInput) (*model.SimpleResult, error) {
+	days, err := BuildAlmanac(AlmanacParams{
+		From:            input.From,
+		To:              utils.PtrToStr(input.To),
+		TzOffsetMinutes: input.TzOffsetMinutes,
+	}, time.Now())
+	if err != nil {
+		return sajuErrResult(err), nil
+	}
+	jsonBytes, err := json.Marshal(days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal almanac: %w", err)
+	}
+	total := len(days)
+	return &model.SimpleResult{Ok: true, Value: utils.StrPtr(string(jsonBytes)), Total: &total}, nil
+}
+
+func parseAlmanacDate(v string) (ttycdom.TtycSolarDate, error) {
+	for _, layout := range []string{"2006-01-02", "20060102"} {
+		if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+			return ttycdom.TtycSolarDate{Year: t.Year(), Month: int(t.Month()), Day: t.Day()}, nil
+		}
+	}
+	return ttycdom.TtycSolarDate{}, fmt.Errorf("unsupported format: %q", v)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"sajudating_api/api/admgql/model"
+	"sajudating_api/api/utils"
+)
+
+func TestBuildAlmanac_DefaultsToToday(t *testing.T) {
+	// 2024-01-01 20:00 UTC = 2024-01-02 05:00 KST
+	now := time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)
+	days, err := BuildAlmanac(AlmanacParams{}, now)
+	if err != nil {
+		t.Fatalf("BuildAlmanac() error = %v", err)
+	}
+	if len(days) != 1 || days[0].Date.Day != 2 || days[0].Day.GanjiHanja != "乙丑" {
+		t.Fatalf("today = %+v", days)
+	}
+
+	utc := 0
+	days, err = BuildAlmanac(AlmanacParams{TzOffsetMinutes: &utc}, now)
+	if err != nil || len(days) != 1 || days[0].Date.Day != 1 {
+		t.Fatalf("today(UTC) = %+v, %v", days, err)
+	}
+}
+
+func TestGetAlmanac_Handler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	GetAlmanac(rec, httptest.NewRequest(http.MethodGet, "/api/almanac?from=2024-02-01&to=2024-02-29", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Data []struct {
+			SolarTerm *struct {
+				NameKo string `json:"nameKo"`
+			} `json:"solarTerm"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || len(body.Data) != 29 {
+		t.Fatalf("body = %s, err = %v", rec.Body.String(), err)
+	}
+	if body.Data[3].SolarTerm == nil || body.Data[3].SolarTerm.NameKo != "입춘" {
+		t.Fatalf("2024-02-04 solarTerm = %+v", body.Data[3].SolarTerm)
+	}
+
+	rec = httptest.NewRecorder()
+	GetAlmanac(rec, httptest.NewRequest(http.MethodGet, "/api/almanac?from=2024-02-01&tzOffsetMinutes=x", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("invalid tz status = %d", rec.Code)
+	}
+}
+
+func TestGetAlmanacGql_OutOfRange(t *testing.T) {
+	res, err := (&AdminToolService{}).GetAlmanacGql(context.Background(), model.AlmanacInput{From: "0000-06-01"})
+	if err != nil {
+		t.Fatalf("GetAlmanacGql() error = %v", err)
+	}
+	if res.Ok || utils.PtrToStr(res.Err) != sajuErrOutOfRange {
+		t.Fatalf("GetAlmanacGql(0000) = %+v, want OUT_OF_RANGE", res)
+	}
+}
@@ -0,0 +1,312 @@
+// 명식 일괄 계산: 분석/백필용으로 수천 건의 출생 입력을 한 번에 받아 입력 순서대로 결과를 돌려준다.
+// 건별 실패는 해당 항목의 Err/Msg 로만 남기고 나머지는 계속 계산한다.
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"sajudating_api/api/admgql/model"
+	"sajudating_api/api/domain"
+	ttycdom "sajudating_api/api/domain/ttyc"
+	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/types"
+	"sajudating_api/api/utils"
+)
+
+const (
+	SajuBatchMaxItems    = 5000
+	SajuBatchMaxDocItems = 500 // doc 모드는 건당 SajuDoc 이 커서 (응답 전체를 메모리에 모음) 상한을 낮춘다
+	SajuBatchMaxWorkers  = 32
+	sajuBatchMaxBody     = 32 << 20 // 요청 본문 최대 32MB
+)
+
+type SajuBatchMode string
+
+const (
+	SajuBatchModePillars SajuBatchMode = "pillars" // 간지만 (ttyc 직접 계산)
+	SajuBatchModeDoc     SajuBatchMode = "doc"     // 전체 SajuDoc
+)
+
+type SajuBatchOptions struct {
+	Mode    SajuBatchMode // 기본 pillars
+	Workers int           // 기본 GOMAXPROCS, 최대 SajuBatchMaxWorkers
+}
+
+// SajuBatchPillars: compact 명식 (간지 한자, 시주 미상이면 Hour 비움)
+type SajuBatchPillars struct {
+	Year            string `json:"year"`
+	Month           string `json:"month"`
+	Day             string `json:"day"`
+	Hour            string `json:"hour,omitempty"`
+	Ts              int64  `json:"ts"`
+	TzOffsetMinutes int    `json:"tzOffsetMinutes"`
+}
+
+// SajuBatchResult: 입력과 같은 순서(Index)의 건별 결과. 실패시 Ok=false 와 Msg (지원 범위 밖이면 Err "OUT_OF_RANGE").
+type SajuBatchResult struct {
+	Index   int               `json:"index"`
+	Ok      bool              `json:"ok"`
+	Pillars *SajuBatchPillars `json:"pillars,omitempty"`
+	Doc     *domain.SajuDoc   `json:"doc,omitempty"`
+	Err     string            `json:"err,omitempty"`
+	Msg     string            `json:"msg,omitempty"`
+}
+
+// ExtractSajuBatch computes every input in a bounded worker pool and returns results in input order.
+// 배치 자체가 잘못된 경우(건수 초과, 알 수 없는 mode)만 error 를 돌려준다. ctx 취소시 남은 항목은 실패로 채운다.
+func (s *ExtractSajuPairService) ExtractSajuBatch(ctx context.Context, inputs []model.ExtractSajuInput, opts SajuBatchOptions) ([]SajuBatchResult, error) {
+	if len(inputs) > SajuBatchMaxItems {
+		return nil, fmt.Errorf("batch exceeds %d items: %d", SajuBatchMaxItems, len(inputs))
+	}
+	mode := opts.Mode
+	if mode == "" {
+		mode = SajuBatchModePillars
+	}
+	if mode != SajuBatchModePillars && mode != SajuBatchModeDoc {
+		return nil, fmt.Errorf("invalid batch mode: %q", mode)
+	}
+	if mode == SajuBatchModeDoc && len(inputs) > SajuBatchMaxDocItems {
+		return nil, fmt.Errorf("doc batch exceeds %d items: %d", SajuBatchMaxDocItems, len(inputs))
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, SajuBatchMaxWorkers, max(len(inputs), 1))
+
+	out := make([]SajuBatchResult, len(inputs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				out[i] = s.extractSajuBatchItem(i, inputs[i], mode)
+			}
+		}()
+	}
+	next := 0
+feed:
+	for ; next < len(inputs); next++ {
+		select {
+		case jobs <- next:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	for i := next; i < len(inputs); i++ {
+		out[i] = sajuBatchErrResult(i, ctx.Err())
+	}
+	return out, nil
+}
+
+func (s *ExtractSajuPairService) extractSajuBatchItem(index int, input model.ExtractSajuInput, mode SajuBatchMode) SajuBatchResult {
+	if mode == SajuBatchModeDoc {
+		doc, _, err := s.calculateSajuDoc(input, "extract_saju_batch")
+		if err != nil {
+			return sajuBatchErrResult(index, err)
+		}
+		return SajuBatchResult{Index: index, Ok: true, Doc: doc}
+	}
+	pillars, err := calculateSajuBatchPillars(input)
+	if err != nil {
+		return sajuBatchErrResult(index, err)
+	}
+	return SajuBatchResult{Index: index, Ok: true, Pillars: pillars}
+}
+
+func sajuBatchErrResult(index int, err error) SajuBatchResult {
+	res := SajuBatchResult{Index: index, Msg: err.Error()}
+	if errors.Is(err, extdao.ErrDateOutOfRange) {
+		res.Err = sajuErrOutOfRange
+	}
+	return res
+}
+
+// calculateSajuBatchPillars 는 calculateSajuDoc 과 같은 입력 해석(음력, 시 정밀도, 자시 유파, 진태양시)으로
+// ttyc.TtycCalculator 에서 간지만 계산한다.
+func calculateSajuBatchPillars(input model.ExtractSajuInput) (*SajuBatchPillars, error) {
+	calendar, err := extdao.NormalizeCalendar(utils.PtrToStr(input.Calendar))
+	if err != nil {
+		return nil, err
+	}
+	parts, _, err := resolveSolarBirthParts(input, calendar)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dtLocal: %w", err)
+	}
+	if err := ttycdom.CheckYearRange(parts.Year); err != nil {
+		return nil, err
+	}
+	timePrec := toDomainTimePrecision(input.TimePrec, parts.HasTime)
+	tz := input.Tz
+	if tz == "" {
+		tz = "Asia/Seoul"
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %s", tz)
+	}
+	birthInput := toDomainBirthInput(input, timePrec)
+	solarMode, err := domain.SolarTimeModeOf(birthInput)
+	if err != nil {
+		return nil, err
+	}
+	zishi, err := domain.ZishiConventionOf(birthInput.Engine)
+	if err != nil {
+		return nil, err
+	}
+	var longitude *float64
+	if input.Loc != nil {
+		longitude = &input.Loc.Lon
+	}
+
+	// 시주 미상은 정오 기준으로 연·월·일주만 계산 (sxtwl 경로와 동일)
+	hh, mm := toHourMinute(parts, timePrec)
+	precision := ttycdom.TtycTimePrecisionUnknown
+	hour, minute := 12, 0
+	if hh != nil {
+		precision = ttycdom.TtycTimePrecisionMinute
+		hour, minute = *hh, *mm
+	}
+	_, offsetSec := time.Date(parts.Year, time.Month(parts.Month), parts.Day, hour, minute, 0, 0, loc).Zone()
+	tzOffset := offsetSec / 60
+	ts, err := ttycdom.ToUnixTimestamp(ttycdom.TtycTimestampParts{
+		Year: parts.Year, Month: parts.Month, Day: parts.Day, Hour: hour, Minute: minute,
+	}, &tzOffset)
+	if err != nil {
+		return nil, err
+	}
+	calc, err := ttycdom.NewTtycCalculator(&ttycdom.TtycCalculatorOptions{
+		TzOffsetMinutes: &tzOffset,
+		TimePrecision:   precision,
+		ZishiConvention: zishi,
+		SolarTimeMode:   solarMode,
+		Longitude:       longitude,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, err := calc.CalculatePillars(ts)
+	if err != nil {
+		return nil, err
+	}
+	out := &SajuBatchPillars{
+		Year:            res.Pillars.Year.GanjiHanja,
+		Month:           res.Pillars.Month.GanjiHanja,
+		Day:             res.Pillars.Day.GanjiHanja,
+		Ts:              res.Ts,
+		TzOffsetMinutes: res.TzOffsetMinutes,
+	}
+	if res.Pillars.Hour != nil {
+		out.Hour = res.Pillars.Hour.GanjiHanja
+	}
+	return out, nil
+}
+
+// POST /api/adm/saju_batch?mode=pillars|doc&workers=N
+// 본문: ExtractSajuInput 의 JSON 배열, 또는 Content-Type application/x-ndjson 이면 한 줄에 하나.
+// 응답: JSON 이면 APIResponse{data: [...]}, NDJSON 요청이면 결과도 NDJSON (입력 순서 그대로).
+// 건수 상한: pillars SajuBatchMaxItems, doc SajuBatchMaxDocItems. 권한은 RouteAdm 의 RequireAdminPerm(llm:generate).
+func RunSajuBatch(w http.ResponseWriter, r *http.Request) {
+	opts := SajuBatchOptions{Mode: SajuBatchMode(r.URL.Query().Get("mode"))}
+	if v := r.URL.Query().Get("workers"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, "invalid workers")
+			return
+		}
+		opts.Workers = n
+	}
+	ndjson := isNDJSONContentType(r.Header.Get("Content-Type"))
+	body := http.MaxBytesReader(w, r.Body, sajuBatchMaxBody)
+
+	var (
+		inputs    []model.ExtractSajuInput
+		lineErrs  map[int]error
+		decodeErr error
+	)
+	if ndjson {
+		inputs, lineErrs, decodeErr = decodeSajuBatchNDJSON(body)
+	} else {
+		decodeErr = json.NewDecoder(body).Decode(&inputs)
+	}
+	if decodeErr != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", decodeErr))
+		return
+	}
+
+	results, err := NewExtractSajuPairService().ExtractSajuBatch(r.Context(), inputs, opts)
+	if err != nil {
+		utils.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	for i, lineErr := range lineErrs {
+		results[i] = sajuBatchErrResult(i, lineErr)
+	}
+
+	if ndjson {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		enc := json.NewEncoder(w)
+		for i := range results {
+			if err := enc.Encode(results[i]); err != nil {
+				return
+			}
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(types.APIResponse[[]SajuBatchResult]{
+		Data: results,
+	})
+}
+
+func isNDJSONContentType(v string) bool {
+	mediaType, _, err := mime.ParseMediaType(v)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/x-ndjson" || mediaType == "application/ndjson" || mediaType == "application/jsonl"
+}
+
+// decodeSajuBatchNDJSON reads one input per non-empty line; 해석할 수 없는 줄은 lineErrs 에 남기고 자리는 유지한다.
+func decodeSajuBatchNDJSON(r io.Reader) ([]model.ExtractSajuInput, map[int]error, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	var inputs []model.ExtractSajuInput
+	lineErrs := map[int]error{}
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var input model.ExtractSajuInput
+		if err := json.Unmarshal([]byte(line), &input); err != nil {
+			lineErrs[len(inputs)] = fmt.Errorf("invalid line: %w", err)
+		}
+		inputs = append(inputs, input)
+		if len(inputs) > SajuBatchMaxItems {
+			return nil, nil, fmt.Errorf("batch exceeds %d items", SajuBatchMaxItems)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return inputs, lineErrs, nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"sajudating_api/api/admgql/model"
+	"sajudating_api/api/types"
+)
+
+func newSajuBatchTestService() *ExtractSajuPairService {
+	return newExtractSajuPairServiceWithDeps(nil, func() time.Time { return time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC) })
+}
+
+func TestExtractSajuBatch_PillarsInOrder(t *testing.T) {
+	svc := newSajuBatchTestService()
+	inputs := []model.ExtractSajuInput{
+		{DtLocal: "2024-01-01 12:00", Tz: "Asia/Seoul"},
+		{DtLocal: "2024-01-01", Tz: "Asia/Seoul"},
+		{DtLocal: "not-a-date", Tz: "Asia/Seoul"},
+		{DtLocal: "0000-06-01 10:00", Tz: "Asia/Seoul"},
+	}
+	res, err := svc.ExtractSajuBatch(context.Background(), inputs, SajuBatchOptions{Workers: 2})
+	if err != nil {
+		t.Fatalf("ExtractSajuBatch() error = %v", err)
+	}
+	if len(res) != len(inputs) {
+		t.Fatalf("len = %d, want %d", len(res), len(inputs))
+	}
+	for i, r := range res {
+		if r.Index != i {
+			t.Fatalf("res[%d].Index = %d", i, r.Index)
+		}
+	}
+	got := res[0].Pillars
+	if !res[0].Ok || got == nil || got.Year != "癸卯" || got.Month != "甲子" || got.Day != "甲子" || got.Hour != "庚午" || got.TzOffsetMinutes != 540 {
+		t.Fatalf("res[0] = %+v / %+v, want 癸卯 甲子 甲子 庚午", res[0], got)
+	}
+	if !res[1].Ok || res[1].Pillars.Day != "甲子" || res[1].Pillars.Hour != "" {
+		t.Fatalf("res[1] = %+v, want 일주 甲子 without hour", res[1].Pillars)
+	}
+	if res[2].Ok || res[2].Msg == "" || res[2].Err != "" {
+		t.Fatalf("res[2] = %+v, want per-item parse error", res[2])
+	}
+	if res[3].Ok || res[3].Err != sajuErrOutOfRange {
+		t.Fatalf("res[3] = %+v, want OUT_OF_RANGE", res[3])
+	}
+}
+
+func TestExtractSajuBatch_PillarsMatchDoc(t *testing.T) {
+	svc := newSajuBatchTestService()
+	lunar := "LUNAR"
+	var inputs []model.ExtractSajuInput
+	for i := 0; i < 200; i++ {
+		dt := time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(i) * 97 * 37 * time.Hour)
+		inputs = append(inputs, model.ExtractSajuInput{
+			DtLocal: dt.Format("2006-01-02 15:04"),
+			Tz:      "Asia/Seoul",
+			Engine:  &model.ExtractEngineInput{Name: "sxtwl", Ver: "1"},
+		})
+	}
+	inputs = append(inputs, model.ExtractSajuInput{DtLocal: "1990-04-20", Tz: "Asia/Seoul", Calendar: &lunar, Engine: &model.ExtractEngineInput{Name: "sxtwl", Ver: "1"}})
+
+	pillars, err := svc.ExtractSajuBatch(context.Background(), inputs, SajuBatchOptions{Mode: SajuBatchModePillars})
+	if err != nil {
+		t.Fatalf("pillars batch error = %v", err)
+	}
+	docs, err := svc.ExtractSajuBatch(context.Background(), inputs, SajuBatchOptions{Mode: SajuBatchModeDoc, Workers: 4})
+	if err != nil {
+		t.Fatalf("doc batch error = %v", err)
+	}
+	for i := range inputs {
+		p, d := pillars[i], docs[i]
+		if !p.Ok || !d.Ok || d.Doc == nil {
+			t.Fatalf("item %d: pillars=%+v doc ok=%v msg=%s", i, p, d.Ok, d.Msg)
+		}
+		var docGanji []string
+		for _, pl := range d.Doc.Pillars {
+			docGanji = append(docGanji, sajuBatchHanja(int(pl.Stem), int(pl.Branch)))
+		}
+		want := strings.Join(docGanji, " ")
+		got := strings.TrimSpace(strings.Join([]string{p.Pillars.Year, p.Pillars.Month, p.Pillars.Day, p.Pillars.Hour}, " "))
+		if got != want {
+			t.Fatalf("item %d (%s): pillars %q != doc %q", i, inputs[i].DtLocal, got, want)
+		}
+	}
+}
+
+func sajuBatchHanja(stem, branch int) string {
+	stems := []rune("甲乙丙丁戊己庚辛壬癸")
+	branches := []rune("子丑寅卯辰巳午未申酉戌亥")
+	return string(stems[stem]) + string(branches[branch])
+}
+
+func TestExtractSajuBatch_Limits(t *testing.T) {
+	svc := newSajuBatchTestService()
+	if _, err := svc.ExtractSajuBatch(context.Background(), make([]model.ExtractSajuInput, SajuBatchMaxItems+1), SajuBatchOptions{}); err == nil {
+		t.Fatalf("expected error over %d items", SajuBatchMaxItems)
+	}
+	if _, err := svc.ExtractSajuBatch(context.Background(), make([]model.ExtractSajuInput, SajuBatchMaxDocItems+1), SajuBatchOptions{Mode: SajuBatchModeDoc}); err == nil {
+		t.Fatalf("expected error over %d doc items", SajuBatchMaxDocItems)
+	}
+	if _, err := svc.ExtractSajuBatch(context.Background(), nil, SajuBatchOptions{Mode: "full"}); err == nil {
+		t.Fatalf("expected invalid mode error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err := svc.ExtractSajuBatch(ctx, make([]model.ExtractSajuInput, 3), SajuBatchOptions{})
+	if err != nil || len(res) != 3 {
+		t.Fatalf("canceled batch = %v, %v", res, err)
+	}
+	for i, r := range res {
+		if r.Index != i || r.Ok {
+			t.Fatalf("canceled res[%d] = %+v", i, r)
+		}
+	}
+}
+
+func TestRunSajuBatch_JSONAndNDJSON(t *testing.T) {
+	body := `[{"dtLocal":"2024-01-01 12:00","tz":"Asia/Seoul"},{"dtLocal":"bad","tz":"Asia/Seoul"}]`
+	req := httptest.NewRequest(http.MethodPost, "/api/adm/saju_batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	RunSajuBatch(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var resp types.APIResponse[[]SajuBatchResult]
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(resp.Data) != 2 || !resp.Data[0].Ok || resp.Data[0].Pillars.Day != "甲子" || resp.Data[1].Ok {
+		t.Fatalf("data = %+v", resp.Data)
+	}
+
+	lines := []string{
+		`{"dtLocal":"2024-01-01 12:00","tz":"Asia/Seoul"}`,
+		``,
+		`{not json`,
+		`{"dtLocal":"2024-01-02","tz":"Asia/Seoul"}`,
+	}
+	req = httptest.NewRequest(http.MethodPost, "/api/adm/saju_batch?mode=pillars&workers=2", strings.NewReader(strings.Join(lines, "\n")))
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	rec = httptest.NewRecorder()
+	RunSajuBatch(rec, req)
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/x-ndjson" {
+		t.Fatalf("status = %d, content-type = %s", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	var got []SajuBatchResult
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		var r SajuBatchResult
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("decode line: %v", err)
+		}
+		got = append(got, r)
+	}
+	if len(got) != 3 {
+		t.Fatalf("lines = %d, want 3", len(got))
+	}
+	if !got[0].Ok || got[1].Ok || !strings.Contains(got[1].Msg, "invalid line") || !got[2].Ok || got[2].Pillars.Day != "乙丑" {
+		t.Fatalf("ndjson results = %s", fmt.Sprint(got))
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/adm/saju_batch?mode=full", strings.NewReader("[]"))
+	rec = httptest.NewRecorder()
+	RunSajuBatch(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("invalid mode status = %d", rec.Code)
+	}
+}
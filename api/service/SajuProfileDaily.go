@@ -0,0 +1,311 @@
+// SajuProfile 일운(日運) 피드: 프로필별로 오늘부터 N일의 일진·십성·십이운성과 daily 카드를 미리 계산해 저장한다.
+// 스케줄러가 KST 날짜가 바뀔 때마다 전 프로필의 갱신 job 을 (프로필, 날짜)당 하나씩 등록하고, 조회시 없는 날짜는 즉시 계산한다.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"sajudating_api/api/config"
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+	ttycdom "sajudating_api/api/domain/ttyc"
+	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/service/itemncard"
+	"sajudating_api/api/types"
+	"sajudating_api/api/utils"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	sajuDailyDateLayout    = "2006-01-02"
+	sajuDailyKeepDays      = 7         // 지난 일운 보관 일수
+	sajuDailyScheduleEvery = time.Hour // 스케줄러 점검 주기 (KST 날짜가 바뀌면 갱신 job 등록)
+)
+
+var sajuDailyZone = time.FixedZone("KST", ttycdom.KST_OFFSET_MINUTES*60)
+
+// ! SajuDailyResult
+type SajuDailyResult struct {
+	Date         string          `json:"date"`
+	GanjiKo      string          `json:"ganjiKo"`
+	GanjiHanja   string          `json:"ganjiHanja"`
+	StemTenGod   string          `json:"stemTenGod"`
+	BranchTenGod string          `json:"branchTenGod"`
+	BranchTwelve string          `json:"branchTwelve"`
+	Tokens       []string        `json:"tokens"`
+	Cards        []SajuDailyCard `json:"cards"`
+}
+
+type SajuDailyCard struct {
+	CardID  string          `json:"cardId"`
+	Title   string          `json:"title"`
+	Score   int             `json:"score"`
+	Content json.RawMessage `json:"content,omitempty"`
+}
+
+func sajuDailyFeedDays() int {
+	if config.AppConfig != nil && config.AppConfig.Jobs.DailyDays > 0 {
+		return config.AppConfig.Jobs.DailyDays
+	}
+	return 7
+}
+
+func sajuDailyToday(now time.Time) time.Time {
+	t := now.In(sajuDailyZone)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// BuildSajuDailies computes days [from, from+days) of 일운 for a (KST) birthdate in calendar (SOLAR|LUNAR,
+// leapMonth for 윤달) against cards (no DB).
+func BuildSajuDailies(profileUid, birthdate, calendar string, leapMonth bool, from time.Time, days int, cards []entity.ItemNCard) ([]entity.SajuProfileDaily, error) {
+	natal, err := extdao.GenPaljaCalendar(birthdate, "", calendar, leapMonth)
+	if err != nil {
+		return nil, err
+	}
+	pillars := itemncard.PillarsTextOf(natal)
+	to := from.AddDate(0, 0, days-1)
+	almanac, err := ttycdom.CalculateAlmanac(ttycdom.TtycAlmanacInput{
+		From: ttycdom.TtycSolarDate{Year: from.Year(), Month: int(from.Month()), Day: from.Day()},
+		To:   ttycdom.TtycSolarDate{Year: to.Year(), Month: int(to.Month()), Day: to.Day()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]entity.SajuProfileDaily, 0, len(almanac))
+	for _, day := range almanac {
+		meta, err := ttycdom.GanjiMetaOf(day.Day.Stem, day.Day.Branch, natal.Pillars.Day.Tg)
+		if err != nil {
+			return nil, err
+		}
+		tokens := itemncard.ItemsToTokens(itemncard.DailyItems(pillars, meta))
+		tokenSet := make(map[string]bool, len(tokens))
+		for _, t := range tokens {
+			tokenSet[t] = true
+		}
+		selected, evidences, scores := itemncard.SelectSajuCardsFromCards(cards, tokenSet, itemncard.DefaultMaxPerDomain, 0)
+		dailyCards := make([]entity.SajuProfileDailyCard, 0, len(selected))
+		for i := range selected {
+			dailyCards = append(dailyCards, entity.SajuProfileDailyCard{
+				CardID:      selected[i].CardID,
+				Title:       selected[i].Title,
+				Score:       scores[i],
+				Evidence:    evidences[i],
+				ContentJSON: selected[i].ContentJSON,
+			})
+		}
+		out = append(out, entity.SajuProfileDaily{
+			Uid:          utils.GenUid(),
+			ProfileUid:   profileUid,
+			Date:         fmt.Sprintf("%04d-%02d-%02d", day.Date.Year, day.Date.Month, day.Date.Day),
+			GanjiKo:      meta.GanjiKo,
+			GanjiHanja:   meta.GanjiHanja,
+			StemTenGod:   string(meta.StemTenGod),
+			BranchTenGod: string(meta.BranchTenGod),
+			BranchTwelve: string(meta.BranchTwelve),
+			Tokens:       tokens,
+			Cards:        dailyCards,
+		})
+	}
+	return out, nil
+}
+
+// runDailyJob materializes today..today+N-1 for the profile and drops days older than sajuDailyKeepDays.
+func (s *SajuProfileService) runDailyJob(ctx context.Context, job *entity.Job) error {
+	uid := job.RefUid
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if err != nil {
+		return fmt.Errorf("saju profile not found: %w", err)
+	}
+	cards, err := itemncard.LoadDailyCards()
+	if err != nil {
+		return err
+	}
+	today := sajuDailyToday(time.Now())
+	if d, err := time.Parse(sajuDailyDateLayout, job.Payload[jobPayloadDailyDate]); err == nil {
+		today = d // 스케줄러가 등록한 날짜 기준
+	}
+	birthdate, calendar, leapMonth := profileBirthInput(profile)
+	dailies, err := BuildSajuDailies(uid, birthdate, calendar, leapMonth, today, sajuDailyFeedDays(), cards)
+	if err != nil {
+		return err
+	}
+	repo := dao.NewSajuProfileDailyRepository()
+	for i := range dailies {
+		if err := repo.Upsert(&dailies[i]); err != nil {
+			return err
+		}
+	}
+	return repo.DeleteBefore(uid, today.AddDate(0, 0, -sajuDailyKeepDays).Format(sajuDailyDateLayout))
+}
+
+// StartDailyScheduler enqueues the daily feed jobs at start and whenever the KST date changes.
+// The last scheduled date is kept in job_schedules so restarts and other instances do not repeat it.
+func (s *SajuProfileService) StartDailyScheduler(ctx context.Context) {
+	go func() {
+		schedules := dao.NewJobScheduleRepository()
+		ticker := time.NewTicker(sajuDailyScheduleEvery)
+		defer ticker.Stop()
+		for {
+			s.scheduleDailyJobs(schedules, sajuDailyToday(time.Now()).Format(sajuDailyDateLayout))
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *SajuProfileService) scheduleDailyJobs(schedules *dao.JobScheduleRepository, date string) {
+	last, err := schedules.LastRunKey(JobTypeSajuProfileDaily)
+	if err != nil {
+		log.Printf("[daily] read schedule failed: %v", err)
+		return
+	}
+	if last == date {
+		return
+	}
+	n, err := s.enqueueDailyJobs(date)
+	if err != nil {
+		// 표시를 남기지 않아 다음 주기에 재시도 (이미 등록된 job 은 중복 제거)
+		log.Printf("[daily] enqueue for %s incomplete (%d new jobs): %v", date, n, err)
+		return
+	}
+	if err := schedules.SetLastRunKey(JobTypeSajuProfileDaily, date); err != nil {
+		log.Printf("[daily] save schedule failed: %v", err)
+	}
+	log.Printf("[daily] enqueued %d daily feed jobs for %s", n, date)
+}
+
+func dailyJobDedupeKey(profileUid, date string) string {
+	return JobTypeSajuProfileDaily + ":" + profileUid + ":" + date
+}
+
+// enqueueDailyJobs enqueues one feed job per profile for date and returns how many were new.
+// Profiles that already have the date's job are skipped, so a retry after a partial failure only
+// adds the missing ones.
+func (s *SajuProfileService) enqueueDailyJobs(date string) (int, error) {
+	uids, err := s.sajuProfileRepo.FindAllUids()
+	if err != nil {
+		return 0, err
+	}
+	created, failed := 0, 0
+	var firstErr error
+	for _, uid := range uids {
+		ok, err := s.jobQueue.EnqueueUnique(JobTypeSajuProfileDaily, uid, dailyJobDedupeKey(uid, date), map[string]string{
+			jobPayloadDailyDate: date,
+		})
+		if err != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if ok {
+			created++
+		}
+	}
+	if failed > 0 {
+		return created, fmt.Errorf("%d of %d profiles failed: %w", failed, len(uids), firstErr)
+	}
+	return created, nil
+}
+
+// GET /api/saju_profile/:uid/daily?date=yyyy-mm-dd
+// 일운 조회 (date 없으면 오늘, KST). 미리 계산된 날이 없으면 즉시 계산한다.
+func (s *SajuProfileService) GetSajuProfileDaily(w http.ResponseWriter, r *http.Request) {
+	uid := chi.URLParam(r, "uid")
+	today := sajuDailyToday(time.Now())
+	date := today
+	if v := r.URL.Query().Get("date"); v != "" {
+		d, err := time.Parse(sajuDailyDateLayout, v)
+		if err != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, "invalid date (yyyy-mm-dd)")
+			return
+		}
+		date = d
+	}
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		utils.RespondWithError(w, http.StatusNotFound, "Saju profile not found")
+		return
+	}
+	if err != nil {
+		s.log(uid, "error", fmt.Sprintf("[GetSajuProfileDaily] Failed to find saju profile: %v", err))
+		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to find saju profile")
+		return
+	}
+
+	repo := dao.NewSajuProfileDailyRepository()
+	daily, err := repo.FindByProfileAndDate(uid, date.Format(sajuDailyDateLayout))
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		s.log(uid, "error", fmt.Sprintf("[GetSajuProfileDaily] Failed to find daily: %v", err))
+		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to find daily fortune")
+		return
+	}
+	if daily == nil {
+		cards, cerr := itemncard.LoadDailyCards()
+		if cerr != nil {
+			s.log(uid, "error", fmt.Sprintf("[GetSajuProfileDaily] Failed to load daily cards: %v", cerr))
+		}
+		birthdate, calendar, leapMonth := profileBirthInput(profile)
+		dailies, berr := BuildSajuDailies(uid, birthdate, calendar, leapMonth, date, 1, cards)
+		if errors.Is(berr, ttycdom.ErrOutOfRange) {
+			utils.RespondWithError(w, http.StatusBadRequest, sajuErrOutOfRange)
+			return
+		}
+		if berr != nil {
+			utils.RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Failed to build daily fortune: %v", berr))
+			return
+		}
+		if len(dailies) == 0 {
+			s.log(uid, "error", fmt.Sprintf("[GetSajuProfileDaily] No daily built for %s", date.Format(sajuDailyDateLayout)))
+			utils.RespondWithError(w, http.StatusInternalServerError, "No daily fortune available for the date")
+			return
+		}
+		daily = &dailies[0]
+		// 피드 구간(오늘~N일) 안의 날짜만 저장
+		if cerr == nil && !date.Before(today) && date.Before(today.AddDate(0, 0, sajuDailyFeedDays())) {
+			if err := repo.Upsert(daily); err != nil {
+				s.log(uid, "error", fmt.Sprintf("[GetSajuProfileDaily] Failed to store daily: %v", err))
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(types.APIResponse[SajuDailyResult]{
+		Data: toSajuDailyResult(daily),
+	})
+}
+
+func toSajuDailyResult(daily *entity.SajuProfileDaily) SajuDailyResult {
+	cards := make([]SajuDailyCard, 0, len(daily.Cards))
+	for _, c := range daily.Cards {
+		card := SajuDailyCard{CardID: c.CardID, Title: c.Title, Score: c.Score}
+		if json.Valid([]byte(c.ContentJSON)) {
+			card.Content = json.RawMessage(c.ContentJSON)
+		}
+		cards = append(cards, card)
+	}
+	return SajuDailyResult{
+		Date:         daily.Date,
+		GanjiKo:      daily.GanjiKo,
+		GanjiHanja:   daily.GanjiHanja,
+		StemTenGod:   daily.StemTenGod,
+		BranchTenGod: daily.BranchTenGod,
+		BranchTwelve: daily.BranchTwelve,
+		Tokens:       daily.Tokens,
+		Cards:        cards,
+	}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"sajudating_api/api/dao/entity"
+	extdao "sajudating_api/api/ext_dao"
+)
+
+func TestBuildSajuDailies(t *testing.T) {
+	cards := []entity.ItemNCard{
+		{CardID: "일운_비견_v1", Scope: "daily", Title: "비견의 날", TriggerJSON: `{"all":[{"token":"일운십성:비견@천간"}]}`, ContentJSON: `{"summary":"s"}`},
+	}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dailies, err := BuildSajuDailies("p1", "199003051030", extdao.CalendarSolar, false, from, 10, cards)
+	if err != nil {
+		t.Fatalf("BuildSajuDailies() error = %v", err)
+	}
+	if len(dailies) != 10 || dailies[0].Date != "2024-01-01" || dailies[9].Date != "2024-01-10" {
+		t.Fatalf("dates = %s..%s (%d)", dailies[0].Date, dailies[len(dailies)-1].Date, len(dailies))
+	}
+	if dailies[0].GanjiHanja != "甲子" || dailies[0].ProfileUid != "p1" {
+		t.Fatalf("2024-01-01 = %+v", dailies[0])
+	}
+	// 10일 중 일간과 같은 천간의 날은 정확히 하루 → 비견 카드 1회
+	matched := 0
+	for _, d := range dailies {
+		if len(d.Tokens) == 0 || d.StemTenGod == "" || d.BranchTwelve == "" {
+			t.Fatalf("missing annotations: %+v", d)
+		}
+		if d.StemTenGod == "비견" {
+			if len(d.Cards) != 1 || d.Cards[0].CardID != "일운_비견_v1" {
+				t.Fatalf("비견 day cards = %+v", d.Cards)
+			}
+			matched++
+		} else if len(d.Cards) != 0 {
+			t.Fatalf("unexpected cards on %s: %+v", d.Date, d.Cards)
+		}
+	}
+	if matched != 1 {
+		t.Fatalf("비견 days = %d, want 1", matched)
+	}
+
+	res := toSajuDailyResult(&dailies[0])
+	if res.Date != "2024-01-01" || res.GanjiKo != "갑자" {
+		t.Fatalf("toSajuDailyResult = %+v", res)
+	}
+}
+
+func TestBuildSajuDailies_Lunar(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// 음력 프로필 (윤달 포함) 은 변환된 양력 생년월일과 같은 일운
+	for _, leap := range []bool{false, true} {
+		profile := &entity.SajuProfile{Calendar: extdao.CalendarLunar, LunarBirthdate: "202004151030", LeapMonth: leap}
+		solar, err := extdao.SolarBirthdate(profile.LunarBirthdate, profile.Calendar, leap)
+		if err != nil {
+			t.Fatalf("SolarBirthdate() error = %v", err)
+		}
+		profile.Birthdate = solar
+		birthdate, calendar, leapMonth := profileBirthInput(profile)
+		lunar, err := BuildSajuDailies("p1", birthdate, calendar, leapMonth, from, 10, nil)
+		if err != nil {
+			t.Fatalf("BuildSajuDailies(lunar, leap=%v) error = %v", leap, err)
+		}
+		want, err := BuildSajuDailies("p1", solar, extdao.CalendarSolar, false, from, 10, nil)
+		if err != nil {
+			t.Fatalf("BuildSajuDailies(solar) error = %v", err)
+		}
+		for i := range want {
+			if lunar[i].StemTenGod != want[i].StemTenGod || lunar[i].BranchTwelve != want[i].BranchTwelve {
+				t.Fatalf("leap=%v %s: lunar %s/%s, solar %s/%s", leap, want[i].Date,
+					lunar[i].StemTenGod, lunar[i].BranchTwelve, want[i].StemTenGod, want[i].BranchTwelve)
+			}
+		}
+	}
+}
+
+func TestSajuDailyToday(t *testing.T) {
+	// 2024-01-01 20:00 UTC = 2024-01-02 05:00 KST
+	got := sajuDailyToday(time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)).Format(sajuDailyDateLayout)
+	if got != "2024-01-02" {
+		t.Fatalf("sajuDailyToday = %s, want 2024-01-02", got)
+	}
+}
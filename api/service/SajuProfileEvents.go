@@ -0,0 +1,269 @@
+// SajuProfile 진행 이벤트 (SSE): GET /api/saju_profile/{uid}/events
+// 파이프라인(SajuProfileJobs.go)의 상태 전이/부분 결과 저장 시점에 발행, 접속시 현재 상태 스냅샷부터 전송.
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/types"
+	"sajudating_api/api/utils"
+	"sajudating_api/api/utils/dslog"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SSE event names (event: 필드)
+const (
+	SajuProfileEventPalja   = "palja"   // 팔자 (접속시)
+	SajuProfileEventStatus  = "status"  // 단계 상태 전이
+	SajuProfileEventSaju    = "saju"    // 사주 결과 (SajuResult)
+	SajuProfileEventPhy     = "phy"     // 관상 결과 (KwansangResult)
+	SajuProfileEventPartner = "partner" // 파트너 매칭 (PartnerMatchEvent)
+	SajuProfileEventEnd     = "end"     // 모든 단계 종료 (isSajuProgressFinal) - 스트림 종료
+)
+
+const (
+	sajuProfileEventBuffer    = 16
+	sajuProfileEventHeartbeat = 15 * time.Second
+)
+
+type SajuProfileEvent struct {
+	Type string
+	Data any
+}
+
+// status 이벤트 데이터
+type StatusEvent struct {
+	Step     string             `json:"step,omitempty"` // saju / phy / partner, 스냅샷이면 빈 값
+	Status   types.SajuStatus   `json:"status"`         // 전체 상태
+	Progress types.SajuProgress `json:"progress"`
+}
+
+// palja 이벤트 데이터
+type PaljaEvent struct {
+	Palja          string `json:"palja"`
+	PaljaHanja     string `json:"palja_hanja"`
+	PaljaMainShape string `json:"palja_main_shape"`
+}
+
+// partner 이벤트 데이터
+type PartnerMatchEvent struct {
+	PartnerUid string  `json:"partner_uid"`
+	Similarity float64 `json:"similarity"`
+}
+
+// in-process pub/sub. 다른 인스턴스의 워커가 처리하는 경우는 heartbeat 마다 DB 재조회로 보정.
+type sajuProfileEventHub struct {
+	mu     sync.Mutex
+	subs   map[string]map[chan SajuProfileEvent]struct{}
+	buffer int // 구독 채널 크기, 0 이면 sajuProfileEventBuffer
+}
+
+var sajuProfileEvents = &sajuProfileEventHub{subs: map[string]map[chan SajuProfileEvent]struct{}{}}
+
+func (h *sajuProfileEventHub) subscribe(uid string) (<-chan SajuProfileEvent, func()) {
+	buffer := h.buffer
+	if buffer <= 0 {
+		buffer = sajuProfileEventBuffer
+	}
+	ch := make(chan SajuProfileEvent, buffer)
+	h.mu.Lock()
+	if h.subs[uid] == nil {
+		h.subs[uid] = map[chan SajuProfileEvent]struct{}{}
+	}
+	h.subs[uid][ch] = struct{}{}
+	h.mu.Unlock()
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subs[uid], ch)
+		if len(h.subs[uid]) == 0 {
+			delete(h.subs, uid)
+		}
+		h.mu.Unlock()
+	}
+}
+
+// publish never blocks the pipeline: a subscriber whose buffer is full misses the event
+// (the periodic re-sync still delivers the latest status).
+func (h *sajuProfileEventHub) publish(uid string, ev SajuProfileEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[uid] {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+func (s *SajuProfileService) publishEvent(uid, eventType string, data any) {
+	sajuProfileEvents.publish(uid, SajuProfileEvent{Type: eventType, Data: data})
+}
+
+// 상태 전이 후 status 이벤트 발행 (프로필 재조회로 progress 포함)
+func (s *SajuProfileService) publishStatus(uid, step string) {
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if err != nil {
+		return
+	}
+	s.publishEvent(uid, SajuProfileEventStatus, newStatusEvent(profile, step))
+}
+
+func newStatusEvent(profile *entity.SajuProfile, step string) StatusEvent {
+	return StatusEvent{
+		Step:     step,
+		Status:   toSajuStatus(profile.Status),
+		Progress: toSajuProgress(profile),
+	}
+}
+
+func isSajuStatusFinal(status types.SajuStatus) bool {
+	return status == types.SajuStatusDone || status == types.SajuStatusError
+}
+
+// isSajuProgressFinal: 더 진행될 단계가 없음 - 사주·관상이 끝났고, 파트너는 끝났거나 관상 실패로 시작되지 않음.
+// 전체 상태는 한 단계만 실패해도 error 이므로 스트림 종료 판단에 쓰지 않는다.
+func isSajuProgressFinal(p types.SajuProgress) bool {
+	if !isSajuStatusFinal(p.Saju.Status) || !isSajuStatusFinal(p.Phy.Status) {
+		return false
+	}
+	return p.Phy.Status == types.SajuStatusError || isSajuStatusFinal(p.Partner.Status)
+}
+
+// text/event-stream 응답 작성기
+type sseWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	seq     int
+}
+
+func newSSEWriter(w http.ResponseWriter) (*sseWriter, bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+	return &sseWriter{w: w, flusher: flusher}, true
+}
+
+func (e *sseWriter) start() {
+	e.w.Header().Set("Content-Type", "text/event-stream")
+	e.w.Header().Set("Cache-Control", "no-cache")
+	e.w.Header().Set("Connection", "keep-alive")
+	e.w.Header().Set("X-Accel-Buffering", "no")
+	e.w.WriteHeader(http.StatusOK)
+	e.flusher.Flush()
+}
+
+// event writes one JSON event; false when the client is gone.
+func (e *sseWriter) event(eventType string, data any) bool {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return true
+	}
+	e.seq++
+	if _, err := fmt.Fprintf(e.w, "id: %d\nevent: %s\ndata: %s\n\n", e.seq, eventType, payload); err != nil {
+		return false
+	}
+	e.flusher.Flush()
+	return true
+}
+
+func (e *sseWriter) comment(text string) bool {
+	if _, err := fmt.Fprintf(e.w, ": %s\n\n", text); err != nil {
+		return false
+	}
+	e.flusher.Flush()
+	return true
+}
+
+// GET /api/saju_profile/:uid/events
+// 진행상태 SSE 스트림. 접속시 palja/status 및 이미 저장된 결과를 먼저 보내고, 이후 변경분 전송.
+// 모든 단계가 done / error 가 되면 (isSajuProgressFinal) end 이벤트 후 종료.
+func (s *SajuProfileService) GetSajuProfileEvents(w http.ResponseWriter, r *http.Request) {
+	uid := chi.URLParam(r, "uid")
+	dslog.Log("info", fmt.Sprintf("[GetSajuProfileEvents][1] Request started - UID: %s", uid))
+
+	sse, ok := newSSEWriter(w)
+	if !ok {
+		utils.RespondWithError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+	// 스냅샷 이전에 구독해야 그 사이 발행된 이벤트를 놓치지 않음
+	events, unsubscribe := sajuProfileEvents.subscribe(uid)
+	defer unsubscribe()
+
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if err != nil {
+		dslog.Log("error", fmt.Sprintf("[GetSajuProfileEvents][2] Saju profile not found: %v", err))
+		utils.RespondWithError(w, http.StatusNotFound, "Saju profile not found")
+		return
+	}
+	sse.start()
+	send := sse.event
+
+	// snapshot
+	send(SajuProfileEventPalja, PaljaEvent{
+		Palja:          profile.Palja,
+		PaljaHanja:     utils.ConvertPaljaToWithHanja(profile.Palja),
+		PaljaMainShape: utils.GetImageSentenceOfIlju(profile.Palja),
+	})
+	last := newStatusEvent(profile, "")
+	send(SajuProfileEventStatus, last)
+	if profile.SajuSummary != "" {
+		send(SajuProfileEventSaju, SajuResult{Summary: profile.SajuSummary, Content: profile.SajuContent, PartnerTips: profile.PartnerMatchTips})
+	}
+	if profile.PhySummary != "" {
+		send(SajuProfileEventPhy, KwansangResult{Summary: profile.PhySummary, Content: profile.PhyContent, Partner_summary: profile.PartnerSummary})
+	}
+	if profile.PhyPartnerUid != "" {
+		send(SajuProfileEventPartner, PartnerMatchEvent{PartnerUid: profile.PhyPartnerUid, Similarity: profile.PhyPartnerSimilarity})
+	}
+	if isSajuProgressFinal(last.Progress) {
+		send(SajuProfileEventEnd, last)
+		return
+	}
+
+	heartbeat := time.NewTicker(sajuProfileEventHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			dslog.Log("info", fmt.Sprintf("[GetSajuProfileEvents][3] Client disconnected - UID: %s", uid))
+			return
+		case ev := <-events:
+			if !send(ev.Type, ev.Data) {
+				return
+			}
+			if st, ok := ev.Data.(StatusEvent); ok {
+				last = st
+				if isSajuProgressFinal(st.Progress) {
+					send(SajuProfileEventEnd, st)
+					return
+				}
+			}
+		case <-heartbeat.C:
+			// 다른 인스턴스에서 처리된 전이 보정
+			if profile, err := s.sajuProfileRepo.FindByUID(uid); err == nil {
+				if st := newStatusEvent(profile, ""); st.Status != last.Status || st.Progress != last.Progress {
+					last = st
+					if !send(SajuProfileEventStatus, st) {
+						return
+					}
+					if isSajuProgressFinal(st.Progress) {
+						send(SajuProfileEventEnd, st)
+						return
+					}
+					continue
+				}
+			}
+			if !sse.comment("ping") {
+				return
+			}
+		}
+	}
+}
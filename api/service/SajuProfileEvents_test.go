@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+
+	"sajudating_api/api/types"
+)
+
+func TestSajuProfileEventHub(t *testing.T) {
+	hub := &sajuProfileEventHub{subs: map[string]map[chan SajuProfileEvent]struct{}{}}
+	a, unsubA := hub.subscribe("p1")
+	b, unsubB := hub.subscribe("p1")
+	other, unsubOther := hub.subscribe("p2")
+	defer unsubOther()
+
+	hub.publish("p1", SajuProfileEvent{Type: SajuProfileEventStatus, Data: StatusEvent{Status: types.SajuStatusInProgress}})
+	for name, ch := range map[string]<-chan SajuProfileEvent{"a": a, "b": b} {
+		select {
+		case ev := <-ch:
+			if ev.Type != SajuProfileEventStatus {
+				t.Errorf("%s: event type = %q, want status", name, ev.Type)
+			}
+		default:
+			t.Errorf("%s: no event delivered", name)
+		}
+	}
+	select {
+	case ev := <-other:
+		t.Errorf("p2 subscriber got p1 event %+v", ev)
+	default:
+	}
+
+	unsubA()
+	unsubB()
+	if _, ok := hub.subs["p1"]; ok {
+		t.Errorf("p1 subscribers not cleaned up")
+	}
+}
+
+func TestSajuProfileEventHub_FullBufferDoesNotBlock(t *testing.T) {
+	hub := &sajuProfileEventHub{subs: map[string]map[chan SajuProfileEvent]struct{}{}}
+	ch, unsub := hub.subscribe("p1")
+	defer unsub()
+	for i := 0; i < sajuProfileEventBuffer+5; i++ {
+		hub.publish("p1", SajuProfileEvent{Type: SajuProfileEventSaju})
+	}
+	if len(ch) != sajuProfileEventBuffer {
+		t.Errorf("buffered = %d, want %d", len(ch), sajuProfileEventBuffer)
+	}
+}
+
+func TestIsSajuProgressFinal(t *testing.T) {
+	const (
+		ini  = types.SajuStatusInitiate
+		ing  = types.SajuStatusInProgress
+		done = types.SajuStatusDone
+		fail = types.SajuStatusError
+	)
+	tests := []struct {
+		name               string
+		saju, phy, partner types.SajuStatus
+		want               bool
+	}{
+		{"all done", done, done, done, true},
+		{"saju error, phy running", fail, ing, ini, false},
+		{"saju error, partner running", fail, done, ing, false},
+		{"saju error, rest done", fail, done, done, true},
+		{"phy error skips partner", done, fail, ini, true},
+		{"partner error", done, done, fail, true},
+		{"partner not started", done, done, ini, false},
+	}
+	for _, tt := range tests {
+		p := types.SajuProgress{
+			Saju:    types.SajuStepStatus{Status: tt.saju},
+			Phy:     types.SajuStepStatus{Status: tt.phy},
+			Partner: types.SajuStepStatus{Status: tt.partner},
+		}
+		if got := isSajuProgressFinal(p); got != tt.want {
+			t.Errorf("%s: isSajuProgressFinal = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
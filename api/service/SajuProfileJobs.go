@@ -0,0 +1,233 @@
+// SajuProfile 분석 파이프라인 job handlers: 사주(RequestSaju), 관상(RequestPhy), 파트너 이미지(RequestPartnerImage), 일운 피드(runDailyJob)
+// 각 단계는 완료시 job 에 기록되어 재시도/서버 재시작시 완료된 단계는 건너뛴다.
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/types"
+)
+
+// Job types (Job.Type)
+const (
+	JobTypeSajuProfileSaju  = "saju_profile.saju"
+	JobTypeSajuProfilePhy   = "saju_profile.phy"
+	JobTypeSajuProfileDaily = "saju_profile.daily"
+)
+
+// Job steps (Job.Steps)
+const (
+	jobStepSaju         = "saju"
+	jobStepFaceFeature  = "face_feature" // phy 하위 단계: 얼굴 특징 추출
+	jobStepPhyAnalysis  = "phy_analysis" // phy 하위 단계: 관상 추론
+	jobStepPhy          = "phy"          // 파트너 매칭 및 프로필 저장까지 완료
+	jobStepPartnerImage = "partner_image"
+)
+
+// Job payload keys (Job.Payload)
+const (
+	jobPayloadImageBase64      = "image_base64"  // 본인 이미지 - face_feature 단계 완료, job 성공 또는 purgeJobPayload 시 삭제 (dead 는 재시도 위해 유지)
+	jobPayloadFaceFeatures     = "face_features" // face_feature 단계 결과 (JSON)
+	jobPayloadPhyAnalysis      = "phy_analysis"  // phy_analysis 단계 결과 (JSON)
+	jobPayloadPartnerUid       = "partner_uid"
+	jobPayloadNeedPartnerImage = "need_partner_image"
+	jobPayloadDailyDate        = "date" // 일운 job 기준 날짜 (yyyy-mm-dd, KST)
+)
+
+// RegisterJobHandlers binds the SajuProfile pipeline job types to q.
+func (s *SajuProfileService) RegisterJobHandlers(q *JobQueueService) {
+	s.jobQueue = q
+	q.RegisterHandler(JobTypeSajuProfileSaju, s.runSajuJob, s.onSajuJobDead)
+	q.RegisterHandler(JobTypeSajuProfilePhy, s.runPhyJob, s.onPhyJobDead, jobPayloadImageBase64)
+	q.RegisterHandler(JobTypeSajuProfileDaily, s.runDailyJob, nil)
+}
+
+func (s *SajuProfileService) runSajuJob(ctx context.Context, job *entity.Job) error {
+	uid := job.RefUid
+	if job.HasStep(jobStepSaju) { // 단계 완료 후 상태 전이 전에 중단된 경우
+		s.transit(uid, entity.SajuProfileStepSaju, entity.SajuProfileStatusDone)
+		return nil
+	}
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if err != nil {
+		return fmt.Errorf("saju profile not found: %w", err)
+	}
+	s.log(uid, "info", fmt.Sprintf("[runSajuJob][1] Starting saju analysis - Job: %s, Attempt: %d", job.Uid, job.Attempts))
+	s.transit(uid, entity.SajuProfileStepSaju, entity.SajuProfileStatusIng)
+	if profile.SajuSummary == "" { // 이전 시도에서 저장까지 끝났으면 재추론하지 않음
+		// 스트리밍으로 생성하며 delta 를 /saju/stream 구독자에게 전달
+		s.publishSajuStream(uid, SajuStreamEventReset, struct{}{})
+		response, err := s.streamSaju(ctx, uid, profile.Sex, profile.Birthdate, func(d extdao.FieldDelta) error {
+			s.publishSajuStream(uid, SajuStreamEventDelta, SajuDeltaEvent{Field: d.Field, Delta: d.Delta})
+			return nil
+		})
+		if err != nil {
+			s.log(uid, "error", fmt.Sprintf("[runSajuJob][2] Failed to request saju: %v", err))
+			return err
+		}
+		err = s.sajuProfileRepo.UpdateSajuSummary(uid, response.Summary, response.Content, response.Nickname, response.PartnerTips)
+		if err != nil {
+			s.log(uid, "error", fmt.Sprintf("[runSajuJob][3] Failed to update saju profile: %v", err))
+			return err
+		}
+		s.publishEvent(uid, SajuProfileEventSaju, SajuResult{
+			Summary: response.Summary, Content: response.Content, PartnerTips: response.PartnerTips,
+		})
+		s.publishSajuStream(uid, SajuStreamEventResult, SajuStreamResult{
+			Nickname:    response.Nickname,
+			Summary:     response.Summary,
+			Content:     response.Content,
+			PartnerTips: response.PartnerTips,
+		})
+	}
+	s.log(uid, "info", "[runSajuJob][4] Saju summary updated successfully")
+	if err := s.jobQueue.CompleteStep(job, jobStepSaju, nil); err != nil {
+		return err
+	}
+	s.transit(uid, entity.SajuProfileStepSaju, entity.SajuProfileStatusDone)
+	return nil
+}
+
+func (s *SajuProfileService) onSajuJobDead(job *entity.Job, err error) {
+	s.log(job.RefUid, "error", fmt.Sprintf("[runSajuJob] Job dead - Job: %s, Attempts: %d, Error: %v", job.Uid, job.Attempts, err))
+	s.transit(job.RefUid, entity.SajuProfileStepSaju, entity.SajuProfileStatusError)
+	s.publishSajuStream(job.RefUid, SajuStreamEventError, SajuStreamError{Message: "Failed to analyze saju"})
+}
+
+func (s *SajuProfileService) runPhyJob(ctx context.Context, job *entity.Job) error {
+	uid := job.RefUid
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if err != nil {
+		return fmt.Errorf("saju profile not found: %w", err)
+	}
+
+	if !job.HasStep(jobStepPhy) {
+		s.log(uid, "info", fmt.Sprintf("[runPhyJob][1] Starting phy analysis - Job: %s, Attempt: %d", job.Uid, job.Attempts))
+		s.transit(uid, entity.SajuProfileStepPhy, entity.SajuProfileStatusIng)
+		faceFeatures, phyAnalysisResponse, partnerUid, needPartnerImage, err := s.RequestPhy(job, profile.Sex, profile.Birthdate)
+		if err != nil {
+			s.log(uid, "error", fmt.Sprintf("[runPhyJob][2] Failed to request phy analysis: %v", err))
+			return err
+		}
+		if err := s.updatePhyProfile(uid, profile.Sex, faceFeatures, phyAnalysisResponse, partnerUid); err != nil {
+			return err
+		}
+		s.publishEvent(uid, SajuProfileEventPhy, KwansangResult{
+			Summary:         phyAnalysisResponse.Summary,
+			Content:         phyAnalysisResponse.Content,
+			Partner_summary: phyAnalysisResponse.IdealPartnerPhysiognomy.PartnerSummary,
+		})
+		needImage := ""
+		if needPartnerImage {
+			needImage = "true"
+		}
+		err = s.jobQueue.CompleteStep(job, jobStepPhy, map[string]string{
+			jobPayloadPartnerUid:       partnerUid,
+			jobPayloadNeedPartnerImage: needImage,
+		})
+		if err != nil {
+			return err
+		}
+		s.log(uid, "info", fmt.Sprintf("[runPhyJob][3] Phy analysis completed and profile updated successfully - PartnerUID: %s", partnerUid))
+	}
+	s.transit(uid, entity.SajuProfileStepPhy, entity.SajuProfileStatusDone)
+
+	s.transit(uid, entity.SajuProfileStepPartner, entity.SajuProfileStatusIng)
+	if job.Payload[jobPayloadNeedPartnerImage] == "true" && !job.HasStep(jobStepPartnerImage) {
+		partnerUid := job.Payload[jobPayloadPartnerUid]
+		s.log(uid, "info", fmt.Sprintf("[runPhyJob][4] Generating partner image - PartnerUID: %s", partnerUid))
+		if err := s.RequestPartnerImage(uid, profile.Sex, profile.Birthdate, partnerUid); err != nil {
+			return err
+		}
+		if err := s.jobQueue.CompleteStep(job, jobStepPartnerImage, nil); err != nil {
+			return err
+		}
+		s.log(uid, "info", fmt.Sprintf("[runPhyJob][5] Partner image completed - PartnerUID: %s", partnerUid))
+	}
+	s.transit(uid, entity.SajuProfileStepPartner, entity.SajuProfileStatusDone)
+	return nil
+}
+
+func (s *SajuProfileService) onPhyJobDead(job *entity.Job, err error) {
+	s.log(job.RefUid, "error", fmt.Sprintf("[runPhyJob] Job dead - Job: %s, Attempts: %d, Error: %v", job.Uid, job.Attempts, err))
+	step := entity.SajuProfileStepPartner
+	if !job.HasStep(jobStepPhy) {
+		step = entity.SajuProfileStepPhy
+	}
+	s.transit(job.RefUid, step, entity.SajuProfileStatusError)
+}
+
+// 단계 상태 전이 (실패는 로그만 남기고 파이프라인은 계속)
+func (s *SajuProfileService) transit(uid, step, to string) {
+	if err := s.sajuProfileRepo.TransitStep(uid, step, to); err != nil {
+		s.log(uid, "error", fmt.Sprintf("[transit] %s -> %s: %v", step, to, err))
+		return
+	}
+	s.publishStatus(uid, step)
+}
+
+// 엔티티 상태값(init/ing/done/error) → API 상태값
+func toSajuStatus(status string) types.SajuStatus {
+	switch entity.NormalizeSajuProfileStatus(status) {
+	case entity.SajuProfileStatusIng:
+		return types.SajuStatusInProgress
+	case entity.SajuProfileStatusDone:
+		return types.SajuStatusDone
+	case entity.SajuProfileStatusError:
+		return types.SajuStatusError
+	}
+	return types.SajuStatusInitiate
+}
+
+func toSajuProgress(profile *entity.SajuProfile) types.SajuProgress {
+	step := func(name string) types.SajuStepStatus {
+		st := types.SajuStepStatus{Status: toSajuStatus(profile.StepStatus(name))}
+		for _, t := range profile.StatusTransitions {
+			if t.Step == name && t.At > st.UpdatedAt {
+				st.UpdatedAt = t.At
+			}
+		}
+		return st
+	}
+	return types.SajuProgress{
+		Saju:    step(entity.SajuProfileStepSaju),
+		Phy:     step(entity.SajuProfileStepPhy),
+		Partner: step(entity.SajuProfileStepPartner),
+	}
+}
+
+// 관상 분석 결과 및 파트너 정보를 프로필에 저장 (saju job 이 동시에 쓰는 필드는 건드리지 않음)
+func (s *SajuProfileService) updatePhyProfile(uid, sex string, faceFeatures *extdao.FaceFeatures, phyAnalysisResponse *extdao.PhyAnalysisResponse, phyPartnerUid string) error {
+	partner := phyAnalysisResponse.IdealPartnerPhysiognomy
+	phyProfile := &entity.SajuProfile{
+		Uid:                     uid,
+		PhySummary:              phyAnalysisResponse.Summary,
+		PhyContent:              phyAnalysisResponse.Content,
+		MyFeatureEyes:           faceFeatures.Eyes.ToString(),
+		MyFeatureNose:           faceFeatures.Nose.ToString(),
+		MyFeatureMouth:          faceFeatures.Mouth.ToString(),
+		MyFeatureFaceShape:      faceFeatures.FaceShape,
+		MyFeatureNotes:          faceFeatures.Notes,
+		PartnerSummary:          partner.PartnerSummary,
+		PartnerFeatureEyes:      partner.FacialFeaturePreferences.Eyes.ToString(),
+		PartnerFeatureNose:      partner.FacialFeaturePreferences.Nose.ToString(),
+		PartnerFeatureMouth:     partner.FacialFeaturePreferences.Mouth.ToString(),
+		PartnerFeatureFaceShape: partner.FacialFeaturePreferences.FaceShape,
+		PartnerPersonalityMatch: partner.PersonalityMatch,
+		PartnerSex:              "male",
+		PartnerAge:              phyAnalysisResponse.GetPartnerAge(),
+		PhyPartnerUid:           phyPartnerUid,
+	}
+	if sex == "male" {
+		phyProfile.PartnerSex = "female"
+	}
+	err := dao.NewSajuProfileRepository().UpdatePhyProfile(phyProfile)
+	if err != nil {
+		s.log(uid, "error", fmt.Sprintf("[updatePhyProfile] Failed to update saju profile with phy data: %v", err))
+	}
+	return err
+}
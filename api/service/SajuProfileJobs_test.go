@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"sajudating_api/api/config"
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/types"
+	"sajudating_api/api/utils"
+	"sajudating_api/api/utils/dslog"
+)
+
+// 파이프라인 테스트용 AiMeta 프롬프트 - testdata/cassettes 의 요청 키가 이 값들로 계산되므로 바꾸면 재기록 필요
+const (
+	testPipelineModel     = "gpt-4o-mini"
+	testPipelineBirthdate = "199603141230"
+	testSajuPrompt        = "사주 분석 테스트 - sex: {{sex}}, birthdate: {{birthdate}}"
+	testFaceFeaturePrompt = "얼굴 특징 추출 테스트 - sex: {{sex}}, birthdate: {{birthdate}}"
+	testPhyPrompt         = "관상 분석 테스트 - sex: {{sex}}, features: {{phy_features_json}}"
+)
+
+// testPipelineLLM replays service/testdata/cassettes by default;
+// LLM_CASSETTE=record OPENAI_API_KEY=... re-records them (see ext_dao/testdata/cassettes/README.md).
+func testPipelineLLM(t *testing.T) *extdao.CassetteLLMProvider {
+	t.Helper()
+	mode := os.Getenv("LLM_CASSETTE")
+	if mode == extdao.CassetteOff {
+		mode = extdao.CassetteReplay
+	}
+	llm, err := extdao.NewLLMProvider(os.Getenv("OPENAI_API_KEY"), mode, "testdata/cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return llm.(*extdao.CassetteLLMProvider)
+}
+
+// testPipelineDB connects to a throwaway database on MONGODB_TEST_URI (dropped after the test).
+// The saju/phy handlers read profiles, AiMetas and partners from MongoDB, so these tests skip without it.
+func testPipelineDB(t *testing.T) {
+	t.Helper()
+	uri := os.Getenv("MONGODB_TEST_URI")
+	if uri == "" {
+		t.Skip("MONGODB_TEST_URI not set; skipping MongoDB-backed pipeline test")
+	}
+	prev := config.AppConfig
+	config.AppConfig = &config.Config{Database: config.DatabaseConfig{URI: uri, DBName: "sajudating_test_" + utils.GenUid()}}
+	if err := dao.InitDatabase(); err != nil {
+		t.Fatalf("InitDatabase() error = %v", err)
+	}
+	dslog.InitDsLog()
+	t.Cleanup(func() {
+		dao.GetDB().Drop(context.Background())
+		config.AppConfig = prev
+	})
+
+	repo := dao.NewAIMetaRepository()
+	for _, meta := range []entity.AIMeta{
+		{MetaType: string(types.AiMetaTypeSaju), Prompt: testSajuPrompt},
+		{MetaType: string(types.AiMetaTypeFaceFeature), Prompt: testFaceFeaturePrompt},
+		{MetaType: string(types.AiMetaTypePhy), Prompt: testPhyPrompt},
+	} {
+		meta.Uid, meta.Name, meta.Model, meta.InUse = utils.GenUid(), meta.MetaType, testPipelineModel, true
+		if err := repo.Create(&meta); err != nil {
+			t.Fatalf("seed ai meta %s: %v", meta.MetaType, err)
+		}
+	}
+}
+
+func newTestPipeline(t *testing.T) (*SajuProfileService, *JobQueueService, *memJobStore, *extdao.CassetteLLMProvider, string) {
+	t.Helper()
+	testPipelineDB(t)
+	llm := testPipelineLLM(t)
+	s := NewSajuProfileServiceWithLLM(llm)
+	store := newMemJobStore()
+	q := newTestJobQueue(store, 3)
+	s.RegisterJobHandlers(q)
+
+	profile := &entity.SajuProfile{Uid: utils.GenUid(), Birthdate: testPipelineBirthdate, Sex: "female"}
+	if err := s.sajuProfileRepo.Create(profile); err != nil {
+		t.Fatalf("create profile: %v", err)
+	}
+	return s, q, store, llm, profile.Uid
+}
+
+func TestSajuJob_RunsOnceThenSkipsLLM(t *testing.T) {
+	s, q, store, llm, uid := newTestPipeline(t)
+
+	job, err := q.Enqueue(JobTypeSajuProfileSaju, uid, nil)
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	runNext(t, q)
+	got, _ := store.FindByUID(job.Uid)
+	if got.Status != entity.JobStatusDone || !got.HasStep(jobStepSaju) {
+		t.Fatalf("saju job: status = %s, steps = %v, err = %s", got.Status, got.Steps, got.LastError)
+	}
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.SajuSummary == "" || profile.StepStatus(entity.SajuProfileStepSaju) != entity.SajuProfileStatusDone {
+		t.Fatalf("profile after saju job: summary = %q, saju status = %s", profile.SajuSummary, profile.SajuStatus)
+	}
+	calls := len(llm.Calls())
+
+	// 저장까지 끝난 프로필에 대한 재실행 (재시작 후 중복 job 등) → LLM 재호출 없음
+	if _, err := q.Enqueue(JobTypeSajuProfileSaju, uid, nil); err != nil {
+		t.Fatal(err)
+	}
+	runNext(t, q)
+	if n := len(llm.Calls()); n != calls {
+		t.Fatalf("rerun made %d new LLM calls", n-calls)
+	}
+}
+
+func TestPhyJob_RetryResumesFromRecordedSteps(t *testing.T) {
+	s, q, store, llm, uid := newTestPipeline(t)
+
+	job, err := q.Enqueue(JobTypeSajuProfilePhy, uid, map[string]string{jobPayloadImageBase64: "aW1n"})
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	// 이상형 이미지 AiMeta 가 없으므로 partner_image 단계에서 실패 (관상 단계까지는 기록)
+	runNext(t, q)
+	got, _ := store.FindByUID(job.Uid)
+	for _, step := range []string{jobStepFaceFeature, jobStepPhyAnalysis, jobStepPhy} {
+		if !got.HasStep(step) {
+			t.Fatalf("step %s not recorded: steps = %v, err = %s", step, got.Steps, got.LastError)
+		}
+	}
+	if _, ok := got.Payload[jobPayloadImageBase64]; ok {
+		t.Fatal("image payload kept after face_feature step")
+	}
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.StepStatus(entity.SajuProfileStepPhy) != entity.SajuProfileStatusDone || profile.PhyPartnerUid == "" {
+		t.Fatalf("profile after phy step: phy status = %s, partner = %q", profile.PhyStatus, profile.PhyPartnerUid)
+	}
+	calls := len(llm.Calls())
+	if got.Status == entity.JobStatusDone { // 유사 파트너가 매칭되면 이미지 단계 없이 완료
+		return
+	}
+
+	// 이미지가 준비된 상태로 재시도 → 완료된 단계는 건너뛰고 LLM 재호출 없이 완료
+	partnerUid := got.Payload[jobPayloadPartnerUid]
+	if err := s.phyIdealPartnerRepo.UpdateImageMimeType(partnerUid, "image/png"); err != nil {
+		t.Fatal(err)
+	}
+	runNext(t, q)
+	got, _ = store.FindByUID(job.Uid)
+	if got.Status != entity.JobStatusDone || !got.HasStep(jobStepPartnerImage) {
+		t.Fatalf("retry: status = %s, steps = %v, err = %s", got.Status, got.Steps, got.LastError)
+	}
+	if n := len(llm.Calls()); n != calls {
+		t.Fatalf("retry made %d new LLM calls", n-calls)
+	}
+	partner, err := s.phyIdealPartnerRepo.FindBySource(uid, job.Uid)
+	if err != nil || partner == nil || partner.Uid != partnerUid {
+		t.Fatalf("partner of job = %+v, %v; want %s", partner, err, partnerUid)
+	}
+}
@@ -0,0 +1,255 @@
+// 사주 해석 스트리밍 (SSE): GET /api/saju_profile/{uid}/saju/stream
+// 사주 job(runSajuJob) 이 LLM 응답 JSON 을 받는 즉시 필드 단위 delta 를 sajuStreamEvents 로 발행하고, 이 엔드포인트는 구독해서 전달만 한다.
+// LLM 호출과 상태 전이는 job 에서만 일어나므로 재접속해도 추가 호출이 생기지 않는다.
+// 생성 도중 접속/재접속한 구독자는 지금까지 쌓인 필드를 먼저 받은 뒤 이후 delta 를 받는다.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+	extdao "sajudating_api/api/ext_dao"
+	"sajudating_api/api/utils"
+	"sajudating_api/api/utils/dslog"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SSE event names for the saju stream
+const (
+	SajuStreamEventReset  = "reset"  // job 시도 시작 - 이전에 받은 delta 는 버림 (재시도)
+	SajuStreamEventDelta  = "delta"  // SajuDeltaEvent
+	SajuStreamEventResult = "result" // SajuStreamResult (최종)
+	SajuStreamEventError  = "error"  // SajuStreamError
+	SajuStreamEventEnd    = "end"
+)
+
+// delta 는 한 응답에 수백 건이라 진행 이벤트보다 구독 버퍼를 크게 둔다.
+const sajuStreamEventBuffer = 512
+
+// 사주 job 의 delta / result / error 전용 hub (진행 이벤트 스트림과 분리)
+var sajuStreamEvents = newSajuStreamHub()
+
+// sajuStreamHub relays saju stream events and keeps the fields accumulated by the current attempt
+// so a subscriber joining mid-generation can be replayed up to date (in-process only, like the hub).
+type sajuStreamHub struct {
+	mu      sync.Mutex
+	hub     *sajuProfileEventHub
+	partial map[string]*sajuStreamPartial // uid -> 진행중인 시도의 누적 필드
+}
+
+// sajuStreamPartial: 필드별 누적 텍스트 (fields 는 처음 받은 순서)
+type sajuStreamPartial struct {
+	fields []string
+	text   map[string]string
+}
+
+func newSajuStreamHub() *sajuStreamHub {
+	return &sajuStreamHub{
+		hub:     &sajuProfileEventHub{subs: map[string]map[chan SajuProfileEvent]struct{}{}, buffer: sajuStreamEventBuffer},
+		partial: map[string]*sajuStreamPartial{},
+	}
+}
+
+// subscribe registers a subscriber and returns, atomically with it, the events replaying the
+// current attempt so far (reset + one delta per accumulated field); nil when nothing is in progress.
+func (h *sajuStreamHub) subscribe(uid string) ([]SajuProfileEvent, <-chan SajuProfileEvent, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	events, unsubscribe := h.hub.subscribe(uid)
+	p := h.partial[uid]
+	if p == nil {
+		return nil, events, unsubscribe
+	}
+	replay := []SajuProfileEvent{{Type: SajuStreamEventReset, Data: struct{}{}}}
+	for _, f := range p.fields {
+		replay = append(replay, SajuProfileEvent{Type: SajuStreamEventDelta, Data: SajuDeltaEvent{Field: f, Delta: p.text[f]}})
+	}
+	return replay, events, unsubscribe
+}
+
+func (h *sajuStreamHub) publish(uid string, ev SajuProfileEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	switch ev.Type {
+	case SajuStreamEventReset:
+		h.partial[uid] = &sajuStreamPartial{text: map[string]string{}}
+	case SajuStreamEventDelta:
+		p := h.partial[uid]
+		if p == nil {
+			p = &sajuStreamPartial{text: map[string]string{}}
+			h.partial[uid] = p
+		}
+		if d, ok := ev.Data.(SajuDeltaEvent); ok {
+			if _, seen := p.text[d.Field]; !seen {
+				p.fields = append(p.fields, d.Field)
+			}
+			p.text[d.Field] += d.Delta
+		}
+	case SajuStreamEventResult, SajuStreamEventError:
+		delete(h.partial, uid)
+	}
+	h.hub.publish(uid, ev)
+}
+
+// delta 이벤트 데이터 - field: nickname / summary / content / partner_tips
+type SajuDeltaEvent struct {
+	Field string `json:"field"`
+	Delta string `json:"delta"`
+}
+
+type SajuStreamResult struct {
+	Nickname    string `json:"nickname"`
+	Summary     string `json:"summary"`
+	Content     string `json:"content"`
+	PartnerTips string `json:"partner_tips"`
+}
+
+type SajuStreamError struct {
+	Message string `json:"message"`
+}
+
+func (s *SajuProfileService) publishSajuStream(uid, eventType string, data any) {
+	sajuStreamEvents.publish(uid, SajuProfileEvent{Type: eventType, Data: data})
+}
+
+// GET /api/saju_profile/:uid/saju/stream
+// 이미 사주 결과가 있으면 result 후 종료, 사주 단계가 error 면 error 후 종료.
+// 그 외에는 진행중인 job 의 delta 를 전달하고 result / error 가 오면 end 로 종료.
+func (s *SajuProfileService) StreamSajuProfileSaju(w http.ResponseWriter, r *http.Request) {
+	uid := chi.URLParam(r, "uid")
+	dslog.Log("info", fmt.Sprintf("[StreamSajuProfileSaju][1] Request started - UID: %s", uid))
+
+	sse, ok := newSSEWriter(w)
+	if !ok {
+		utils.RespondWithError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+	// 스냅샷 이전에 구독해야 그 사이 발행된 result 를 놓치지 않음
+	replay, events, unsubscribe := sajuStreamEvents.subscribe(uid)
+	defer unsubscribe()
+
+	profile, err := s.sajuProfileRepo.FindByUID(uid)
+	if err != nil {
+		dslog.Log("error", fmt.Sprintf("[StreamSajuProfileSaju][2] Saju profile not found: %v", err))
+		utils.RespondWithError(w, http.StatusNotFound, "Saju profile not found")
+		return
+	}
+	sse.start()
+	if s.sendSajuStreamFinal(sse, profile) {
+		return
+	}
+	// 접속 전에 생성된 부분 결과부터 전달
+	for _, ev := range replay {
+		if !sse.event(ev.Type, ev.Data) {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(sajuProfileEventHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			dslog.Log("info", fmt.Sprintf("[StreamSajuProfileSaju][3] Client disconnected - UID: %s", uid))
+			return
+		case ev := <-events:
+			if !sse.event(ev.Type, ev.Data) {
+				return
+			}
+			if ev.Type == SajuStreamEventResult || ev.Type == SajuStreamEventError {
+				sse.event(SajuStreamEventEnd, struct{}{})
+				return
+			}
+		case <-heartbeat.C:
+			// 다른 인스턴스의 워커가 끝낸 경우 보정
+			if profile, err := s.sajuProfileRepo.FindByUID(uid); err == nil && s.sendSajuStreamFinal(sse, profile) {
+				return
+			}
+			if !sse.comment("ping") {
+				return
+			}
+		}
+	}
+}
+
+// sendSajuStreamFinal writes result/error + end when the saju step is already final; false otherwise.
+func (s *SajuProfileService) sendSajuStreamFinal(sse *sseWriter, profile *entity.SajuProfile) bool {
+	switch {
+	case profile.SajuSummary != "":
+		sse.event(SajuStreamEventResult, SajuStreamResult{
+			Nickname:    profile.Nickname,
+			Summary:     profile.SajuSummary,
+			Content:     profile.SajuContent,
+			PartnerTips: profile.PartnerMatchTips,
+		})
+	case entity.NormalizeSajuProfileStatus(profile.StepStatus(entity.SajuProfileStepSaju)) == entity.SajuProfileStatusError:
+		sse.event(SajuStreamEventError, SajuStreamError{Message: "Failed to analyze saju"})
+	default:
+		return false
+	}
+	sse.event(SajuStreamEventEnd, struct{}{})
+	return true
+}
+
+// streamSaju runs the in-use Saju AiMeta prompt as a streaming completion, recording an AiExecution like runSaju.
+func (s *SajuProfileService) streamSaju(ctx context.Context, uid, sex, birth string, onDelta func(extdao.FieldDelta) error) (*extdao.SajuAnalysisResponse, error) {
+	input, err := s.sajuExecutionInput(uid, sex, birth)
+	if err != nil {
+		return nil, err
+	}
+	inputKVJSON, _ := json.Marshal(input.Inputkvs)
+	outputKVJSON, _ := json.Marshal(input.Outputkvs)
+	now := time.Now().UnixMilli()
+	aiExecution := &entity.AiExecution{
+		Uid:               utils.GenUid(),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		MetaUid:           input.MetaUID,
+		MetaType:          input.MetaType,
+		PromptType:        input.PromptType,
+		Prompt:            input.Prompt,
+		ValuedPrompt:      input.ValuedPrompt,
+		IntputKV_JSON:     string(inputKVJSON),
+		OutputKV_JSON:     string(outputKVJSON),
+		Model:             input.Model,
+		Temperature:       input.Temperature,
+		MaxTokens:         input.MaxTokens,
+		Status:            "running",
+		RunBy:             "system",
+		RunSajuProfileUid: uid,
+	}
+	aiExecutionRepo := dao.NewAiExecutionRepository()
+	if err := aiExecutionRepo.Create(aiExecution); err != nil {
+		return nil, fmt.Errorf("failed to create ai execution: %w", err)
+	}
+
+	response, usage, err := extdao.StreamSajuAnalysis(ctx, s.llm, extdao.ChatCompletionRequest{
+		Model:       input.Model,
+		Messages:    []extdao.ChatMessage{{Role: "user", Content: input.ValuedPrompt}},
+		Temperature: float32(input.Temperature),
+		MaxTokens:   input.MaxTokens,
+	}, onDelta)
+	aiExecution.ElapsedTime = int(time.Now().UnixMilli() - now)
+	if usage != nil {
+		s.aiExecution.recordUsage(aiExecution, usage, 0)
+	}
+	if err != nil {
+		aiExecution.Status = "failed"
+		aiExecution.ErrorMessage = err.Error()
+	} else {
+		output, _ := json.Marshal(response)
+		aiExecution.Status = "done"
+		aiExecution.OutputText = string(output)
+	}
+	if uerr := aiExecutionRepo.Update(aiExecution); uerr != nil {
+		s.log(uid, "error", fmt.Sprintf("[streamSaju] Failed to update ai execution: %v", uerr))
+	}
+	return response, err
+}
@@ -0,0 +1,49 @@
+package service
+
+import "testing"
+
+func TestSajuStreamHub_ReplaysPartialFields(t *testing.T) {
+	hub := newSajuStreamHub()
+	replay, _, unsub := hub.subscribe("p1")
+	unsub()
+	if replay != nil {
+		t.Errorf("replay before generation = %+v, want none", replay)
+	}
+
+	hub.publish("p1", SajuProfileEvent{Type: SajuStreamEventReset, Data: struct{}{}})
+	for _, d := range []SajuDeltaEvent{{"nickname", "불꽃"}, {"summary", "따뜻한 "}, {"nickname", " 여행자"}, {"summary", "성격"}} {
+		hub.publish("p1", SajuProfileEvent{Type: SajuStreamEventDelta, Data: d})
+	}
+
+	replay, events, unsubLive := hub.subscribe("p1")
+	defer unsubLive()
+	want := []SajuDeltaEvent{{"nickname", "불꽃 여행자"}, {"summary", "따뜻한 성격"}}
+	if len(replay) != len(want)+1 || replay[0].Type != SajuStreamEventReset {
+		t.Fatalf("replay = %+v, want reset + %d deltas", replay, len(want))
+	}
+	for i, w := range want {
+		if got, _ := replay[i+1].Data.(SajuDeltaEvent); got != w {
+			t.Errorf("replay[%d] = %+v, want %+v", i+1, got, w)
+		}
+	}
+
+	// 이후 delta 는 채널로만 전달 (replay 와 중복 없음)
+	hub.publish("p1", SajuProfileEvent{Type: SajuStreamEventDelta, Data: SajuDeltaEvent{"content", "본문"}})
+	if ev := <-events; ev.Type != SajuStreamEventDelta {
+		t.Errorf("live event = %+v, want delta", ev)
+	}
+
+	// 재시도(reset) 는 이전 누적을 버리고, result 는 누적을 지운다
+	hub.publish("p1", SajuProfileEvent{Type: SajuStreamEventReset, Data: struct{}{}})
+	replay, _, unsub = hub.subscribe("p1")
+	unsub()
+	if len(replay) != 1 {
+		t.Errorf("replay after reset = %+v, want reset only", replay)
+	}
+	hub.publish("p1", SajuProfileEvent{Type: SajuStreamEventResult, Data: SajuStreamResult{}})
+	replay, _, unsub = hub.subscribe("p1")
+	unsub()
+	if replay != nil {
+		t.Errorf("replay after result = %+v, want none", replay)
+	}
+}
@@ -26,13 +26,24 @@ type SajuProfileService struct {
 	sajuProfileRepo     *dao.SajuProfileRepository
 	phyIdealPartnerRepo *dao.PhyIdealPartnerRepository
 	sajuProfileLogRepo  *dao.SajuProfileLogRepository
+	jobQueue            *JobQueueService
+	llm                 extdao.LLMProvider
+	aiExecution         *AdminAiExecutionService
 }
 
 func NewSajuProfileService() *SajuProfileService {
+	return NewSajuProfileServiceWithLLM(extdao.DefaultLLMProvider())
+}
+
+// NewSajuProfileServiceWithLLM runs every LLM step (embeddings and AiMeta executions) on llm.
+func NewSajuProfileServiceWithLLM(llm extdao.LLMProvider) *SajuProfileService {
 	return &SajuProfileService{
 		sajuProfileRepo:     dao.NewSajuProfileRepository(),
 		phyIdealPartnerRepo: dao.NewPhyIdealPartnerRepository(),
 		sajuProfileLogRepo:  dao.NewSajuProfileLogRepository(),
+		jobQueue:            GetJobQueueService(),
+		llm:                 llm,
+		aiExecution:         NewAdminAiExecutionServiceWithLLM(llm),
 	}
 }
 
@@ -50,7 +61,7 @@ func (s *SajuProfileService) log(uid string, status string, text string) error {
 }
 
 // POST /api/saju_profile
-// 프로필 생성 직후 리턴, 내부 추론 과정은 별도 스레드로 처리
+// 프로필 생성 직후 리턴, 내부 추론 과정은 job queue 워커에서 처리 (SajuProfileJobs.go)
 func (s *SajuProfileService) CreateSajuProfile(w http.ResponseWriter, r *http.Request) {
 	profileUid := utils.GenUid()
 	s.log(profileUid, "info", fmt.Sprintf("[CreateSajuProfile][1] Request started - Method: %s, URL: %s", r.Method, r.URL.Path))
@@ -65,7 +76,9 @@ func (s *SajuProfileService) CreateSajuProfile(w http.ResponseWriter, r *http.Re
 
 	birthdate := r.FormValue("birthdate")
 	sex := r.FormValue("sex")
-	s.log(profileUid, "info", fmt.Sprintf("[CreateSajuProfile][5] Extracted form values - Birthdate: %s, Sex: %s", birthdate, sex))
+	calendarValue := r.FormValue("calendar")
+	leapMonth := r.FormValue("leap_month") == "true" || r.FormValue("leap_month") == "1"
+	s.log(profileUid, "info", fmt.Sprintf("[CreateSajuProfile][5] Extracted form values - Birthdate: %s, Sex: %s, Calendar: %s, LeapMonth: %v", birthdate, sex, calendarValue, leapMonth))
 
 	if birthdate == "" || sex == "" {
 		s.log(profileUid, "error", fmt.Sprintf("[CreateSajuProfile][6] Validation failed - missing required fields (birthdate: %s, sex: %s)", birthdate, sex))
@@ -79,6 +92,26 @@ func (s *SajuProfileService) CreateSajuProfile(w http.ResponseWriter, r *http.Re
 		Sex:       sex,
 	}
 
+	// 음력 입력은 양력으로 변환해 Birthdate 에 두고 원본은 LunarBirthdate 로 보관
+	calendar, err := extdao.NormalizeCalendar(calendarValue)
+	if err != nil {
+		s.log(profileUid, "error", fmt.Sprintf("[CreateSajuProfile][6] Validation failed - %v", err))
+		utils.RespondWithError(w, http.StatusBadRequest, "Calendar must be SOLAR or LUNAR")
+		return
+	}
+	if calendar == extdao.CalendarLunar {
+		solarBirthdate, err := extdao.SolarBirthdate(birthdate, calendar, leapMonth)
+		if err != nil {
+			s.log(profileUid, "error", fmt.Sprintf("[CreateSajuProfile][6] Lunar birthdate conversion failed: %v", err))
+			utils.RespondWithError(w, http.StatusBadRequest, "Invalid lunar birthdate")
+			return
+		}
+		profile.Calendar = calendar
+		profile.LunarBirthdate = birthdate
+		profile.LeapMonth = leapMonth
+		profile.Birthdate = solarBirthdate
+	}
+
 	// 이미지 처리
 	file, header, err := r.FormFile("image")
 	if err != nil {
@@ -109,7 +142,8 @@ func (s *SajuProfileService) CreateSajuProfile(w http.ResponseWriter, r *http.Re
 	s.log(profileUid, "info", fmt.Sprintf("[CreateSajuProfile][10] Image processed successfully - Size: %d bytes, MimeType: %s", len(imageData), profile.ImageMimeType))
 
 	// 팔자 생성
-	paljaResult, err := extdao.GenPalja(profile.Birthdate, "") // 빈 문자열 = 기본값 Asia/Seoul 사용
+	inputBirthdate, inputCalendar, inputLeapMonth := profileBirthInput(profile)
+	paljaResult, err := extdao.GenPaljaCalendar(inputBirthdate, "", inputCalendar, inputLeapMonth) // 빈 문자열 = 기본값 Asia/Seoul 사용
 	if err != nil {
 		s.log(profileUid, "error", fmt.Sprintf("[CreateSajuProfile][11] Failed to call sxtwl: %v", err))
 		utils.RespondWithError(w, http.StatusInternalServerError, "Failed to call sxtwl")
@@ -124,68 +158,33 @@ func (s *SajuProfileService) CreateSajuProfile(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	go func(uid string, birthdate string, sex string, palja string) {
-		s.log(uid, "info", "[CreateSajuProfile][13] Starting saju analysis in background goroutine")
-		response, err := s.RequestSaju(uid, birthdate, sex, palja)
-		if err != nil {
-			s.log(uid, "error", fmt.Sprintf("[CreateSajuProfile][14] Failed to request saju: %v", err))
-			return
-		}
-		err = s.sajuProfileRepo.UpdateSajuSummary(uid, response.Summary, response.Content, response.Nickname, response.PartnerTips)
-		if err != nil {
-			s.log(uid, "error", fmt.Sprintf("[CreateSajuProfile][15] Failed to update saju profile: %v", err))
-			return
-		}
-		s.log(uid, "info", "[CreateSajuProfile][16] Saju summary updated successfully")
+	// 사주/관상 분석은 job queue 로 처리 (재시도·재시작시 이어서 실행)
+	s.log(profileUid, "info", "[CreateSajuProfile][13] Enqueueing saju analysis job")
+	if _, err := s.jobQueue.Enqueue(JobTypeSajuProfileSaju, profile.Uid, nil); err != nil {
+		s.log(profileUid, "error", fmt.Sprintf("[CreateSajuProfile][14] Failed to enqueue saju job: %v", err))
+		s.transit(profile.Uid, entity.SajuProfileStepSaju, entity.SajuProfileStatusError)
+	}
 
-	}(profile.Uid, profile.Birthdate, profile.Sex, profile.Palja)
+	if _, err := s.jobQueue.Enqueue(JobTypeSajuProfileDaily, profile.Uid, nil); err != nil {
+		s.log(profileUid, "error", fmt.Sprintf("[CreateSajuProfile][14] Failed to enqueue daily job: %v", err))
+	}
 
-	// request phy analysis
+	// request phy analysis - 이미지는 job payload 에만 두고 job 종료시 삭제
+	s.log(profileUid, "info", "[CreateSajuProfile][15] Enqueueing phy analysis job")
 	base64Image := base64.StdEncoding.EncodeToString(imageData)
-	go func(uid, base64Image, sex, birthdate string) {
-		s.log(uid, "info", "[CreateSajuProfile][17] Starting phy analysis in background goroutine")
-		faceFeatures, phyAnalysisResponse, phyPartnerUid, err := s.RequestPhy(uid, base64Image, profile.Sex, profile.Birthdate)
-		if err != nil {
-			s.log(uid, "error", fmt.Sprintf("[CreateSajuProfile][18] Failed to request phy analysis: %v", err))
-			s.sajuProfileRepo.UpdateStatus(uid, utils.StrPtr("error"), nil, nil, nil)
-			return
-		}
-		sajuProfile, err := dao.NewSajuProfileRepository().FindByUID(uid)
-		if err != nil {
-			s.log(uid, "error", fmt.Sprintf("[CreateSajuProfile][19] Saju profile not found: %v", err))
-			return
-		}
-
-		sajuProfile.PhySummary = phyAnalysisResponse.Summary
-		sajuProfile.PhyContent = phyAnalysisResponse.Content
-		sajuProfile.MyFeatureEyes = faceFeatures.Eyes.ToString()
-		sajuProfile.MyFeatureNose = faceFeatures.Nose.ToString()
-		sajuProfile.MyFeatureMouth = faceFeatures.Mouth.ToString()
-		sajuProfile.MyFeatureFaceShape = faceFeatures.FaceShape
-		sajuProfile.MyFeatureNotes = faceFeatures.Notes
-		sajuProfile.PartnerSummary = phyAnalysisResponse.IdealPartnerPhysiognomy.PartnerSummary
-		sajuProfile.PartnerFeatureEyes = phyAnalysisResponse.IdealPartnerPhysiognomy.FacialFeaturePreferences.Eyes.ToString()
-		sajuProfile.PartnerFeatureNose = phyAnalysisResponse.IdealPartnerPhysiognomy.FacialFeaturePreferences.Nose.ToString()
-		sajuProfile.PartnerFeatureMouth = phyAnalysisResponse.IdealPartnerPhysiognomy.FacialFeaturePreferences.Mouth.ToString()
-		sajuProfile.PartnerFeatureFaceShape = phyAnalysisResponse.IdealPartnerPhysiognomy.FacialFeaturePreferences.FaceShape
-		sajuProfile.PartnerPersonalityMatch = phyAnalysisResponse.IdealPartnerPhysiognomy.PersonalityMatch
-		sajuProfile.PartnerSex = "male"
-		if sex == "male" {
-			sajuProfile.PartnerSex = "female"
-		}
-		sajuProfile.PartnerAge = phyAnalysisResponse.GetPartnerAge()
-		sajuProfile.PhyPartnerUid = phyPartnerUid
-		sajuProfile.UpdatedAt = time.Now().UnixMilli()
-		err = dao.NewSajuProfileRepository().Update(sajuProfile)
-		if err != nil {
-			s.log(uid, "error", fmt.Sprintf("[CreateSajuProfile][20] Failed to update saju profile with phy data: %v", err))
-			return
-		}
-		s.log(uid, "info", fmt.Sprintf("[CreateSajuProfile][21] Phy analysis completed and profile updated successfully - PartnerUID: %s", phyPartnerUid))
-	}(profile.Uid, base64Image, profile.Sex, profile.Birthdate)
+	if _, err := s.jobQueue.Enqueue(JobTypeSajuProfilePhy, profile.Uid, map[string]string{
+		jobPayloadImageBase64: base64Image,
+	}); err != nil {
+		s.log(profileUid, "error", fmt.Sprintf("[CreateSajuProfile][16] Failed to enqueue phy job: %v", err))
+		s.transit(profile.Uid, entity.SajuProfileStepPhy, entity.SajuProfileStatusError)
+	}
 
 	result := types.SajuProfile{
 		Uid:            profile.Uid,
+		Birthdate:      profile.Birthdate,
+		Calendar:       profile.Calendar,
+		LunarBirthdate: profile.LunarBirthdate,
+		LeapMonth:      profile.LeapMonth,
 		Palja:          profile.Palja,
 		PaljaHanja:     utils.ConvertPaljaToWithHanja(profile.Palja),
 		PaljaMainShape: utils.GetImageSentenceOfIlju(profile.Palja),
@@ -196,7 +195,7 @@ func (s *SajuProfileService) CreateSajuProfile(w http.ResponseWriter, r *http.Re
 	json.NewEncoder(w).Encode(types.APIResponse[types.SajuProfile]{
 		Data: result,
 	})
-	s.log(profileUid, "success", fmt.Sprintf("[CreateSajuProfile][22] Request completed successfully - UID: %s", profile.Uid))
+	s.log(profileUid, "success", fmt.Sprintf("[CreateSajuProfile][17] Request completed successfully - UID: %s", profile.Uid))
 }
 
 // GET /api/saju_profile/:uid
@@ -234,8 +233,11 @@ func (s *SajuProfileService) GetSajuProfile(w http.ResponseWriter, r *http.Reque
 	data := types.SajuProfile{
 		Uid: profile.Uid,
 		// input
-		Birthdate: profile.Birthdate,
-		Sex:       profile.Sex,
+		Birthdate:      profile.Birthdate,
+		Calendar:       profile.Calendar,
+		LunarBirthdate: profile.LunarBirthdate,
+		LeapMonth:      profile.LeapMonth,
+		Sex:            profile.Sex,
 		// Image:          imageBase64,
 		Palja:          profile.Palja,
 		PaljaHanja:     utils.ConvertPaljaToWithHanja(profile.Palja),
@@ -243,7 +245,8 @@ func (s *SajuProfileService) GetSajuProfile(w http.ResponseWriter, r *http.Reque
 		// result
 		PartnerImage: partnerImageBase64,
 		Nickname:     profile.Nickname,
-		Status:       types.SajuStatus(profile.Status),
+		Status:       toSajuStatus(profile.Status),
+		Progress:     toSajuProgress(profile),
 		Saju: types.SajuContent{
 			Summary:     profile.SajuSummary,
 			Content:     profile.SajuContent,
@@ -273,6 +276,14 @@ func (s *SajuProfileService) GetSajuProfile(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// 프로필의 입력 생년월일 (음력 입력이면 음력 원본과 윤달 여부, 그 외는 양력 Birthdate)
+func profileBirthInput(profile *entity.SajuProfile) (birthdate, calendar string, leapMonth bool) {
+	if profile.Calendar == extdao.CalendarLunar && profile.LunarBirthdate != "" {
+		return profile.LunarBirthdate, extdao.CalendarLunar, profile.LeapMonth
+	}
+	return profile.Birthdate, extdao.CalendarSolar, false
+}
+
 func convertMapToKVs(inputMap map[string]string) []*model.KVInput {
 	kvs := []*model.KVInput{}
 	for k, v := range inputMap {
@@ -318,119 +329,142 @@ func (s *SajuProfileService) RequestSaju(uid, birth, sex, palja string) (*extdao
 	return response, nil
 }
 
-// 관상 추론하여 결과 저장 및 반환
-// 1. 이미지 기반으로 얼굴의 특징 분석 (OpenAiPhyExtDao.ExtractFaceFeatures)
-// 2. 얼굴의 특징 및 성별을 기반으로 관상 추론 결과 및 상대방 이상형 특징 추론 (OpenAiPhyExtDao.InterpretPhysiognomy)
-// 데이터 업데이트
-// 이미지 생성을 분리
-// 3. 상대방 이상형 특징 추론 결과를 바탕으로 이미지 생성 (OpenAiPhyExtDao.GenerateIdealPartnerImage)
-func (s *SajuProfileService) RequestPhy(uid, imageBase64, sex, birth string) (
-	*extdao.FaceFeatures, *extdao.PhyAnalysisResponse, string, error,
+// 관상 추론하여 결과 저장 및 반환 (phy job 단계)
+// 1. face_feature: 이미지 기반으로 얼굴의 특징 분석 (runFaceFeature) - 결과는 job payload 에 저장하고 이미지는 삭제
+// 2. phy_analysis: 얼굴의 특징 및 성별을 기반으로 관상 추론 결과 및 상대방 이상형 특징 추론 (runPhy) - 결과는 job payload 에 저장
+// 3. 이상형 파트너 upsert (profile uid + job uid) 후 유사 파트너 조회
+// 완료된 단계는 job payload 의 결과를 재사용하므로 재시도해도 LLM 을 다시 호출하지 않는다.
+// 이미지 생성을 분리 - 유사 파트너가 없으면 needPartnerImage=true, RequestPartnerImage 로 생성
+func (s *SajuProfileService) RequestPhy(job *entity.Job, sex, birth string) (
+	*extdao.FaceFeatures, *extdao.PhyAnalysisResponse, string, bool, error,
 ) {
-
-	s.log(uid, "info", fmt.Sprintf("[RequestPhy][1] Starting phy analysis - Birth: %s, Sex: %s", birth, sex))
+	uid := job.RefUid
+	s.log(uid, "info", fmt.Sprintf("[RequestPhy][1] Starting phy analysis - Birth: %s, Sex: %s, Steps: %v", birth, sex, job.Steps))
 	partnerSex := "male"
 	if sex == "male" {
 		partnerSex = "female"
 	}
-	// extDao := extdao.NewOpenAiPhyExtDao()
-	// // 얼굴 특징 추론
-	// faceFeatures, err := extDao.ExtractFaceFeatures(context.Background(), imageBase64)
-	// if err != nil {
-	// 	log.Printf("[RequestPhy] Failed to extract face features: %v", err)
-	// 	return nil, nil, "", err
-	// }
-	faceFeatures, err := s.runFaceFeature(uid, imageBase64, sex, birth)
-	if err != nil {
-		s.log(uid, "error", fmt.Sprintf("[RequestPhy][2] Failed to extract face features: %v", err))
-		return nil, nil, "", err
-	}
-	s.log(uid, "info", fmt.Sprintf("[RequestPhy][3] Face features extracted - Eyes: %s, Nose: %s, Mouth: %s, FaceShape: %s", faceFeatures.Eyes.ToString(), faceFeatures.Nose.ToString(), faceFeatures.Mouth.ToString(), faceFeatures.FaceShape))
-	s.sajuProfileRepo.UpdateFaceFeatures(uid,
-		faceFeatures.Eyes.ToString(),
-		faceFeatures.Nose.ToString(),
-		faceFeatures.Mouth.ToString(),
-		faceFeatures.FaceShape,
-		faceFeatures.Notes,
-	)
 
-	// 관상 추론
-	// log.Printf("[RequestPhy] InterpretPhysiognomy: %s, %s, %s", sex, age, faceFeatures.ToString())
-	// phyAnalysisResponse, err := extDao.InterpretPhysiognomy(context.Background(), faceFeatures, sex, age)
-	// if err != nil {
-	// 	log.Printf("[RequestPhy] Failed to interpret physiognomy: %v", err)
-	// 	return nil, nil, "", err
-	// }
-	phyAnalysisResponse, err := s.runPhy(uid, faceFeatures, sex, birth)
-	if err != nil {
-		s.log(uid, "error", fmt.Sprintf("[RequestPhy][4] Failed to interpret physiognomy: %v", err))
-		return nil, nil, "", err
+	faceFeatures := &extdao.FaceFeatures{}
+	if job.HasStep(jobStepFaceFeature) {
+		if err := json.Unmarshal([]byte(job.Payload[jobPayloadFaceFeatures]), faceFeatures); err != nil {
+			return nil, nil, "", false, fmt.Errorf("invalid face features in job payload: %w", err)
+		}
+	} else {
+		imageBase64 := job.Payload[jobPayloadImageBase64]
+		if imageBase64 == "" {
+			return nil, nil, "", false, fmt.Errorf("image not found in job payload")
+		}
+		var err error
+		faceFeatures, err = s.runFaceFeature(uid, imageBase64, sex, birth)
+		if err != nil {
+			s.log(uid, "error", fmt.Sprintf("[RequestPhy][2] Failed to extract face features: %v", err))
+			return nil, nil, "", false, err
+		}
+		s.log(uid, "info", fmt.Sprintf("[RequestPhy][3] Face features extracted - Eyes: %s, Nose: %s, Mouth: %s, FaceShape: %s", faceFeatures.Eyes.ToString(), faceFeatures.Nose.ToString(), faceFeatures.Mouth.ToString(), faceFeatures.FaceShape))
+		s.sajuProfileRepo.UpdateFaceFeatures(uid,
+			faceFeatures.Eyes.ToString(),
+			faceFeatures.Nose.ToString(),
+			faceFeatures.Mouth.ToString(),
+			faceFeatures.FaceShape,
+			faceFeatures.Notes,
+		)
+		featuresJSON, _ := json.Marshal(faceFeatures)
+		err = s.jobQueue.CompleteStep(job, jobStepFaceFeature, map[string]string{
+			jobPayloadFaceFeatures: string(featuresJSON),
+		}, jobPayloadImageBase64)
+		if err != nil {
+			return nil, nil, "", false, err
+		}
+	}
+
+	phyAnalysisResponse := &extdao.PhyAnalysisResponse{}
+	if job.HasStep(jobStepPhyAnalysis) {
+		if err := json.Unmarshal([]byte(job.Payload[jobPayloadPhyAnalysis]), phyAnalysisResponse); err != nil {
+			return nil, nil, "", false, fmt.Errorf("invalid phy analysis in job payload: %w", err)
+		}
+	} else {
+		var err error
+		phyAnalysisResponse, err = s.runPhy(uid, faceFeatures, sex, birth)
+		if err != nil {
+			s.log(uid, "error", fmt.Sprintf("[RequestPhy][4] Failed to interpret physiognomy: %v", err))
+			return nil, nil, "", false, err
+		}
+		s.log(uid, "info", fmt.Sprintf("[RequestPhy][5] Physiognomy analysis completed - Age: %d, PartnerAge: %d", phyAnalysisResponse.GetAge(), phyAnalysisResponse.GetPartnerAge()))
+		s.updatePhyAnalysisResponse(uid, phyAnalysisResponse)
+		analysisJSON, _ := json.Marshal(phyAnalysisResponse)
+		err = s.jobQueue.CompleteStep(job, jobStepPhyAnalysis, map[string]string{
+			jobPayloadPhyAnalysis: string(analysisJSON),
+		})
+		if err != nil {
+			return nil, nil, "", false, err
+		}
 	}
-	s.log(uid, "info", fmt.Sprintf("[RequestPhy][5] Physiognomy analysis completed - Age: %d, PartnerAge: %d", phyAnalysisResponse.GetAge(), phyAnalysisResponse.GetPartnerAge()))
-	s.updatePhyAnalysisResponse(uid, phyAnalysisResponse)
-	phyPartner, err := s.createPhyPartner(uid, phyAnalysisResponse, partnerSex)
+
+	phyPartner, err := s.createPhyPartner(uid, job.Uid, phyAnalysisResponse, partnerSex)
 	if err != nil {
 		s.log(uid, "error", fmt.Sprintf("[RequestPhy][6] Failed to create phy partner: %v", err))
-		return nil, nil, "", err
+		return nil, nil, "", false, err
 	}
 	// 유사한 이미지 조회 후 조건부 이미지 생성
-	openaiDao := extdao.NewOpenAIExtDao()
-	embedding, err := openaiDao.CreateEmbedding(context.Background(), "", phyPartner.GenerateEmbeddingText())
-	if err != nil {
-		s.log(uid, "error", fmt.Sprintf("[RequestPhy][7] Failed to create embedding: %v", err))
-		return nil, nil, "", err
-	}
-	phyPartner.Embedding = utils.ConvertFloat32ToFloat64(embedding)
-
 	similarPhyPartner, similarityScore, err := s.phyIdealPartnerRepo.FindMostSimilarByEmbedding(
 		phyPartner.Embedding, partnerSex, 0.99,
 	)
 	if err != nil {
 		s.log(uid, "error", fmt.Sprintf("[RequestPhy][8] Failed to find similar phy partner: %v", err))
-		// return nil, nil, "", err
+		// return nil, nil, "", false, err
 	}
 
 	matchedPartnerUid := ""
+	needPartnerImage := false
 	if similarPhyPartner != nil { // 유사 이미지 존재시 유사이미지로 업데이트, 스코어와 함께
 		matchedPartnerUid = similarPhyPartner.Uid
 		s.log(uid, "info", fmt.Sprintf("[RequestPhy][9] Similar partner found - PartnerUID: %s, Similarity: %.4f", similarPhyPartner.Uid, similarityScore))
 		s.updatePartner(uid, similarPhyPartner.Uid, similarityScore)
 	} else {
+		// 이미지 생성은 별도 단계 (RequestPartnerImage)
 		matchedPartnerUid = phyPartner.Uid
-		// 이미지 생성.
-		s.log(uid, "info", fmt.Sprintf("[RequestPhy][10] No similar partner found, generating new image - PartnerUID: %s, Age: %d, Sex: %s", phyPartner.Uid, phyAnalysisResponse.GetPartnerAge(), partnerSex))
-		// idealPartnerImage, err := extDao.GenerateIdealPartnerImage(context.Background(), phyAnalysisResponse, partnerSex)
-		// if err != nil {
-		// 	log.Printf("[RequestPhy] Failed to generate ideal partner image: %v", err)
-		// 	return nil, nil, "", err
-		// }
-		idealPartnerImage, err := s.runIdealPartnerImage(uid, sex, birth, phyAnalysisResponse)
+		needPartnerImage = true
+		s.log(uid, "info", fmt.Sprintf("[RequestPhy][10] No similar partner found, new image required - PartnerUID: %s, Age: %d, Sex: %s", phyPartner.Uid, phyAnalysisResponse.GetPartnerAge(), partnerSex))
+	}
+
+	s.log(uid, "info", fmt.Sprintf("[RequestPhy][11] Phy analysis completed successfully - MatchedPartnerUID: %s", matchedPartnerUid))
+	return faceFeatures, phyAnalysisResponse, matchedPartnerUid, needPartnerImage, nil
+}
+
+// 상대방 이상형 특징 추론 결과(PhyIdealPartner)를 바탕으로 이미지 생성 후 S3 저장 및 파트너 바인딩
+// 이미 이미지가 있는 파트너면 생성 없이 바인딩만 (재시도시 중복 생성 방지)
+func (s *SajuProfileService) RequestPartnerImage(uid, sex, birth, partnerUid string) error {
+	phyPartner, err := s.phyIdealPartnerRepo.FindByUID(partnerUid)
+	if err != nil {
+		s.log(uid, "error", fmt.Sprintf("[RequestPartnerImage][1] Phy partner not found - PartnerUID: %s, Error: %v", partnerUid, err))
+		return err
+	}
+	if !phyPartner.HasImage {
+		idealPartnerImage, err := s.runIdealPartnerImage(uid, sex, birth, phyPartner)
 		if err != nil {
-			s.log(uid, "error", fmt.Sprintf("[RequestPhy][11] Failed to generate ideal partner image: %v", err))
-			return nil, nil, "", err
+			s.log(uid, "error", fmt.Sprintf("[RequestPartnerImage][2] Failed to generate ideal partner image: %v", err))
+			return err
 		}
-		s.log(uid, "info", fmt.Sprintf("[RequestPhy][12] Ideal partner image generated - Size: %d bytes", len(idealPartnerImage)))
+		s.log(uid, "info", fmt.Sprintf("[RequestPartnerImage][3] Ideal partner image generated - Size: %d bytes", len(idealPartnerImage)))
 		// 파트너 이미지 S3에 저장
 		imageS3Dao := extdao.NewImageS3Dao()
 		imagePath := utils.GetPhyPartnerImagePath(phyPartner.Uid)
 		err, _ = imageS3Dao.SaveImageToS3(imagePath, idealPartnerImage)
 		if err != nil {
-			s.log(uid, "error", fmt.Sprintf("[RequestPhy][13] Failed to save image to S3: %v", err))
-			return nil, nil, "", err
+			s.log(uid, "error", fmt.Sprintf("[RequestPartnerImage][4] Failed to save image to S3: %v", err))
+			return err
 		}
 		// 파트너에 파일 메타정보 업데이트
 		err = s.phyIdealPartnerRepo.UpdateImageMimeType(phyPartner.Uid, "image/png")
 		if err != nil {
-			s.log(uid, "error", fmt.Sprintf("[RequestPhy][14] Failed to update phy partner image mime type: %v", err))
-			return nil, nil, "", err
+			s.log(uid, "error", fmt.Sprintf("[RequestPartnerImage][5] Failed to update phy partner image mime type: %v", err))
+			return err
 		}
-
-		// 파트너 바인딩 업데이트 내 설명으로 생성되었으므로 유사도는 1
-		s.updatePartner(uid, phyPartner.Uid, 1.0)
 	}
 
-	s.log(uid, "info", fmt.Sprintf("[RequestPhy][15] Phy analysis completed successfully - MatchedPartnerUID: %s", matchedPartnerUid))
-	return faceFeatures, phyAnalysisResponse, matchedPartnerUid, nil
+	// 파트너 바인딩 업데이트 내 설명으로 생성되었으므로 유사도는 1
+	return s.updatePartner(uid, phyPartner.Uid, 1.0)
 }
 
 // ! SajuResult
@@ -613,12 +647,29 @@ func (s *SajuProfileService) updatePartner(uid string, partner_uid string, partn
 		s.log(uid, "error", fmt.Sprintf("[updatePartner] Failed to update partner - PartnerUID: %s, Similarity: %.2f, Error: %v", partner_uid, partner_similarity, err))
 	} else {
 		s.log(uid, "info", fmt.Sprintf("[updatePartner] Partner updated successfully - PartnerUID: %s, Similarity: %.2f", partner_uid, partner_similarity))
+		s.publishEvent(uid, SajuProfileEventPartner, PartnerMatchEvent{PartnerUid: partner_uid, Similarity: partner_similarity})
 	}
 	return err
 }
 
 // PhyIdealPartner 생성
-func (s *SajuProfileService) createPhyPartner(uid string, response *extdao.PhyAnalysisResponse, sex string) (*entity.PhyIdealPartner, error) {
+// 기본 임베딩 모델로 text 임베딩 생성 (AiExecution 으로 기록, 사용량은 프로필에 누적)
+func (s *SajuProfileService) createEmbedding(uid, text string) ([]float32, error) {
+	return s.aiExecution.RunEmbedding(context.Background(), text, "system", uid)
+}
+
+// 관상 job 한 건당 이상형 파트너 하나 - (profile uid, job uid) 로 upsert 하여 재시도시 기존 파트너 재사용
+func (s *SajuProfileService) createPhyPartner(uid, jobUid string, response *extdao.PhyAnalysisResponse, sex string) (*entity.PhyIdealPartner, error) {
+	existing, err := s.phyIdealPartnerRepo.FindBySource(uid, jobUid)
+	if err != nil {
+		s.log(uid, "error", fmt.Sprintf("[createPhyPartner][0] Failed to find phy partner of job %s: %v", jobUid, err))
+		return nil, err
+	}
+	if existing != nil {
+		s.log(uid, "info", fmt.Sprintf("[createPhyPartner][0] Reusing phy partner of job %s - PartnerUID: %s", jobUid, existing.Uid))
+		return existing, nil
+	}
+
 	s.log(uid, "info", fmt.Sprintf("[createPhyPartner][1] Creating phy partner - Sex: %s, Age: %d", sex, response.GetPartnerAge()))
 	now := time.Now().UnixMilli()
 	phyPartner := &entity.PhyIdealPartner{
@@ -635,18 +686,20 @@ func (s *SajuProfileService) createPhyPartner(uid string, response *extdao.PhyAn
 		Sex:              sex,
 		Age:              response.GetPartnerAge(),
 		HasImage:         false,
+		SourceProfileUid: uid,
+		SourceJobUid:     jobUid,
 	}
 	phyPartner.EmbeddingText = phyPartner.GenerateEmbeddingText()
-	openaiDao := extdao.NewOpenAIExtDao()
-	embedding, err := openaiDao.CreateEmbedding(context.Background(), "", phyPartner.EmbeddingText)
+	embedding, err := s.createEmbedding(uid, phyPartner.EmbeddingText)
 	if err != nil {
 		s.log(uid, "error", fmt.Sprintf("[createPhyPartner][2] Failed to create embedding: %v", err))
 		return nil, err
 	}
 	phyPartner.Embedding = utils.ConvertFloat32ToFloat64(embedding)
-	phyPartner.EmbeddingModel = "text-embedding-3-small"
+	phyPartner.EmbeddingModel = defaultEmbeddingModel
 
-	if err := s.phyIdealPartnerRepo.Create(phyPartner); err != nil {
+	phyPartner, err = s.phyIdealPartnerRepo.UpsertBySource(phyPartner)
+	if err != nil {
 		s.log(uid, "error", fmt.Sprintf("[createPhyPartner][3] Failed to save PhyIdealPartner to database: %v", err))
 		return nil, err
 	}
@@ -689,12 +742,12 @@ func (s *SajuProfileService) UpdateSajuProfile(w http.ResponseWriter, r *http.Re
 }
 
 // AiExecution 수행
-func (s *SajuProfileService) runSaju(uid, sex, birth string) (*extdao.SajuAnalysisResponse, error) {
-	// AI Execution 생성 및 수행
+// 사용중인 Saju AiMeta 로 실행 입력 생성 (runSaju / StreamSajuProfileSaju 공용)
+func (s *SajuProfileService) sajuExecutionInput(uid, sex, birth string) (*model.AiExcutionInput, error) {
 	metaType := string(types.AiMetaTypeSaju)
 	aiMeta, err := dao.NewAIMetaRepository().FindInUseByMetaType(metaType)
 	if err != nil {
-		s.log(uid, "error", fmt.Sprintf("[runSaju][1] Failed to find ai meta: %v", err))
+		s.log(uid, "error", fmt.Sprintf("[sajuExecutionInput] Failed to find ai meta: %v", err))
 		return nil, err
 	}
 	// aiMeta 조회
@@ -703,7 +756,7 @@ func (s *SajuProfileService) runSaju(uid, sex, birth string) (*extdao.SajuAnalys
 		"birthdate": birth,
 	}
 	outputMap := GetAiMetaValues(metaType, inputMap)
-	aiExecutionInput := model.AiExcutionInput{
+	return &model.AiExcutionInput{
 		MetaUID:      aiMeta.Uid,
 		MetaType:     aiMeta.MetaType,
 		PromptType:   "text",
@@ -715,10 +768,18 @@ func (s *SajuProfileService) runSaju(uid, sex, birth string) (*extdao.SajuAnalys
 		Temperature:  aiMeta.Temperature,
 		MaxTokens:    aiMeta.MaxTokens,
 		Size:         aiMeta.Size,
+	}, nil
+}
+
+func (s *SajuProfileService) runSaju(uid, sex, birth string) (*extdao.SajuAnalysisResponse, error) {
+	// AI Execution 생성 및 수행
+	aiExecutionInput, err := s.sajuExecutionInput(uid, sex, birth)
+	if err != nil {
+		s.log(uid, "error", fmt.Sprintf("[runSaju][1] Failed to find ai meta: %v", err))
+		return nil, err
 	}
-	adminAiExecutionService := NewAdminAiExecutionService()
-	sr, err := adminAiExecutionService.RunAiExecution(context.Background(),
-		aiExecutionInput, utils.StrPtr("system"), utils.StrPtr(uid),
+	sr, err := s.aiExecution.RunAiExecution(context.Background(),
+		*aiExecutionInput, utils.StrPtr("system"), utils.StrPtr(uid),
 	)
 	if err != nil {
 		s.log(uid, "error", fmt.Sprintf("[runSaju][2] Failed to run ai execution: %v", err))
@@ -769,8 +830,7 @@ func (s *SajuProfileService) runFaceFeature(uid, imageBase64, sex, birth string)
 		Size:             aiMeta.Size,
 		InputImageBase64: &imageBase64,
 	}
-	adminAiExecutionService := NewAdminAiExecutionService()
-	sr, err := adminAiExecutionService.RunAiExecution(context.Background(),
+	sr, err := s.aiExecution.RunAiExecution(context.Background(),
 		aiExecutionInput, utils.StrPtr("system"), utils.StrPtr(uid),
 	)
 	if err != nil {
@@ -822,8 +882,7 @@ func (s *SajuProfileService) runPhy(uid string, faceFeatures *extdao.FaceFeature
 		MaxTokens:    aiMeta.MaxTokens,
 		Size:         aiMeta.Size,
 	}
-	adminAiExecutionService := NewAdminAiExecutionService()
-	sr, err := adminAiExecutionService.RunAiExecution(context.Background(),
+	sr, err := s.aiExecution.RunAiExecution(context.Background(),
 		aiExecutionInput, utils.StrPtr("system"), utils.StrPtr(uid),
 	)
 	if err != nil {
@@ -848,7 +907,7 @@ func (s *SajuProfileService) runPhy(uid string, faceFeatures *extdao.FaceFeature
 	return nil, fmt.Errorf("failed to get ai execution result")
 }
 
-func (s *SajuProfileService) runIdealPartnerImage(uid, sex, birth string, phyAnalysisResponse *extdao.PhyAnalysisResponse) ([]byte, error) {
+func (s *SajuProfileService) runIdealPartnerImage(uid, sex, birth string, partner *entity.PhyIdealPartner) ([]byte, error) {
 	metaType := string(types.AiMetaTypeIdealPartnerImageMale)
 	if sex == "female" {
 		metaType = string(types.AiMetaTypeIdealPartnerImageFemale)
@@ -862,11 +921,11 @@ func (s *SajuProfileService) runIdealPartnerImage(uid, sex, birth string, phyAna
 	inputMap := map[string]string{
 		"sex":                sex,
 		"birthdate":          birth,
-		"partner_eyes":       phyAnalysisResponse.IdealPartnerPhysiognomy.FacialFeaturePreferences.Eyes.ToString(),
-		"partner_nose":       phyAnalysisResponse.IdealPartnerPhysiognomy.FacialFeaturePreferences.Nose.ToString(),
-		"partner_mouth":      phyAnalysisResponse.IdealPartnerPhysiognomy.FacialFeaturePreferences.Mouth.ToString(),
-		"partner_face_shape": phyAnalysisResponse.IdealPartnerPhysiognomy.FacialFeaturePreferences.FaceShape,
-		"partner_age":        fmt.Sprintf("%d", phyAnalysisResponse.GetPartnerAge()),
+		"partner_eyes":       partner.FeatureEyes,
+		"partner_nose":       partner.FeatureNose,
+		"partner_mouth":      partner.FeatureMouth,
+		"partner_face_shape": partner.FeatureFaceShape,
+		"partner_age":        fmt.Sprintf("%d", partner.Age),
 	}
 	outputMap := GetAiMetaValues(metaType, inputMap)
 	aiExecutionInput := model.AiExcutionInput{
@@ -882,8 +941,7 @@ func (s *SajuProfileService) runIdealPartnerImage(uid, sex, birth string, phyAna
 		MaxTokens:    aiMeta.MaxTokens,
 		Size:         aiMeta.Size,
 	}
-	adminAiExecutionService := NewAdminAiExecutionService()
-	sr, err := adminAiExecutionService.RunAiExecution(context.Background(),
+	sr, err := s.aiExecution.RunAiExecution(context.Background(),
 		aiExecutionInput, utils.StrPtr("system"), utils.StrPtr(uid),
 	)
 	if err != nil {
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"sajudating_api/api/admgql/model"
+	ttycdom "sajudating_api/api/domain/ttyc"
+	"sajudating_api/api/utils"
+)
+
+// SajuSearchParams is the input of FindSajuDatetimes (admgql sajuSearch, MCP find_saju_datetimes).
+type SajuSearchParams struct {
+	Pattern         string // "甲子/丙寅/戊辰/庚午", "*/*/壬戌" 등 (ttyc.ParsePillarPattern)
+	From            string // 현지 일시 (포함), yyyy-mm-dd[ HH:MM]
+	To              string // 현지 일시 (제외)
+	TzOffsetMinutes *int   // 기본 KST(540)
+	ZishiConvention string // DAY_CHANGE_00 | YAJASI | DAY_CHANGE_23
+	Limit           int    // 기본 1000, 최대 10000
+}
+
+// SajuSearchWindow is one matching time window [Start, End) in local time.
+type SajuSearchWindow struct {
+	Start        string `json:"start"`
+	End          string `json:"end"`
+	StartTs      int64  `json:"startTs"`
+	EndTs        int64  `json:"endTs"`
+	PillarsHanja string `json:"pillarsHanja"` // "甲子 丙寅 戊辰 庚午"
+	PillarsKo    string `json:"pillarsKo"`
+}
+
+// FindSajuDatetimes returns the datetimes whose chart matches params.Pattern (ttyc.FindDatetimes, 최대 200년·10000 구간).
+func FindSajuDatetimes(ctx context.Context, params SajuSearchParams) ([]SajuSearchWindow, bool, error) {
+	pattern, err := ttycdom.ParsePillarPattern(params.Pattern)
+	if err != nil {
+		return nil, false, err
+	}
+	tzOffset := ttycdom.KST_OFFSET_MINUTES
+	if params.TzOffsetMinutes != nil {
+		tzOffset = *params.TzOffsetMinutes
+	}
+	fromTs, err := parseSearchLocalTs(params.From, tzOffset)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid from: %w", err)
+	}
+	toTs, err := parseSearchLocalTs(params.To, tzOffset)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid to: %w", err)
+	}
+	res, err := ttycdom.FindDatetimes(ctx, pattern, ttycdom.TtycSearchRange{
+		FromTs:          fromTs,
+		ToTs:            toTs,
+		TzOffsetMinutes: &tzOffset,
+		ZishiConvention: ttycdom.TtycZishiConvention(strings.ToUpper(strings.TrimSpace(params.ZishiConvention))),
+		Limit:           params.Limit,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	out := make([]SajuSearchWindow, 0, len(res.Windows))
+	for _, w := range res.Windows {
+		hanja := []string{w.Pillars.Year.GanjiHanja, w.Pillars.Month.GanjiHanja, w.Pillars.Day.GanjiHanja}
+		ko := []string{w.Pillars.Year.GanjiKo, w.Pillars.Month.GanjiKo, w.Pillars.Day.GanjiKo}
+		if w.Pillars.Hour != nil {
+			hanja = append(hanja, w.Pillars.Hour.GanjiHanja)
+			ko = append(ko, w.Pillars.Hour.GanjiKo)
+		}
+		out = append(out, SajuSearchWindow{
+			Start:        formatSearchLocal(w.Start),
+			End:          formatSearchLocal(w.End),
+			StartTs:      w.StartTs,
+			EndTs:        w.EndTs,
+			PillarsHanja: strings.Join(hanja, " "),
+			PillarsKo:    strings.Join(ko, " "),
+		})
+	}
+	return out, res.Truncated, nil
+}
+
+// FindSajuDatetimesGql serves the sajuSearch query: Value 는 {windows, truncated} JSON.
+func (s *AdminToolService) FindSajuDatetimesGql(ctx context.Context, input model.SajuSearchInput) (*model.SimpleResult, error) {
+	limit := 0
+	if input.Limit != nil {
+		limit = *input.Limit
+	}
+	windows, truncated, err := FindSajuDatetimes(ctx, SajuSearchParams{
+		Pattern:         input.Pattern,
+		From:            input.From,
+		To:              input.To,
+		TzOffsetMinutes: input.TzOffsetMinutes,
+		ZishiConvention: utils.PtrToStr(input.ZishiConvention),
+		Limit:           limit,
+	})
+	if err != nil {
+		return sajuErrResult(err), nil
+	}
+	jsonBytes, err := json.Marshal(map[string]any{"windows": windows, "truncated": truncated})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal search result: %w", err)
+	}
+	total := len(windows)
+	return &model.SimpleResult{Ok: true, Value: utils.StrPtr(string(jsonBytes)), Total: &total}, nil
+}
+
+func parseSearchLocalTs(v string, tzOffsetMinutes int) (int64, error) {
+	for _, layout := range []string{"2006-01-02", "2006-01-02T15:04", "2006-01-02 15:04", "20060102", "200601021504"} {
+		if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+			return ttycdom.ToUnixTimestamp(ttycdom.TtycTimestampParts{
+				Year: t.Year(), Month: int(t.Month()), Day: t.Day(), Hour: t.Hour(), Minute: t.Minute(),
+			}, &tzOffsetMinutes)
+		}
+	}
+	return 0, fmt.Errorf("unsupported format: %q", v)
+}
+
+func formatSearchLocal(p ttycdom.TtycLocalDateTimeParts) string {
+	return fmt.Sprintf("%04d-%02d-%02dT%02d:%02d", p.Year, p.Month, p.Day, p.Hour, p.Minute)
+}
@@ -0,0 +1,73 @@
+// Package itemncard: 일운 (daily) items/tokens — 일진 vs 원국 (scope "daily" cards).
+package itemncard
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"sajudating_api/api/config"
+	"sajudating_api/api/dao"
+	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/domain"
+	ttycdom "sajudating_api/api/domain/ttyc"
+	itemncardtypes "sajudating_api/api/types/itemncard"
+)
+
+// DailyItems builds 일운 items for one day: 일진 천간/지지 십성·십이운성 (일간 기준), 일진 지지와 원국 지지의 관계, 신살.
+// day is the 일진 meta from ttyc.GanjiMetaOf(..., 원국 일간).
+func DailyItems(natal itemncardtypes.PillarsText, day ttycdom.TtycGanjiMeta) []itemncardtypes.Item {
+	items := []itemncardtypes.Item{
+		{K: "일운", N: day.GanjiKo, W: 60},
+		{K: "일운십성", N: string(day.StemTenGod), Where: []string{"천간"}, W: 70},
+		{K: "일운십성", N: string(day.BranchTenGod), Where: []string{"지지"}, W: 70},
+		{K: "일운운성", N: string(day.BranchTwelve), W: 70},
+	}
+
+	stemPos := []string{"년간", "월간", "일간", "시간"}
+	branchPos := []string{"년지", "월지", "일지", "시지"}
+	for i, p := range []string{natal.Year, natal.Month, natal.Day, natal.Hour} {
+		if p == "" {
+			continue
+		}
+		tg, dz, ok := pillarToIndices(p)
+		if !ok {
+			continue
+		}
+		// 관계: SajuDoc 엣지와 같은 규칙 (domain.StemRelations/BranchRelations)
+		for _, t := range domain.StemRelations(domain.StemId(day.Stem), domain.StemId(tg)) {
+			if name, ok := relationName(t, "STEM"); ok {
+				items = append(items, itemncardtypes.Item{K: "일운관계", N: name, Where: []string{stemPos[i]}, W: relationW[name]})
+			}
+		}
+		for _, t := range domain.BranchRelations(domain.BranchId(day.Branch), domain.BranchId(dz)) {
+			if name, ok := relationName(t, "BRANCH"); ok {
+				items = append(items, itemncardtypes.Item{K: "일운관계", N: name, Where: []string{branchPos[i]}, W: relationW[name]})
+			}
+		}
+	}
+
+	// 신살: 일진 지지 기준 (domain 신살 룰팩, SIMPLE 유파 — 원국 일간·일주 공망 포함)
+	if natalRaw, ok := rawPillarsOf(natal); ok {
+		for _, name := range domain.SinsalOfBranch(domain.SinsalSysSimple, natalRaw, domain.BranchId(day.Branch)) {
+			items = append(items, itemncardtypes.Item{K: "일운신살", N: name, W: sinsalW(name), Sys: strings.ToLower(domain.SinsalSysSimple)})
+		}
+	}
+	return items
+}
+
+// LoadDailyCards returns published daily cards (seed directory when ENV=dev, falling back to DB).
+func LoadDailyCards() ([]entity.ItemNCard, error) {
+	if dao.GetDB() == nil {
+		return nil, fmt.Errorf("MongoDB not configured (e.g. in tests); daily card selection requires DB or seed")
+	}
+	if config.IsDev() {
+		seedDir := GetSeedDir()
+		cards, err := LoadSeedCardsByScope(seedDir, "daily")
+		if err == nil {
+			return cards, nil
+		}
+		log.Printf("[itemncard] seed load daily failed (dir=%s): %v; falling back to DB", seedDir, err)
+	}
+	return dao.NewItemNCardRepository().ListPublishedByScope("daily")
+}
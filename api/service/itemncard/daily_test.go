@@ -0,0 +1,65 @@
+// Package itemncard: tests for 일운 (daily) items/tokens and daily card selection.
+package itemncard
+
+import (
+	"testing"
+
+	"sajudating_api/api/dao/entity"
+	ttycdom "sajudating_api/api/domain/ttyc"
+	itemncardtypes "sajudating_api/api/types/itemncard"
+)
+
+func dailyTokenSet(t *testing.T, natal itemncardtypes.PillarsText, stem, branch, dayMaster int) map[string]bool {
+	t.Helper()
+	meta, err := ttycdom.GanjiMetaOf(stem, branch, dayMaster)
+	if err != nil {
+		t.Fatalf("GanjiMetaOf: %v", err)
+	}
+	set := map[string]bool{}
+	for _, tok := range ItemsToTokens(DailyItems(natal, meta)) {
+		set[tok] = true
+	}
+	return set
+}
+
+func TestDailyItems(t *testing.T) {
+	natal := itemncardtypes.PillarsText{Year: "경오", Month: "기축", Day: "갑자", Hour: "병인"}
+
+	// 庚午일 (甲 일간): 천간 편관, 지지 식신(ttyc 지지 음양은 index 기준), 午 = 甲의 사지, 子午충@일지, 丑午해@월지, 午 도화
+	set := dailyTokenSet(t, natal, 6, 6, 0)
+	for _, want := range []string{
+		"일운:경오",
+		"일운십성:편관@천간",
+		"일운십성:식신@지지",
+		"일운운성:사",
+		"일운관계:충@일지",
+		"일운관계:해@월지",
+		"일운신살:도화",
+	} {
+		if !set[want] {
+			t.Errorf("missing token %q", want)
+		}
+	}
+	if set["일운신살:공망"] {
+		t.Error("庚午 is not 공망 for 甲子 일주")
+	}
+
+	// 甲戌일: 甲子순 공망(戌亥) 에 해당
+	set = dailyTokenSet(t, natal, 0, 10, 0)
+	if !set["일운신살:공망"] || !set["일운십성:비견@천간"] {
+		t.Errorf("甲戌 tokens missing 공망/비견: %v", set)
+	}
+}
+
+func TestDailyCardSelection(t *testing.T) {
+	natal := itemncardtypes.PillarsText{Year: "경오", Month: "기축", Day: "갑자"}
+	set := dailyTokenSet(t, natal, 6, 6, 0)
+	cards := []entity.ItemNCard{
+		{CardID: "일운_충_일지_v1", Scope: "daily", TriggerJSON: `{"all":[{"token":"일운관계:충@일지"}]}`, Priority: 10},
+		{CardID: "일운_정재_v1", Scope: "daily", TriggerJSON: `{"all":[{"token":"일운십성:정재@천간"}]}`, Priority: 10},
+	}
+	selected, evidences, _ := SelectSajuCardsFromCards(cards, set, DefaultMaxPerDomain, 0)
+	if len(selected) != 1 || selected[0].CardID != "일운_충_일지_v1" || len(evidences[0]) != 1 {
+		t.Fatalf("selected = %+v", selected)
+	}
+}
@@ -1,4 +1,4 @@
-// Package itemncard: pair (궁합) pipeline (A/B items → P_items → P_tokens, pair card trigger).
+// Package itemncard: pair (궁합) pipeline (A/B SajuDoc → PairDoc → P_items → P_tokens, pair card trigger).
 package itemncard
 
 import (
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"time"
 
 	"sajudating_api/api/config"
 	"sajudating_api/api/dao"
 	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/domain"
 	itemncardtypes "sajudating_api/api/types/itemncard"
 )
 
@@ -26,50 +28,60 @@ type PairTriggerRule struct {
 	Not []PairTriggerCondition `json:"not,omitempty"`
 }
 
-// pairWhere returns "A.<pos>-B.<pos>" (A first per ChemiStructure).
-func pairWhere(pos string) string {
-	return "A." + pos + "-B." + pos
+// pairWhere returns "A.<posA>-B.<posB>" (A first per ChemiStructure).
+func pairWhere(posA, posB string) string {
+	return "A." + posA + "-B." + posB
 }
 
-// PItemsFromPillars builds pair items from A/B pillars: 충/합/형/해/천간합/삼합 with where A.<pos>-B.<pos>.
-func PItemsFromPillars(pillarsA, pillarsB itemncardtypes.PillarsText) []itemncardtypes.Item {
-	var items []itemncardtypes.Item
-	stemPosNames := []string{"년간", "월간", "일간", "시간"}
-	branchPosNames := []string{"년지", "월지", "일지", "시지"}
-	pillarStrsA := []string{pillarsA.Year, pillarsA.Month, pillarsA.Day, pillarsA.Hour}
-	pillarStrsB := []string{pillarsB.Year, pillarsB.Month, pillarsB.Day, pillarsB.Hour}
+// PItemsFromPillars builds pair items from A/B pillars via PairDoc (PItemsFromPairDoc).
+func PItemsFromPillars(pillarsA, pillarsB itemncardtypes.PillarsText) ([]itemncardtypes.Item, error) {
+	_, _, pItems, err := PairItemsFromPillars(pillarsA, pillarsB)
+	return pItems, err
+}
 
-	for posIdx := 0; posIdx < 4; posIdx++ {
-		if posIdx >= len(pillarStrsA) || posIdx >= len(pillarStrsB) {
-			break
-		}
-		tgA, dzA, okA := pillarToIndices(pillarStrsA[posIdx])
-		tgB, dzB, okB := pillarToIndices(pillarStrsB[posIdx])
-		if !okA || !okB {
-			continue
-		}
-		stemPos := stemPosNames[posIdx]
-		branchPos := branchPosNames[posIdx]
+// PairItemsFromPillars builds A/B items and pair items from the same A/B SajuDoc (→ PairDoc).
+func PairItemsFromPillars(pillarsA, pillarsB itemncardtypes.PillarsText) (itemsA, itemsB, pItems []itemncardtypes.Item, err error) {
+	docA, err := SajuDocFromPillars(pillarsA)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("A: %w", err)
+	}
+	docB, err := SajuDocFromPillars(pillarsB)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("B: %w", err)
+	}
+	doc, err := domain.BuildPairDocAt(domain.PairInput{}, docA, docB, time.Unix(0, 0).UTC())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return ItemsFromSajuDoc(docA), ItemsFromSajuDoc(docB), PItemsFromPairDoc(doc), nil
+}
 
-		// 천간합 (stem-stem at same position)
-		if tgPairHe(tgA, tgB) {
-			items = append(items, itemncardtypes.Item{K: "궁합", N: "천간합", Where: []string{pairWhere(stemPos)}, W: 75, Sys: "pair_v1"})
-		}
-		// 충/합/형/해/삼합 (branch-branch at same position)
-		if dzChong(dzA, dzB) {
-			items = append(items, itemncardtypes.Item{K: "궁합", N: "충", Where: []string{pairWhere(branchPos)}, W: 90, Sys: "pair_v1"})
-		}
-		if dzHe(dzA, dzB) {
-			items = append(items, itemncardtypes.Item{K: "궁합", N: "합", Where: []string{pairWhere(branchPos)}, W: 75, Sys: "pair_v1"})
-		}
-		if dzHyung(dzA, dzB) {
-			items = append(items, itemncardtypes.Item{K: "궁합", N: "형", Where: []string{pairWhere(branchPos)}, W: 70, Sys: "pair_v1"})
+// PItemsFromPairDoc projects PairDoc 교차 관계 엣지 to pair items: 천간합/충/합/형/해/파/삼합 with where A.<pos>-B.<pos>.
+func PItemsFromPairDoc(doc *domain.PairDoc) []itemncardtypes.Item {
+	var items []itemncardtypes.Item
+	if doc.Charts != nil && doc.Charts.A != nil && doc.Charts.B != nil {
+		nodesA := make(map[domain.NodeId]domain.Node, len(doc.Charts.A.Nodes))
+		for _, n := range doc.Charts.A.Nodes {
+			nodesA[n.ID] = n
 		}
-		if dzHae(dzA, dzB) {
-			items = append(items, itemncardtypes.Item{K: "궁합", N: "해", Where: []string{pairWhere(branchPos)}, W: 70, Sys: "pair_v1"})
+		nodesB := make(map[domain.NodeId]domain.Node, len(doc.Charts.B.Nodes))
+		for _, n := range doc.Charts.B.Nodes {
+			nodesB[n.ID] = n
 		}
-		if dzSamhap(dzA, dzB) {
-			items = append(items, itemncardtypes.Item{K: "궁합", N: "삼합", Where: []string{pairWhere(branchPos)}, W: 80, Sys: "pair_v1"})
+		for _, e := range doc.Edges {
+			if e.Active != nil && !*e.Active {
+				continue
+			}
+			a, okA := nodesA[e.A]
+			b, okB := nodesB[e.B]
+			if !okA || !okB {
+				continue
+			}
+			name, ok := relationName(e.T, a.Kind)
+			if !ok {
+				continue
+			}
+			items = append(items, itemncardtypes.Item{K: "궁합", N: name, Where: []string{pairWhere(nodePos(a), nodePos(b))}, W: relationW[name], Sys: "pair_v1"})
 		}
 	}
 	// 확신 fallback so pair trigger can still match
@@ -2,16 +2,22 @@
 package itemncard
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/domain"
 	itemncardtypes "sajudating_api/api/types/itemncard"
 )
 
 func TestPItemsFromPillars(t *testing.T) {
 	pillarsA := itemncardtypes.PillarsText{Year: "경오", Month: "기축", Day: "갑자", Hour: "병인"}
 	pillarsB := itemncardtypes.PillarsText{Year: "경오", Month: "기축", Day: "갑자", Hour: "병인"}
-	items := PItemsFromPillars(pillarsA, pillarsB)
+	items, err := PItemsFromPillars(pillarsA, pillarsB)
+	if err != nil {
+		t.Fatalf("PItemsFromPillars: %v", err)
+	}
 	if len(items) == 0 {
 		t.Fatal("PItemsFromPillars expected non-empty when A==B (same pillars → 충/합/형/해 at same pos)")
 	}
@@ -31,6 +37,69 @@ func TestPItemsFromPillars(t *testing.T) {
 	}
 }
 
+func TestPItemsFromPairDoc_Golden(t *testing.T) {
+	got := make(map[string][]string)
+	for i := 0; i+1 < len(goldenSajuCharts); i++ {
+		a, b := goldenSajuCharts[i], goldenSajuCharts[i+1]
+		items, err := PItemsFromPillars(a, b)
+		if err != nil {
+			t.Fatalf("PItemsFromPillars: %v", err)
+		}
+		got[pillarsKey(a)+" / "+pillarsKey(b)] = ItemsToTokens(items)
+	}
+	checkGolden(t, "pair_tokens.json", got)
+}
+
+// TestPItemsFromPairDoc_AgreesWithDoc: 궁합 토큰과 PairDoc 교차 엣지가 양방향으로 일치해야 한다.
+func TestPItemsFromPairDoc_AgreesWithDoc(t *testing.T) {
+	charts := sweepPillars(200)
+	for i := 0; i+1 < len(charts); i++ {
+		docA, errA := SajuDocFromPillars(charts[i])
+		docB, errB := SajuDocFromPillars(charts[i+1])
+		if errA != nil || errB != nil {
+			t.Fatalf("SajuDocFromPillars: %v / %v", errA, errB)
+		}
+		doc, err := domain.BuildPairDocAt(domain.PairInput{}, docA, docB, time.Unix(0, 0).UTC())
+		if err != nil {
+			t.Fatalf("BuildPairDocAt: %v", err)
+		}
+		nodesA, nodesB := make(map[domain.NodeId]domain.Node), make(map[domain.NodeId]domain.Node)
+		for _, n := range docA.Nodes {
+			nodesA[n.ID] = n
+		}
+		for _, n := range docB.Nodes {
+			nodesB[n.ID] = n
+		}
+		want := make(map[string]bool)
+		for _, e := range doc.Edges {
+			name, ok := docRelationKo[e.T]
+			if !ok {
+				continue
+			}
+			if nodesA[e.A].Kind == "STEM" {
+				name = "천간합"
+			}
+			want["궁합:"+name+"@A."+docNodePos(nodesA[e.A])+"-B."+docNodePos(nodesB[e.B])] = true
+		}
+		got := make(map[string]bool)
+		for _, tok := range ItemsToTokens(PItemsFromPairDoc(doc)) {
+			if strings.Contains(tok, "@") && !strings.Contains(tok, "#") {
+				got[tok] = true
+			}
+		}
+		for tok := range want {
+			if !got[tok] {
+				t.Errorf("%s / %s: pair doc has %s but tokens do not", pillarsKey(charts[i]), pillarsKey(charts[i+1]), tok)
+			}
+		}
+		for tok := range got {
+			if !want[tok] {
+				t.Errorf("%s / %s: token %s has no pair edge", pillarsKey(charts[i]), pillarsKey(charts[i+1]), tok)
+			}
+		}
+	}
+}
+
 func TestEvaluatePairTrigger_Empty(t *testing.T) {
 	aSet := map[string]bool{}
 	bSet := map[string]bool{}
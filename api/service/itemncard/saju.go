@@ -1,17 +1,21 @@
-// Package itemncard: saju pipeline (pillars → items → tokens → card trigger evaluation).
+// Package itemncard: saju pipeline (pillars → SajuDoc → items → tokens → card trigger evaluation).
 package itemncard
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"sajudating_api/api/config"
 	"sajudating_api/api/dao"
 	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/domain"
 	extdao "sajudating_api/api/ext_dao"
 	itemncardtypes "sajudating_api/api/types/itemncard"
 	"sajudating_api/api/utils"
@@ -26,14 +30,18 @@ func PillarsFromBirth(year, month, day int, hour, min *int, timezone string) (pi
 	if err != nil {
 		return itemncardtypes.PillarsText{}, "", err
 	}
+	return PillarsTextOf(res), res.GetPalja(), nil
+}
+
+// PillarsTextOf returns the sxtwl pillars as Korean strings (시주 없으면 Hour 빈 값).
+func PillarsTextOf(res *extdao.SxtwlResult) (pillars itemncardtypes.PillarsText) {
 	pillars.Year = utils.TG_ARRAY[res.Pillars.Year.Tg] + utils.DZ_ARRAY[res.Pillars.Year.Dz]
 	pillars.Month = utils.TG_ARRAY[res.Pillars.Month.Tg] + utils.DZ_ARRAY[res.Pillars.Month.Dz]
 	pillars.Day = utils.TG_ARRAY[res.Pillars.Day.Tg] + utils.DZ_ARRAY[res.Pillars.Day.Dz]
 	if res.Pillars.Hour != nil {
 		pillars.Hour = utils.TG_ARRAY[res.Pillars.Hour.Tg] + utils.DZ_ARRAY[res.Pillars.Hour.Dz]
 	}
-	palja = res.GetPalja()
-	return pillars, palja, nil
+	return pillars
 }
 
 // pillarToIndices returns (TG index, DZ index) for a two-rune pillar string (e.g. "경오"). ok false if not found.
@@ -42,339 +50,232 @@ func pillarToIndices(pillar string) (tgIdx, dzIdx int, ok bool) {
 	if len(runes) < 2 {
 		return 0, 0, false
 	}
-	tgStr := string(runes[0])
-	dzStr := string(runes[1])
+	tgIdx, dzIdx = -1, -1
 	for i, tg := range utils.TG_ARRAY {
-		if tg == tgStr {
+		if tg == string(runes[0]) {
 			tgIdx = i
 			break
 		}
 	}
 	for i, dz := range utils.DZ_ARRAY {
-		if dz == dzStr {
+		if dz == string(runes[1]) {
 			dzIdx = i
-			return tgIdx, dzIdx, true
+			break
 		}
 	}
-	return 0, 0, false
+	if tgIdx < 0 || dzIdx < 0 {
+		return 0, 0, false
+	}
+	return tgIdx, dzIdx, true
 }
 
-// relationPair normalizes pillar pair (i,j) to "posI-posJ" with 년→월→일→시 order (smaller index first).
-func relationPair(posNames []string, i, j int) string {
-	if i <= j {
-		return posNames[i] + "-" + posNames[j]
-	}
-	return posNames[j] + "-" + posNames[i]
+// pillarPosPrefix: SajuDoc 기둥 키 → 위치 이름 접두 (년간/년지 …)
+var pillarPosPrefix = map[domain.PillarKey]string{"Y": "년", "M": "월", "D": "일", "H": "시"}
+
+// relationNames: SajuDoc 관계 엣지 타입 → 관계 item 이름 (천간 HE 는 "천간합"). PILLAR/HIDDEN 구조 엣지는 없음.
+var relationNames = map[domain.RelType]string{
+	"CHONG":  "충",
+	"HE":     "합",
+	"HYUNG":  "형",
+	"HAE":    "해",
+	"PO":     "파",
+	"SAMHAP": "삼합",
 }
 
-// ItemsFromPillars builds items from pillars + palja (십성, 오행, 관계, 확신).
-func ItemsFromPillars(pillars itemncardtypes.PillarsText, palja string) []itemncardtypes.Item {
-	var items []itemncardtypes.Item
-	posNames := []string{"년간", "년지", "월간", "월지", "일간", "일지", "시간", "시지"}
-	stemPosNames := []string{"년간", "월간", "일간", "시간"}
-	branchPosNames := []string{"년지", "월지", "일지", "시지"}
-
-	tenStemsStr := utils.CalculateTenStems(palja)
-	parts := strings.Fields(tenStemsStr)
-	for i, part := range parts {
-		if part == "본원" || i >= len(posNames) {
-			continue
-		}
-		items = append(items, itemncardtypes.Item{
-			K:     "십성",
-			N:     part,
-			Where: []string{posNames[i]},
-			W:     70,
-		})
-	}
-	// 오행 from day stem
-	runes := []rune(palja)
-	feIdx := 0
-	if len(runes) >= 5 {
-		dayStem := string(runes[4])
-		for i, tg := range utils.TG_ARRAY {
-			if tg == dayStem {
-				feIdx = utils.TG_FE_INDEXES[i]
-				break
-			}
-		}
-		items = append(items, itemncardtypes.Item{
-			K: "오행",
-			N: utils.FIVE_ELEMENTS[feIdx],
-			W: 70,
-		})
-	}
+// relationW: 관계 item 가중치 (원국·궁합·일운 공통)
+var relationW = map[string]int{"천간합": 75, "충": 90, "합": 75, "형": 70, "해": 70, "파": 65, "삼합": 80}
 
-	// 관계: from pillars compute 천간합( stems ), 충/합/형/파/해/삼합( branches )
-	pillarStrs := []string{pillars.Year, pillars.Month, pillars.Day, pillars.Hour}
-	var stemIdxs, branchIdxs []int
-	var stemPosUsed, branchPosUsed []string
-	for i, p := range pillarStrs {
-		if p == "" {
-			continue
-		}
-		tg, dz, ok := pillarToIndices(p)
-		if !ok {
-			continue
-		}
-		if i < len(stemPosNames) {
-			stemPosUsed = append(stemPosUsed, stemPosNames[i])
-			stemIdxs = append(stemIdxs, tg)
-		}
-		if i < len(branchPosNames) {
-			branchPosUsed = append(branchPosUsed, branchPosNames[i])
-			branchIdxs = append(branchIdxs, dz)
-		}
+// relationName returns the item name of a relation edge between nodes of kind; ok false for non-relation edges.
+func relationName(t domain.RelType, kind domain.NodeKind) (string, bool) {
+	name, ok := relationNames[t]
+	if !ok {
+		return "", false
 	}
-	// 천간합 (TG 五合): pair of stems
-	for i := 0; i < len(stemIdxs); i++ {
-		for j := i + 1; j < len(stemIdxs); j++ {
-			if tgPairHe(stemIdxs[i], stemIdxs[j]) {
-				where := relationPair(stemPosUsed, i, j)
-				items = append(items, itemncardtypes.Item{K: "관계", N: "천간합", Where: []string{where}, W: 75})
-			}
-		}
-	}
-	// 지지 관계: 충/합/형/파/해/삼합
-	for i := 0; i < len(branchIdxs); i++ {
-		for j := i + 1; j < len(branchIdxs); j++ {
-			a, b := branchIdxs[i], branchIdxs[j]
-			where := relationPair(branchPosUsed, i, j)
-			if dzChong(a, b) {
-				items = append(items, itemncardtypes.Item{K: "관계", N: "충", Where: []string{where}, W: 90})
-			}
-			if dzHe(a, b) {
-				items = append(items, itemncardtypes.Item{K: "관계", N: "합", Where: []string{where}, W: 75})
-			}
-			if dzHyung(a, b) {
-				items = append(items, itemncardtypes.Item{K: "관계", N: "형", Where: []string{where}, W: 70})
-			}
-			if dzHae(a, b) {
-				items = append(items, itemncardtypes.Item{K: "관계", N: "해", Where: []string{where}, W: 70})
-			}
-			if dzSamhap(a, b) {
-				items = append(items, itemncardtypes.Item{K: "관계", N: "삼합", Where: []string{where}, W: 80})
-			}
-		}
+	if kind == "STEM" {
+		return "천간합", t == "HE"
 	}
+	return name, true
+}
 
-	// 신살: 도화(子午卯酉), 역마(寅申巳亥) etc. per rule_set
-	for i, dzIdx := range branchIdxs {
-		if i >= len(branchPosUsed) {
-			break
-		}
-		pos := branchPosUsed[i]
-		if sinsalDoHwa(dzIdx) {
-			items = append(items, itemncardtypes.Item{K: "신살", N: "도화", Where: []string{pos}, W: 70, Sys: "common_v1"})
-		}
-		if sinsalYeokMa(dzIdx) {
-			items = append(items, itemncardtypes.Item{K: "신살", N: "역마", Where: []string{pos}, W: 70, Sys: "common_v1"})
-		}
-		if sinsalCheonEul(dzIdx, stemIdxs) {
-			items = append(items, itemncardtypes.Item{K: "신살", N: "천을귀인", Where: []string{pos}, W: 75, Sys: "common_v1"})
-		}
+// nodePos returns the position name of a node (예: 월간, 일지; HIDDEN 은 소속 지지 위치).
+func nodePos(n domain.Node) string {
+	if n.Kind == "STEM" {
+		return pillarPosPrefix[n.Pillar] + "간"
 	}
+	return pillarPosPrefix[n.Pillar] + "지"
+}
 
-	// 지장간: hidden stems per pillar (본기 only for minimal set)
-	for i, dzIdx := range branchIdxs {
-		if i >= len(branchPosUsed) {
+// rawPillarsOf converts pillars text to domain.RawPillars (시주 없으면 Hour nil).
+func rawPillarsOf(pillars itemncardtypes.PillarsText) (domain.RawPillars, bool) {
+	var raw domain.RawPillars
+	for i, p := range []string{pillars.Year, pillars.Month, pillars.Day, pillars.Hour} {
+		if i == 3 && p == "" {
 			break
 		}
-		pos := branchPosUsed[i]
-		tgIdx := dzJangGanBongi(dzIdx)
-		if tgIdx >= 0 {
-			where := pos + ".지장간.본기"
-			items = append(items, itemncardtypes.Item{K: "지장간", N: utils.TG_ARRAY[tgIdx], Where: []string{where}, W: 70})
+		tg, dz, ok := pillarToIndices(p)
+		if !ok {
+			return domain.RawPillars{}, false
 		}
-	}
-
-	// 격국: from 월지 十神 (simple rule: 月支本气十神 → 격국). sys for future variants.
-	if len(parts) > 3 && parts[3] != "본원" {
-		gyeokName := parts[3] + "격"
-		items = append(items, itemncardtypes.Item{K: "격국", N: gyeokName, Where: []string{"월지"}, W: 70, Sys: "simple_month_stem_v1"})
-	}
-	// 용신: minimal placeholder = 日主 오행 (same as day stem element). sys for future variants.
-	if len(runes) >= 5 {
-		items = append(items, itemncardtypes.Item{K: "용신", N: utils.FIVE_ELEMENTS[feIdx], W: 70, Sys: "simple_day_stem_v1"})
-	}
-	// 강약: 月令 vs 日主 — same→신강, 月令克日主→신약, else 중화. sys for future variants.
-	if len(branchIdxs) > 1 {
-		monthDz := branchIdxs[1]
-		monthElemIdx := dzToFiveElement(monthDz)
-		strong := "중화"
-		wVal := 55
-		if monthElemIdx == feIdx {
-			strong = "신강"
-			wVal = 70
-		} else if monthKeDay(feIdx, monthElemIdx) {
-			strong = "신약"
-			wVal = 40
+		rp := domain.RawPillar{Stem: domain.StemId(tg), Branch: domain.BranchId(dz)}
+		switch i {
+		case 0:
+			raw.Year = rp
+		case 1:
+			raw.Month = rp
+		case 2:
+			raw.Day = rp
+		case 3:
+			raw.Hour = &rp
 		}
-		items = append(items, itemncardtypes.Item{K: "강약", N: strong, W: wVal, Sys: "simple_month_ling_v1"})
 	}
-
-	// 확신 placeholder
-	items = append(items, itemncardtypes.Item{K: "확신", N: "전체", W: 80})
-	return items
+	return raw, true
 }
 
-// dzToFiveElement maps DZ index (0-11) to 五行 index (0-4): 寅卯木, 巳午火, 申酉金, 亥子水, 辰戌丑未土.
-func dzToFiveElement(dzIdx int) int {
-	switch dzIdx {
-	case 2, 3:
-		return 0 // 寅卯 木
-	case 5, 6:
-		return 1 // 巳午 火
-	case 8, 9:
-		return 2 // 申酉 金
-	case 11, 0:
-		return 3 // 亥子 水
-	case 1, 4, 7, 10:
-		return 4 // 丑辰未戌 土
-	default:
-		return 4
+// SajuDocFromPillars builds the SajuDoc for pillars (시주 없으면 3주). 토큰은 이 문서에서만 투영한다.
+func SajuDocFromPillars(pillars itemncardtypes.PillarsText) (*domain.SajuDoc, error) {
+	raw, ok := rawPillarsOf(pillars)
+	if !ok {
+		return nil, fmt.Errorf("invalid pillars: %+v", pillars)
 	}
+	return domain.BuildSajuDocAt(domain.BirthInput{}, raw, time.Unix(0, 0).UTC())
 }
 
-// monthKeDay returns true when 月令 克 日主: 木克土(0克4), 土克水(4克3), 水克火(3克1), 火克金(1克2), 金克木(2克0).
-func monthKeDay(dayFeIdx, monthFeIdx int) bool {
-	return dayFeIdx == (monthFeIdx+4)%5
-}
-
-// sinsalDoHwa 桃花: 子午卯酉 (DZ indices 0, 6, 3, 9).
-func sinsalDoHwa(dzIdx int) bool {
-	return dzIdx == 0 || dzIdx == 6 || dzIdx == 3 || dzIdx == 9
-}
-
-// sinsalYeokMa 驿马: 寅申巳亥 (DZ indices 2, 8, 5, 11) — often evaluated at 日支.
-func sinsalYeokMa(dzIdx int) bool {
-	return dzIdx == 2 || dzIdx == 8 || dzIdx == 5 || dzIdx == 11
+// ItemsFromPillars builds items from pillars via SajuDocFromPillars + ItemsFromSajuDoc.
+func ItemsFromPillars(pillars itemncardtypes.PillarsText) ([]itemncardtypes.Item, error) {
+	doc, err := SajuDocFromPillars(pillars)
+	if err != nil {
+		return nil, err
+	}
+	return ItemsFromSajuDoc(doc), nil
 }
 
-// sinsalCheonEul 天乙贵人: simplified — day stem 甲戊见丑未, etc. We use day stem (index 2 in stemIdxs) and current branch.
-func sinsalCheonEul(dzIdx int, stemIdxs []int) bool {
-	if len(stemIdxs) < 3 {
-		return false
-	}
-	dayStem := stemIdxs[2] // 일간
-	// 甲戊见丑未, 乙己见子申, 丙丁见亥酉, 庚辛见寅午, 壬癸见卯巳 (TG indices 0-9)
-	pairs := map[int][]int{
-		0: {1, 7}, 4: {1, 7},   // 甲戊 -> 丑未
-		1: {0, 8}, 5: {0, 8},   // 乙己 -> 子申
-		2: {11, 9}, 3: {11, 9}, // 丙丁 -> 亥酉
-		6: {2, 6}, 7: {2, 6},   // 庚辛 -> 寅午 (辛=7)
-		8: {3, 5}, 9: {3, 5},   // 壬癸 -> 卯巳
-	}
-	for _, b := range pairs[dayStem] {
-		if dzIdx == b {
-			return true
+// ItemsFromSajuDoc projects SajuDoc nodes/edges/facts/evals to items (십성, 오행, 관계, 신살, 지장간, 격국, 용신, 강약, 확신).
+func ItemsFromSajuDoc(doc *domain.SajuDoc) []itemncardtypes.Item {
+	var items []itemncardtypes.Item
+	nodes := make(map[domain.NodeId]domain.Node, len(doc.Nodes))
+	var dayStem *domain.Node
+	for i := range doc.Nodes {
+		n := doc.Nodes[i]
+		nodes[n.ID] = n
+		if n.Kind == "STEM" && n.Pillar == "D" {
+			dayStem = &doc.Nodes[i]
 		}
 	}
-	return false
-}
 
-// dzJangGanBongi returns TG index of 本气 for the given DZ index (0-11), or -1.
-func dzJangGanBongi(dzIdx int) int {
-	// 子癸, 丑己, 寅甲, 卯乙, 辰戊, 巳丙, 午丁, 未己, 申庚, 酉辛, 戌戊, 亥壬
-	table := []int{9, 5, 0, 1, 4, 2, 3, 5, 6, 8, 4, 8}
-	if dzIdx < 0 || dzIdx >= len(table) {
-		return -1
+	// 십성: 천간·지지 노드 (일간 제외)
+	for _, n := range doc.Nodes {
+		if n.TenGod == nil || n.Kind == "HIDDEN" || (n.Kind == "STEM" && n.Pillar == "D") {
+			continue
+		}
+		items = append(items, itemncardtypes.Item{K: "십성", N: n.TenGod.Ko(), Where: []string{nodePos(n)}, W: 70})
 	}
-	return table[dzIdx]
-}
-
-// tgPairHe returns true if the two stem indices form 天干五合 (甲己, 乙庚, 丙辛, 丁壬, 戊癸).
-func tgPairHe(i, j int) bool {
-	if i > j {
-		i, j = j, i
+	// 오행: 일간 오행
+	if dayStem != nil {
+		items = append(items, itemncardtypes.Item{K: "오행", N: dayStem.El.Ko(), W: 70})
 	}
-	pairs := [][2]int{{0, 5}, {1, 6}, {2, 7}, {3, 8}, {4, 9}}
-	for _, p := range pairs {
-		if i == p[0] && j == p[1] {
-			return true
+
+	// 관계: 작용하는 관계 엣지 (천간합, 충/합/형/해/파/삼합)
+	for _, e := range doc.Edges {
+		if e.Active != nil && !*e.Active {
+			continue
+		}
+		a, b := nodes[e.A], nodes[e.B]
+		name, ok := relationName(e.T, a.Kind)
+		if !ok {
+			continue
 		}
+		where := NormalizeWhere(nodePos(a) + "-" + nodePos(b))
+		items = append(items, itemncardtypes.Item{K: "관계", N: name, Where: []string{where}, W: relationW[name]})
 	}
-	return false
-}
 
-// dzChong 六冲: 子午, 丑未, 寅申, 卯酉, 辰戌, 巳亥 (indices 0-11).
-func dzChong(i, j int) bool {
-	if i > j {
-		i, j = j, i
-	}
-	pairs := [][2]int{{0, 6}, {1, 7}, {2, 8}, {3, 9}, {4, 10}, {5, 11}}
-	for _, p := range pairs {
-		if i == p[0] && j == p[1] {
-			return true
+	// 신살: SajuDoc SINSAL 팩트 (유파는 Evidence.Sys)
+	for _, f := range doc.Facts {
+		if f.K != domain.FactSinsal {
+			continue
+		}
+		var where []string
+		for _, id := range f.Refs {
+			if n := nodes[id]; n.Kind == "BRANCH" && sinsalTargetPillar(f, n.Pillar) {
+				where = append(where, nodePos(n))
+			}
 		}
+		item := itemncardtypes.Item{K: "신살", N: f.N, W: sinsalW(f.N), Sys: strings.ToLower(f.Evidence.Sys)}
+		if len(where) > 0 {
+			item.Where = []string{NormalizeWhere(strings.Join(where, "-"))}
+		}
+		items = append(items, item)
 	}
-	return false
-}
 
-// dzHe 六合: 子丑, 寅亥, 卯戌, 辰酉, 巳申, 午未.
-func dzHe(i, j int) bool {
-	if i > j {
-		i, j = j, i
-	}
-	pairs := [][2]int{{0, 1}, {2, 11}, {3, 10}, {4, 9}, {5, 8}, {6, 7}}
-	for _, p := range pairs {
-		if i == p[0] && j == p[1] {
-			return true
+	// 지장간: HIDDEN 노드 중 본기 (Idx 0)
+	for _, n := range doc.Nodes {
+		if n.Kind != "HIDDEN" || n.Idx == nil || *n.Idx != 0 || n.Stem == nil {
+			continue
 		}
+		items = append(items, itemncardtypes.Item{K: "지장간", N: domain.StemKo(*n.Stem), Where: []string{nodePos(n) + ".지장간.본기"}, W: 70})
 	}
-	return false
-}
 
-// dzHyung 刑: 寅巳申, 丑戌未, 子卯, and self (辰辰等).
-func dzHyung(i, j int) bool {
-	if i > j {
-		i, j = j, i
+	// 격국: GYEOKGUK 평가 결론 (정격은 월지 자리, w 는 후보 점수)
+	for _, e := range doc.Evals {
+		if e.K != domain.EvalGyeokguk {
+			continue
+		}
+		item := itemncardtypes.Item{K: "격국", N: e.N, W: int(math.Round(e.Score.Total)), Sys: evalSys(e)}
+		if v, ok := e.V.(map[string]any); ok && v["type"] == domain.GyeokgukTypeNormal {
+			item.Where = []string{"월지"}
+		}
+		items = append(items, item)
 	}
-	triples := [][3]int{{2, 5, 8}, {1, 7, 10}}
-	for _, t := range triples {
-		if (i == t[0] && j == t[1]) || (i == t[0] && j == t[2]) || (i == t[1] && j == t[2]) {
-			return true
+	// 용신: YONGSIN 평가 오행 (sys 는 억부·조후·통관·병약 방법)
+	for _, e := range doc.Evals {
+		if e.K != domain.EvalYongsin {
+			continue
 		}
+		v, _ := e.V.(map[string]any)
+		el, _ := v["element"].(string)
+		method, _ := v["method"].(string)
+		items = append(items, itemncardtypes.Item{K: "용신", N: domain.FiveEl(el).Ko(), W: int(math.Round(e.Score.Total)), Sys: strings.ToLower(method)})
 	}
-	if (i == 0 && j == 3) || (i == 3 && j == 0) {
-		return true
+	// 강약: STRENGTH 평가 7단계 (극신약…극신강), w 는 0~100 점수
+	for _, e := range doc.Evals {
+		if e.K == domain.EvalStrength {
+			items = append(items, itemncardtypes.Item{K: "강약", N: e.N, W: int(math.Round(e.Score.Total)), Sys: evalSys(e)})
+		}
 	}
-	return i == j && (i == 4 || i == 6 || i == 9 || i == 11)
+
+	// 확신 placeholder
+	items = append(items, itemncardtypes.Item{K: "확신", N: "전체", W: 80})
+	return items
+}
+
+// evalSys: 평가 룰 ID·버전으로 만든 item sys (예: rule.eval.gyeokguk v1 → gyeokguk_v1)
+func evalSys(e domain.EvalItem) string {
+	return strings.TrimPrefix(e.Evidence.RuleId, "rule.eval.") + "_" + e.Evidence.RuleVer
 }
 
-// dzHae 害: 子未, 丑午, 寅巳, 卯辰, 申亥, 酉戌.
-func dzHae(i, j int) bool {
-	if i > j {
-		i, j = j, i
+// sinsalTargetPillar reports whether pillar is a 자리 of the 신살 fact (기준 노드 제외).
+func sinsalTargetPillar(f domain.FactItem, pillar domain.PillarKey) bool {
+	v, ok := f.V.(map[string]any)
+	if !ok {
+		return false
 	}
-	pairs := [][2]int{{0, 7}, {1, 6}, {2, 5}, {3, 4}, {8, 11}, {9, 10}}
-	for _, p := range pairs {
-		if i == p[0] && j == p[1] {
+	pillars, _ := v["pillars"].([]string)
+	for _, p := range pillars {
+		if p == string(pillar) {
 			return true
 		}
 	}
 	return false
 }
 
-// dzSamhap 三合: 申子辰, 寅午戌, 亥卯未, 巳酉丑 (pairs within each triple).
-func dzSamhap(i, j int) bool {
-	if i > j {
-		i, j = j, i
+// sinsalW: 신살 item 가중치 (귀인 75, 공망 60, 그 외 70)
+func sinsalW(name string) int {
+	switch name {
+	case "천을귀인", "문창귀인":
+		return 75
+	case "공망":
+		return 60
 	}
-	triples := [][3]int{{8, 0, 4}, {2, 6, 10}, {11, 3, 7}, {5, 9, 1}}
-	for _, t := range triples {
-		count := 0
-		if i == t[0] || i == t[1] || i == t[2] {
-			count++
-		}
-		if j == t[0] || j == t[1] || j == t[2] {
-			count++
-		}
-		if count == 2 {
-			return true
-		}
-	}
-	return false
+	return 70
 }
 
 // NormalizeWhere sorts a relation "A-B" by position order (년→월→일→시).
@@ -479,18 +380,45 @@ func ComputeScore(tokenSet map[string]bool, scoreJSON string) int {
 	}
 	score := sr.Base
 	for _, b := range sr.BonusIf {
-		if tokenSet[b.Token] {
+		if hasToken(tokenSet, b.Token) {
 			score += b.Add
 		}
 	}
 	for _, p := range sr.PenaltyIf {
-		if tokenSet[p.Token] {
+		if hasToken(tokenSet, p.Token) {
 			score -= p.Sub
 		}
 	}
 	return score
 }
 
+// legacySys: ItemsFromPillars 시절의 ~sys 값 → 해당 카테고리 (k). 저장된 카드 트리거 호환용.
+// 당시 sys 는 카테고리마다 하나뿐이었으므로 ~sys 를 뗀 토큰과 같은 뜻이다.
+var legacySys = map[string]string{
+	"common_v1":            "신살",
+	"simple_month_stem_v1": "격국",
+	"simple_day_stem_v1":   "용신",
+	"simple_month_ling_v1": "강약",
+	"simple_weight_v1":     "강약",
+}
+
+// canonicalToken maps a legacy sys token (예: 신살:도화@일지~common_v1) to the token without ~sys; other tokens are returned as is.
+func canonicalToken(token string) string {
+	i := strings.LastIndex(token, "~")
+	if i < 0 {
+		return token
+	}
+	if k, ok := legacySys[token[i+1:]]; ok && strings.HasPrefix(token, k+":") {
+		return token[:i]
+	}
+	return token
+}
+
+// hasToken reports whether tokenSet contains token, accepting legacy sys tokens.
+func hasToken(tokenSet map[string]bool, token string) bool {
+	return tokenSet[token] || tokenSet[canonicalToken(token)]
+}
+
 // EvaluateSajuTrigger returns true if tokenSet satisfies the card trigger (not → skip; all; any).
 func EvaluateSajuTrigger(tokenSet map[string]bool, triggerJSON string) (pass bool, evidence []string) {
 	if triggerJSON == "" {
@@ -501,12 +429,12 @@ func EvaluateSajuTrigger(tokenSet map[string]bool, triggerJSON string) (pass boo
 		return false, nil
 	}
 	for _, c := range tr.Not {
-		if tokenSet[c.Token] {
+		if hasToken(tokenSet, c.Token) {
 			return false, nil
 		}
 	}
 	for _, c := range tr.All {
-		if !tokenSet[c.Token] {
+		if !hasToken(tokenSet, c.Token) {
 			return false, nil
 		}
 		evidence = append(evidence, c.Token)
@@ -514,7 +442,7 @@ func EvaluateSajuTrigger(tokenSet map[string]bool, triggerJSON string) (pass boo
 	if len(tr.Any) > 0 {
 		matched := false
 		for _, c := range tr.Any {
-			if tokenSet[c.Token] {
+			if hasToken(tokenSet, c.Token) {
 				matched = true
 				evidence = append(evidence, c.Token)
 			}
@@ -641,15 +569,21 @@ func SelectSajuCards(tokenSet map[string]bool) ([]entity.ItemNCard, [][]string,
 	return selected, evidences, scores, nil
 }
 
-// BirthInput parses YYYY-MM-DD and optional HH:mm.
+// ErrBirthDateFormat is returned by BirthInput / BirthInputCalendar for a date that is not YYYY-MM-DD.
+var ErrBirthDateFormat = errors.New("birth date must be YYYY-MM-DD")
+
+// BirthInput parses YYYY-MM-DD and optional HH:mm; a malformed date returns ErrBirthDateFormat.
 func BirthInput(dateStr, timeStr string) (y, m, d int, hh, mm *int, err error) {
 	parts := strings.Split(dateStr, "-")
 	if len(parts) != 3 {
-		return 0, 0, 0, nil, nil, nil
+		return 0, 0, 0, nil, nil, ErrBirthDateFormat
+	}
+	y, errY := strconv.Atoi(parts[0])
+	m, errM := strconv.Atoi(parts[1])
+	d, errD := strconv.Atoi(parts[2])
+	if errY != nil || errM != nil || errD != nil {
+		return 0, 0, 0, nil, nil, ErrBirthDateFormat
 	}
-	y, _ = strconv.Atoi(parts[0])
-	m, _ = strconv.Atoi(parts[1])
-	d, _ = strconv.Atoi(parts[2])
 	if timeStr != "" && timeStr != "unknown" {
 		ts := strings.Split(timeStr, ":")
 		if len(ts) >= 2 {
@@ -661,3 +595,16 @@ func BirthInput(dateStr, timeStr string) (y, m, d int, hh, mm *int, err error) {
 	}
 	return y, m, d, hh, mm, nil
 }
+
+// BirthInputCalendar is BirthInput for a date in calendar (solar | lunar); lunar dates are converted to solar.
+func BirthInputCalendar(dateStr, timeStr, calendar string, leapMonth bool) (y, m, d int, hh, mm *int, err error) {
+	y, m, d, hh, mm, err = BirthInput(dateStr, timeStr)
+	if err != nil {
+		return 0, 0, 0, nil, nil, err
+	}
+	y, m, d, err = extdao.ResolveSolarDate(calendar, leapMonth, y, m, d)
+	if err != nil {
+		return 0, 0, 0, nil, nil, err
+	}
+	return y, m, d, hh, mm, nil
+}
@@ -1,12 +1,20 @@
-// Package itemncard: tests for saju pipeline (relations, 신살, 지장간).
+// Package itemncard: tests for saju pipeline (relations, 신살, 지장간, SajuDoc projection).
 package itemncard
 
 import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"sajudating_api/api/dao/entity"
+	"sajudating_api/api/domain"
 	itemncardtypes "sajudating_api/api/types/itemncard"
+	"sajudating_api/api/utils"
 )
 
 func TestPillarToIndices(t *testing.T) {
@@ -31,8 +39,10 @@ func TestItemsFromPillars_Relations(t *testing.T) {
 		Day:   "갑자",
 		Hour:  "병인",
 	}
-	palja := "경오기축갑자병인"
-	items := ItemsFromPillars(pillars, palja)
+	items, err := ItemsFromPillars(pillars)
+	if err != nil {
+		t.Fatalf("ItemsFromPillars: %v", err)
+	}
 	var hasRelation bool
 	for _, it := range items {
 		if it.K == "관계" {
@@ -157,10 +167,51 @@ func TestComputeScore(t *testing.T) {
 	}
 }
 
+// TestSelectSajuCardsFromCards_LegacySysTokens: ItemsFromPillars 시절 ~sys 로 저장된 카드도 계속 매칭된다.
+func TestSelectSajuCardsFromCards_LegacySysTokens(t *testing.T) {
+	items, err := ItemsFromPillars(goldenSajuCharts[0]) // 경오 기축 갑자 병인
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenSet := make(map[string]bool)
+	for _, tok := range ItemsToTokens(items) {
+		tokenSet[tok] = true
+	}
+	cards := []entity.ItemNCard{
+		{
+			CardID: "legacy", Title: "Legacy", Priority: 50,
+			TriggerJSON: `{"all":[{"token":"신살:역마@시지~common_v1"},{"token":"격국:정재격~simple_month_stem_v1"},{"token":"용신:목~simple_day_stem_v1"}],` +
+				`"any":[{"token":"강약:신약~simple_month_ling_v1"},{"token":"강약:신약#L~simple_weight_v1"}]}`,
+			ScoreJSON: `{"base":40,"bonus_if":[{"token":"신살:천을귀인~common_v1","add":10}],"penalty_if":[{"token":"신살:도화~common_v1","sub":5}]}`,
+		},
+		{
+			CardID: "legacy_miss", Title: "Legacy miss", Priority: 50,
+			TriggerJSON: `{"all":[{"token":"신살:도화~common_v1"}]}`,
+		},
+		{
+			// 별칭은 원래 카테고리에만 적용
+			CardID: "legacy_wrong_k", Title: "Legacy wrong k", Priority: 50,
+			TriggerJSON: `{"all":[{"token":"십성:정재~common_v1"}]}`,
+		},
+	}
+	selected, evidences, scores := SelectSajuCardsFromCards(cards, tokenSet, 0, 0)
+	if len(selected) != 1 || selected[0].CardID != "legacy" {
+		t.Fatalf("selected = %v, want [legacy]", selected)
+	}
+	if len(evidences[0]) != 5 {
+		t.Errorf("evidence = %v, want 3 all + 2 any tokens", evidences[0])
+	}
+	if scores[0] != 50 {
+		t.Errorf("score = %d, want 50 (40+10)", scores[0])
+	}
+}
+
 func TestItemsFromPillars_GyeokYongStrong(t *testing.T) {
 	pillars := itemncardtypes.PillarsText{Year: "경오", Month: "기축", Day: "갑자", Hour: "병인"}
-	palja := "경오기축갑자병인"
-	items := ItemsFromPillars(pillars, palja)
+	items, err := ItemsFromPillars(pillars)
+	if err != nil {
+		t.Fatalf("ItemsFromPillars: %v", err)
+	}
 	var hasGyeok, hasYong, hasStrong bool
 	for _, it := range items {
 		if it.K == "격국" {
@@ -260,7 +311,7 @@ func TestSelectSajuCardsFromCards_EmptyTokenSet_ZeroCards(t *testing.T) {
 }
 
 // TestItemsFromPillars_YearMonthStyle verifies that pillars (e.g. 연도별/월별 歲運·月運 style) produce items and tokens.
-// Uses synthetic pillars/palja so the test does not depend on sxtwl; validates pillar→items→tokens path.
+// Uses synthetic pillars so the test does not depend on sxtwl; validates pillar→items→tokens path.
 func TestItemsFromPillars_YearMonthStyle(t *testing.T) {
 	pillars := itemncardtypes.PillarsText{
 		Year:  "갑진",
@@ -268,8 +319,10 @@ func TestItemsFromPillars_YearMonthStyle(t *testing.T) {
 		Day:   "경오",
 		Hour:  "신미",
 	}
-	palja := "갑진병인경오신미"
-	items := ItemsFromPillars(pillars, palja)
+	items, err := ItemsFromPillars(pillars)
+	if err != nil {
+		t.Fatalf("ItemsFromPillars: %v", err)
+	}
 	if len(items) == 0 {
 		t.Fatal("ItemsFromPillars expected non-empty items for year/month style pillars")
 	}
@@ -328,3 +381,160 @@ func TestSelectSajuCardsFromCards_SamePriorityAndScore_StableOrder(t *testing.T)
 		t.Errorf("expected stable order [card_a, card_m, card_z]; got %v", firstOrder)
 	}
 }
+
+var updateGolden = flag.Bool("update", false, "rewrite testdata/golden/*.json")
+
+// goldenSajuCharts: 토큰 golden 고정 명식 (시주 미상 포함)
+var goldenSajuCharts = []itemncardtypes.PillarsText{
+	{Year: "경오", Month: "기축", Day: "갑자", Hour: "병인"},
+	{Year: "갑진", Month: "병인", Day: "경오", Hour: "신미"},
+	{Year: "임자", Month: "임자", Day: "임자", Hour: "임자"},
+	{Year: "병오", Month: "갑오", Day: "병오", Hour: "갑오"},
+	{Year: "을해", Month: "무인", Day: "정유"},
+}
+
+func pillarsKey(p itemncardtypes.PillarsText) string {
+	return strings.TrimSpace(strings.Join([]string{p.Year, p.Month, p.Day, p.Hour}, " "))
+}
+
+// checkGolden compares got with testdata/golden/<name> (go test -update 로 갱신).
+func checkGolden(t *testing.T, name string, got map[string][]string) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name)
+	if *updateGolden {
+		b, err := json.MarshalIndent(got, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden: %v (run go test -update)", err)
+	}
+	var want map[string][]string
+	if err := json.Unmarshal(b, &want); err != nil {
+		t.Fatalf("decode golden: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("golden %s: %d charts, want %d", name, len(got), len(want))
+	}
+	for k, w := range want {
+		if g := got[k]; strings.Join(g, "|") != strings.Join(w, "|") {
+			t.Errorf("golden %s [%s]:\n got  %v\n want %v", name, k, g, w)
+		}
+	}
+}
+
+func TestItemsFromSajuDoc_Golden(t *testing.T) {
+	got := make(map[string][]string)
+	for _, p := range goldenSajuCharts {
+		items, err := ItemsFromPillars(p)
+		if err != nil {
+			t.Fatalf("ItemsFromPillars(%v): %v", p, err)
+		}
+		got[pillarsKey(p)] = ItemsToTokens(items)
+	}
+	checkGolden(t, "saju_tokens.json", got)
+}
+
+// docTenGodKo / docRelationKo: 문서 값 → 토큰 이름 (projection 과 독립적으로 기대값을 만든다)
+var docTenGodKo = map[domain.TenGod]string{
+	domain.BiGyeon: "비견", domain.GeobJae: "겁재", domain.SikShin: "식신", domain.SangGwan: "상관", domain.PyeonJae: "편재",
+	domain.JeongJae: "정재", domain.PyeonGwan: "편관", domain.JeongGwan: "정관", domain.PyeonIn: "편인", domain.JeongIn: "정인",
+}
+
+var docRelationKo = map[domain.RelType]string{"CHONG": "충", "HE": "합", "HYUNG": "형", "HAE": "해", "PO": "파", "SAMHAP": "삼합"}
+
+func docNodePos(n domain.Node) string {
+	suffix := "지"
+	if n.Kind == "STEM" {
+		suffix = "간"
+	}
+	return map[domain.PillarKey]string{"Y": "년", "M": "월", "D": "일", "H": "시"}[n.Pillar] + suffix
+}
+
+// sweepPillars returns n deterministic charts over the 60 간지 (5개 중 1개는 시주 미상).
+func sweepPillars(n int) []itemncardtypes.PillarsText {
+	ganji := func(i int) string {
+		i %= 60
+		return utils.TG_ARRAY[i%10] + utils.DZ_ARRAY[i%12]
+	}
+	out := make([]itemncardtypes.PillarsText, 0, n)
+	for i := 0; i < n; i++ {
+		p := itemncardtypes.PillarsText{Year: ganji(i), Month: ganji(i*7 + 3), Day: ganji(i*13 + 5), Hour: ganji(i*31 + 11)}
+		if i%5 == 0 {
+			p.Hour = ""
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+// TestItemsFromSajuDoc_AgreesWithDoc: 십성·관계·지장간 토큰과 SajuDoc 노드/엣지가 양방향으로 일치해야 한다.
+func TestItemsFromSajuDoc_AgreesWithDoc(t *testing.T) {
+	for _, p := range sweepPillars(600) {
+		doc, err := SajuDocFromPillars(p)
+		if err != nil {
+			t.Fatalf("SajuDocFromPillars(%v): %v", p, err)
+		}
+		want := make(map[string]bool)
+		nodes := make(map[domain.NodeId]domain.Node)
+		for _, n := range doc.Nodes {
+			nodes[n.ID] = n
+			switch {
+			case n.Kind == "HIDDEN" && *n.Idx == 0:
+				want[fmt.Sprintf("지장간:%s@%s.지장간.본기", utils.TG_ARRAY[*n.Stem], docNodePos(n))] = true
+			case n.Kind != "HIDDEN" && !(n.Kind == "STEM" && n.Pillar == "D"):
+				want["십성:"+docTenGodKo[*n.TenGod]+"@"+docNodePos(n)] = true
+			}
+		}
+		for _, e := range doc.Edges {
+			name, ok := docRelationKo[e.T]
+			if !ok {
+				continue
+			}
+			if nodes[e.A].Kind == "STEM" {
+				name = "천간합"
+			}
+			want["관계:"+name+"@"+docNodePos(nodes[e.A])+"-"+docNodePos(nodes[e.B])] = true
+		}
+
+		got := make(map[string]bool)
+		for _, tok := range ItemsToTokens(ItemsFromSajuDoc(doc)) {
+			if strings.Contains(tok, "@") && !strings.Contains(tok, "#") &&
+				(strings.HasPrefix(tok, "십성:") || strings.HasPrefix(tok, "관계:") || strings.HasPrefix(tok, "지장간:")) {
+				got[tok] = true
+			}
+		}
+		for tok := range want {
+			if !got[tok] {
+				t.Errorf("%s: doc has %s but tokens do not", pillarsKey(p), tok)
+			}
+		}
+		for tok := range got {
+			if !want[tok] {
+				t.Errorf("%s: token %s has no doc node/edge", pillarsKey(p), tok)
+			}
+		}
+	}
+}
+
+func TestBirthInputCalendar_Errors(t *testing.T) {
+	for _, date := range []string{"1990/05/14", "1990-5", "199x-05-14", ""} {
+		if y, _, _, _, _, err := BirthInputCalendar(date, "10:30", "lunar", false); !errors.Is(err, ErrBirthDateFormat) || y != 0 {
+			t.Errorf("BirthInputCalendar(%q) = %d, %v; want ErrBirthDateFormat", date, y, err)
+		}
+	}
+	// 평달 → 양력 변환, 없는 윤달은 에러
+	y, m, d, hh, _, err := BirthInputCalendar("2020-04-15", "10:30", "lunar", true)
+	if err != nil || y != 2020 || hh == nil || *hh != 10 {
+		t.Fatalf("BirthInputCalendar(2020 윤4월) = %d-%d-%d, %v", y, m, d, err)
+	}
+	if _, _, _, _, _, err := BirthInputCalendar("2020-02-15", "", "lunar", true); err == nil {
+		t.Fatal("expected error for missing leap month")
+	}
+}
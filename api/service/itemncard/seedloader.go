@@ -100,7 +100,7 @@ func seedToEntity(c *seedJSONShape) entity.ItemNCard {
 	}
 }
 
-// LoadSeedCardsByScope reads *.json from seedDir, filters by scope (saju_* -> saju, pair_* -> pair), and returns []entity.ItemNCard.
+// LoadSeedCardsByScope reads *.json from seedDir, filters by scope (saju_* -> saju, pair_* -> pair, daily_* -> daily), and returns []entity.ItemNCard.
 // Parse errors are logged and that file is skipped.
 func LoadSeedCardsByScope(seedDir string, scope string) ([]entity.ItemNCard, error) {
 	entries, err := os.ReadDir(seedDir)
@@ -113,6 +113,8 @@ func LoadSeedCardsByScope(seedDir string, scope string) ([]entity.ItemNCard, err
 		prefix = "saju_"
 	case "pair":
 		prefix = "pair_"
+	case "daily":
+		prefix = "daily_"
 	default:
 		return nil, nil
 	}
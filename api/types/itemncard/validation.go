@@ -38,8 +38,8 @@ type scoreShape struct {
 // ValidateCardPayload validates trigger (and optionally score/content) for the given scope.
 // Returns a short error message suitable for GraphQL/HTTP (e.g. "trigger.all[0]: missing token").
 func ValidateCardPayload(scope, triggerJSON, scoreJSON string) error {
-	if scope != "saju" && scope != "pair" {
-		return fmt.Errorf("scope must be saju or pair")
+	if scope != "saju" && scope != "pair" && scope != "daily" {
+		return fmt.Errorf("scope must be saju, pair or daily")
 	}
 	if err := validateTrigger(scope, triggerJSON); err != nil {
 		return err
@@ -25,6 +25,8 @@ func TestValidateCardPayload(t *testing.T) {
 		{"pair trigger missing token", "pair", `{"all":[],"any":[{"src":"P","token":""}],"not":[]}`, "{}", true, "missing token"},
 		{"pair trigger invalid src", "pair", `{"all":[],"any":[{"src":"X","token":"궁합:충"}],"not":[]}`, "{}", true, "src must be P, A, or B"},
 		{"pair trigger missing src", "pair", `{"all":[],"any":[{"token":"궁합:충"}],"not":[]}`, "{}", true, "src must be P, A, or B"},
+		{"valid daily trigger", "daily", `{"all":[{"token":"일운십성:정재@천간"}],"any":[],"not":[]}`, "{}", false, ""},
+		{"invalid scope", "other", "{}", "{}", true, "scope must be saju, pair or daily"},
 		{"invalid trigger JSON", "saju", `{all: no quotes}`, "{}", true, "invalid JSON"},
 		{"valid score", "saju", "{}", `{"base":50,"bonus_if":[{"token":"십성:정재#H","add":10}],"penalty_if":[]}`, false, ""},
 		{"score bonus_if missing token", "saju", "{}", `{"base":50,"bonus_if":[{"add":10}],"penalty_if":[]}`, true, "missing token"},
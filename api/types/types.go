@@ -25,20 +25,38 @@ type SajuProfile struct {
 	Uid       string     `json:"uid"`
 	CreatedAt int64      `json:"created_at,omitempty"`
 	Sex       string     `json:"sex,omitempty"`
-	Birthdate string     `json:"birthdate,omitempty"`
+	Birthdate string     `json:"birthdate,omitempty"` // 양력
 	Status    SajuStatus `json:"status,omitempty"`
 
+	Calendar       string `json:"calendar,omitempty"`        // SOLAR / LUNAR
+	LunarBirthdate string `json:"lunar_birthdate,omitempty"` // 음력 입력 원본
+	LeapMonth      bool   `json:"leap_month,omitempty"`      // 윤달 여부
+
 	Palja          string `json:"palja,omitempty"`            // 팔자
 	PaljaHanja     string `json:"palja_hanja,omitempty"`      // 팔자 한자
 	PaljaMainShape string `json:"palja_main_shape,omitempty"` // 팔자 일주 형상
 	// Image          string `json:"image,omitempty"`            // base64 encoded image 본인 이미지 전송불가 처리
 	PartnerImage string `json:"partner_image,omitempty"` // base64 encoded image
 
+	Progress SajuProgress `json:"progress"` // 단계별 진행상태 (Status 는 단계 상태에서 도출)
+
 	Nickname string          `json:"nickname,omitempty"` // saju
 	Saju     SajuContent     `json:"saju,omitempty"`     // saju
 	Kwansang KwansangContent `json:"kwansang,omitempty"` // kwansang
 }
 
+// 단계별 진행상태 - saju(사주) / phy(관상) / partner(이상형 파트너)
+type SajuProgress struct {
+	Saju    SajuStepStatus `json:"saju"`
+	Phy     SajuStepStatus `json:"phy"`
+	Partner SajuStepStatus `json:"partner"`
+}
+
+type SajuStepStatus struct {
+	Status    SajuStatus `json:"status"`
+	UpdatedAt int64      `json:"updated_at,omitempty"` // 마지막 상태 전이 시각 (unix ms)
+}
+
 type SajuContent struct {
 	Summary     string `json:"summary,omitempty"`
 	Content     string `json:"content,omitempty"`
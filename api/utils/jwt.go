@@ -14,6 +14,7 @@ import (
 type contextKey string
 
 const AdminUserContextKey contextKey = "admin_user_uid"
+const AdminUserRoleContextKey contextKey = "admin_user_role"
 
 type AdminTokenClaims struct {
 	Hashed string `json:"hashed"` // Encrypted JSON string containing {uid, sessionKey}
@@ -113,3 +114,13 @@ func SetAdminUserUIDToContext(ctx context.Context, uid string) context.Context {
 	return context.WithValue(ctx, AdminUserContextKey, uid)
 }
 
+// GetAdminUserRoleFromContext extracts admin user role from context ("" if not set)
+func GetAdminUserRoleFromContext(ctx context.Context) string {
+	role, _ := ctx.Value(AdminUserRoleContextKey).(string)
+	return role
+}
+
+// SetAdminUserRoleToContext sets admin user role to context
+func SetAdminUserRoleToContext(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, AdminUserRoleContextKey, role)
+}
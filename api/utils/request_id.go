@@ -0,0 +1,17 @@
+// Request id carried through context for log/audit correlation
+package utils
+
+import "context"
+
+const RequestIDContextKey contextKey = "request_id"
+
+// GetRequestIDFromContext returns the request id set by middleware.RequestIDMiddleware ("" if none)
+func GetRequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(RequestIDContextKey).(string)
+	return id
+}
+
+// SetRequestIDToContext sets request id to context
+func SetRequestIDToContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, RequestIDContextKey, id)
+}